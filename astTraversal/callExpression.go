@@ -1,6 +1,7 @@
 package astTraversal
 
 import (
+	"fmt"
 	"go/ast"
 	"go/types"
 )
@@ -92,7 +93,7 @@ func (c *CallExpressionTraverser) Type() (*types.Func, error) {
 
 	var obj types.Object
 	var err error
-	switch nodeFun := c.Node.Fun.(type) {
+	switch nodeFun := uninstantiatedExpr(c.Node.Fun).(type) {
 	case *ast.Ident:
 		obj, err = c.File.Package.FindObjectForIdent(nodeFun)
 	case *ast.SelectorExpr:
@@ -129,6 +130,95 @@ func (c *CallExpressionTraverser) Type() (*types.Func, error) {
 	return nil, ErrInvalidNodeType
 }
 
+// TypeArguments resolves the concrete types that a generic function is instantiated with at the call, by its type parameters.
+// It returns nil if the function isn't generic.
+func (c *CallExpressionTraverser) TypeArguments() (map[*types.TypeParam]types.Type, error) {
+	funcType, err := c.Type()
+	if err != nil {
+		return nil, err
+	}
+
+	signature, ok := funcType.Type().(*types.Signature)
+	if !ok {
+		return nil, ErrInvalidNodeType
+	}
+
+	typeParams := signature.TypeParams()
+	if typeParams.Len() == 0 {
+		return nil, nil
+	}
+
+	var ident *ast.Ident
+	switch nodeFun := uninstantiatedExpr(c.Node.Fun).(type) {
+	case *ast.Ident:
+		ident = nodeFun
+	case *ast.SelectorExpr:
+		ident = nodeFun.Sel
+	default:
+		return nil, ErrInvalidNodeType
+	}
+
+	if c.File.Package.Package == nil {
+		return nil, fmt.Errorf("package %s not populated", c.File.Package.Path())
+	}
+
+	instance, ok := c.File.Package.Package.TypesInfo.Instances[ident]
+	if !ok || instance.TypeArgs.Len() != typeParams.Len() {
+		return nil, fmt.Errorf("instance of %s not found", ident.Name)
+	}
+
+	typeArguments := make(map[*types.TypeParam]types.Type, typeParams.Len())
+	for i := 0; i < typeParams.Len(); i++ {
+		typeArguments[typeParams.At(i)] = instance.TypeArgs.At(i)
+	}
+
+	return typeArguments, nil
+}
+
+// ArgumentValues resolves the constant values of the arguments at the call, by the parameters of the function they are passed to.
+// Arguments that don't have a constant value are omitted.
+func (c *CallExpressionTraverser) ArgumentValues() (map[*types.Var]string, error) {
+	funcType, err := c.Type()
+	if err != nil {
+		return nil, err
+	}
+
+	signature, ok := funcType.Type().(*types.Signature)
+	if !ok {
+		return nil, ErrInvalidNodeType
+	}
+
+	argumentValues := make(map[*types.Var]string)
+	for i, arg := range c.Node.Args {
+		// Variadic arguments are collected into a slice, so they don't have a single value
+		if i >= signature.Params().Len() || (signature.Variadic() && i >= signature.Params().Len()-1) {
+			break
+		}
+
+		// The arguments are evaluated in the file of the call, rather than the active file
+		value, err := (&ExpressionTraverser{Traverser: c.Traverser, Node: arg, File: c.File}).Value()
+		if err != nil {
+			continue
+		}
+
+		argumentValues[signature.Params().At(i)] = value
+	}
+
+	return argumentValues, nil
+}
+
+// uninstantiatedExpr strips the explicit type arguments from the instantiation of a generic function (i.e. Respond[Pet]).
+func uninstantiatedExpr(expr ast.Expr) ast.Expr {
+	switch n := expr.(type) {
+	case *ast.IndexExpr:
+		return n.X
+	case *ast.IndexListExpr:
+		return n.X
+	}
+
+	return expr
+}
+
 func (c *CallExpressionTraverser) ReturnType(returnNum int) (types.Type, error) {
 	funcType, err := c.Type()
 	if err != nil {
@@ -31,6 +31,11 @@ func (d *DeclarationTraverser) Value() (ast.Node, error) {
 			}
 		}
 
+		// The variable is declared without a value (i.e. var x T)
+		if index >= len(n.Values) {
+			return nil, ErrInvalidNodeType
+		}
+
 		return n.Values[index], nil
 	case *ast.AssignStmt:
 		var index int
@@ -41,6 +46,11 @@ func (d *DeclarationTraverser) Value() (ast.Node, error) {
 			}
 		}
 
+		// The variable is assigned one of the results of a call (i.e. x, err := f())
+		if index >= len(n.Rhs) {
+			return nil, ErrInvalidNodeType
+		}
+
 		return n.Rhs[index], nil
 	default:
 		return nil, ErrInvalidNodeType
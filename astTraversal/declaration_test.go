@@ -20,3 +20,33 @@ func TestTraverser_Declaration(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, declaration)
 }
+
+func TestDeclarationTraverser_Value(t *testing.T) {
+	traverser, err := setupTestDeclarationTraverser()
+	assert.NoError(t, err)
+
+	t.Run("should return an error for a variable declared without a value", func(t *testing.T) {
+		valueSpec := &ast.ValueSpec{
+			Names: []*ast.Ident{{Name: "x"}},
+		}
+
+		declaration, err := traverser.Declaration(valueSpec, "x")
+		assert.NoError(t, err)
+
+		_, err = declaration.Value()
+		assert.ErrorIs(t, err, ErrInvalidNodeType)
+	})
+
+	t.Run("should return an error for a variable assigned one of the results of a call", func(t *testing.T) {
+		assignStmt := &ast.AssignStmt{
+			Lhs: []ast.Expr{&ast.Ident{Name: "x"}, &ast.Ident{Name: "err"}},
+			Rhs: []ast.Expr{&ast.CallExpr{Fun: &ast.Ident{Name: "f"}}},
+		}
+
+		declaration, err := traverser.Declaration(assignStmt, "err")
+		assert.NoError(t, err)
+
+		_, err = declaration.Value()
+		assert.ErrorIs(t, err, ErrInvalidNodeType)
+	})
+}
@@ -3,6 +3,7 @@ package astTraversal
 import (
 	"errors"
 	"go/ast"
+	"go/constant"
 	"go/token"
 	"go/types"
 	"strings"
@@ -60,8 +61,15 @@ func (e *ExpressionTraverser) Value() (string, error) {
 			return "", err
 		}
 
-		if constant, ok := obj.(*types.Const); ok {
-			return constant.Val().String(), nil
+		if constantObj, ok := obj.(*types.Const); ok {
+			return constantValue(constantObj), nil
+		}
+
+		// Parameters of the function being traversed take the value they are called with
+		if varObj, ok := obj.(*types.Var); ok && e.Traverser != nil {
+			if value, ok := e.Traverser.argumentValues[varObj]; ok {
+				return value, nil
+			}
 		}
 
 		node, err := e.File.Package.ASTAtPos(obj.Pos())
@@ -91,6 +99,11 @@ func (e *ExpressionTraverser) Value() (string, error) {
 			return "", err
 		}
 
+		// Constants already carry their value, so their package doesn't need to be loaded
+		if constantObj, ok := obj.(*types.Const); ok {
+			return constantValue(constantObj), nil
+		}
+
 		pkgPath := obj.Pkg().Path()
 		if e.Traverser == nil || e.Traverser.Packages == nil || !e.Traverser.Packages.shouldLoadFullPackage(pkgPath) {
 			return "", errors.New("value not retrievable")
@@ -106,8 +119,8 @@ func (e *ExpressionTraverser) Value() (string, error) {
 			return "", err
 		}
 
-		if constant, ok := obj.(*types.Const); ok {
-			return constant.Val().String(), nil
+		if constantObj, ok := obj.(*types.Const); ok {
+			return constantValue(constantObj), nil
 		}
 
 		node, err := pkg.ASTAtPos(obj.Pos())
@@ -130,12 +143,60 @@ func (e *ExpressionTraverser) Value() (string, error) {
 			return "", errors.New("astNode is not of type ast.Expr")
 		}
 
-		return e.Traverser.Expression(exprNode).Value()
+		// The identifiers of the declaration are resolved in the file of the other package it is in
+		file, err := pkg.FindFileForPos(obj.Pos())
+		if err != nil {
+			return "", err
+		}
+
+		return (&ExpressionTraverser{Traverser: e.Traverser, Node: exprNode, File: file}).Value()
+	case *ast.CallExpr:
+		// The value of an error created from a message is the message itself (i.e. errors.New("not found"))
+		if isErrorConstructor(e.File.Package, n) && len(n.Args) > 0 {
+			return (&ExpressionTraverser{Traverser: e.Traverser, Node: n.Args[0], File: e.File}).Value()
+		}
 	}
 
 	return "", errors.New("value not retrievable")
 }
 
+// errorConstructors are the functions that create an error from a message, by the import path of their package.
+var errorConstructors = map[string]string{
+	"errors": "New",
+	"fmt":    "Errorf",
+}
+
+// isErrorConstructor checks whether the call expression in the package creates an error from a message.
+// The package of the function is resolved from its import, so it is matched even if it is imported under another name.
+func isErrorConstructor(pkg *PackageNode, callExpr *ast.CallExpr) bool {
+	selectorExpr, ok := callExpr.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+
+	ident, ok := selectorExpr.X.(*ast.Ident)
+	if !ok {
+		return false
+	}
+
+	obj, err := pkg.FindUsesForIdent(ident)
+	if err != nil {
+		return false
+	}
+
+	pkgName, ok := obj.(*types.PkgName)
+	return ok && errorConstructors[pkgName.Imported().Path()] == selectorExpr.Sel.Name
+}
+
+// constantValue formats the value of a constant, without the quotes surrounding a string.
+func constantValue(constantObj *types.Const) string {
+	if constantObj.Val().Kind() == constant.String {
+		return constant.StringVal(constantObj.Val())
+	}
+
+	return constantObj.Val().String()
+}
+
 func (e *ExpressionTraverser) Type() (types.Type, error) {
 	switch n := e.Node.(type) {
 	case *ast.StarExpr:
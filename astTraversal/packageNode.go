@@ -102,6 +102,24 @@ func (p *PackageNode) FindTypeForExpr(expr ast.Expr) (types.Type, error) {
 	return t, nil
 }
 
+// EvalType evaluates a type expression written in the source (i.e. []models.Pet), as if it were written at the position in the package.
+// The position determines the scope, so the imports of the file it is in can be used.
+func (p *PackageNode) EvalType(expr string, pos token.Pos) (types.Type, error) {
+	if p.Package == nil {
+		return nil, fmt.Errorf("package %s not populated", p.Path())
+	}
+
+	typeAndValue, err := types.Eval(p.Package.Fset, p.Package.Types, pos, expr)
+	if err != nil {
+		return nil, err
+	}
+	if !typeAndValue.IsType() {
+		return nil, fmt.Errorf("%s is not a type in package %s", expr, p.Path())
+	}
+
+	return typeAndValue.Type, nil
+}
+
 func (p *PackageNode) FindObjectForName(name string) (types.Object, error) {
 	if p.Package == nil {
 		return nil, fmt.Errorf("package %s not populated", p.Path())
@@ -164,6 +182,22 @@ func (p *PackageNode) FindUsesForIdent(ident *ast.Ident) (types.Object, error) {
 	return uses, nil
 }
 
+// FindFileForPos finds the file of the package that a position is in.
+func (p *PackageNode) FindFileForPos(pos token.Pos) (*FileNode, error) {
+	if p.Package == nil {
+		return nil, fmt.Errorf("package %s not populated", p.Path())
+	}
+
+	position := p.Package.Fset.Position(pos)
+	for _, f := range p.Files {
+		if f.FileName == position.Filename {
+			return f, nil
+		}
+	}
+
+	return nil, fmt.Errorf("file at %s not found in package %s", position, p.Path())
+}
+
 func (p *PackageNode) ASTAtPos(pos token.Pos) (ast.Node, error) {
 	if p.Package == nil {
 		return nil, fmt.Errorf("package %s not populated", p.Path())
@@ -198,6 +232,17 @@ func (p *PackageNode) ASTAtPos(pos token.Pos) (ast.Node, error) {
 	return nil, fmt.Errorf("node at %s not found in package %s", node, p.Path())
 }
 
+// Doc finds the documentation for the package, from the package clause of the first file that documents it.
+func (p *PackageNode) Doc() string {
+	for _, f := range p.Files {
+		if f.AST != nil && f.AST.Doc != nil {
+			return FormatDoc(f.AST.Doc.Text())
+		}
+	}
+
+	return ""
+}
+
 // FindDocForType finds the documentation for a type in the package.
 func (p *PackageNode) FindDocForType(typeName string) (string, bool) {
 	p.populateTypeDocMap()
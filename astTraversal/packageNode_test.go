@@ -2,6 +2,8 @@ package astTraversal
 
 import (
 	"go/ast"
+	"go/token"
+	"strings"
 	"testing"
 )
 import "github.com/stretchr/testify/assert"
@@ -129,3 +131,61 @@ func TestPackageNode_FindASTForType(t *testing.T) {
 		assert.ErrorContains(t, err, "type otherpkg1.Foo not found in package github.com/ls6-events/astra/astTraversal/testfiles")
 	})
 }
+
+func TestPackageNode_EvalType(t *testing.T) {
+	traverser, err := CreateTraverserFromTestFile("usefulTypes.go")
+	assert.NoError(t, err)
+
+	pkg := traverser.ActiveFile().Package
+	_, err = traverser.Packages.Get(pkg)
+	assert.NoError(t, err)
+
+	// Evaluate the types from within the file, so its imports are in scope
+	var pos token.Pos
+	for _, f := range pkg.Package.Syntax {
+		if strings.HasSuffix(pkg.Package.Fset.Position(f.Pos()).Filename, "usefulTypes.go") {
+			pos = f.Decls[len(f.Decls)-1].Pos()
+		}
+	}
+
+	t.Run("Test evaluating a type in the same package", func(t *testing.T) {
+		evaluated, err := pkg.EvalType("MyStruct", pos)
+		assert.NoError(t, err)
+		assert.Equal(t, "github.com/ls6-events/astra/astTraversal/testfiles.MyStruct", evaluated.String())
+	})
+
+	t.Run("Test evaluating a composite type of an imported package", func(t *testing.T) {
+		evaluated, err := pkg.EvalType("[]otherpkg1.Foo", pos)
+		assert.NoError(t, err)
+		assert.Equal(t, "[]github.com/ls6-events/astra/astTraversal/testfiles/otherpkg1.Foo", evaluated.String())
+	})
+
+	t.Run("Test evaluating a value", func(t *testing.T) {
+		_, err := pkg.EvalType("1 + 1", pos)
+		assert.ErrorContains(t, err, "is not a type")
+	})
+
+	t.Run("Test evaluating an unknown type", func(t *testing.T) {
+		_, err := pkg.EvalType("Unknown", pos)
+		assert.Error(t, err)
+	})
+}
+
+func TestPackageNode_Doc(t *testing.T) {
+	t.Run("Test a package without documentation", func(t *testing.T) {
+		p := &PackageNode{Name: "root"}
+		p.AddFile(&FileNode{FileName: "main.go", AST: &ast.File{}})
+
+		assert.Empty(t, p.Doc())
+	})
+
+	t.Run("Test a package documented in one of its files", func(t *testing.T) {
+		p := &PackageNode{Name: "root"}
+		p.AddFile(&FileNode{FileName: "main.go", AST: &ast.File{}})
+		p.AddFile(&FileNode{FileName: "doc.go", AST: &ast.File{
+			Doc: &ast.CommentGroup{List: []*ast.Comment{{Text: "// Package root manages the pets."}}},
+		}})
+
+		assert.Equal(t, "Package root manages the pets.", p.Doc())
+	})
+}
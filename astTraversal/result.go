@@ -18,6 +18,15 @@ type Result struct {
 	// IsEmbedded is true if the result is embedded in a struct
 	IsEmbedded bool
 
+	// IsNullable is true if the result is a struct field that can be null (i.e. a pointer)
+	IsNullable bool
+
+	// Example is the example value of the result from the example tag of a struct field
+	Example string
+
+	// Extensions are the vendor extensions of the result from the x- tags of a struct field (i.e. `x-go-type:"uuid.UUID"`)
+	Extensions map[string]string
+
 	// ConstantValue is the constant value of the result (e.g. for a string)
 	ConstantValue string
 
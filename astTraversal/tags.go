@@ -2,6 +2,7 @@ package astTraversal
 
 import (
 	"reflect"
+	"strconv"
 	"strings"
 )
 
@@ -37,8 +38,11 @@ const (
 
 var ValidationTags = []ValidationTagType{GinValidationTag, ValidatorValidationTag}
 
+// ValidationTag is the parsed form of a validation struct tag (e.g. `binding:"required,min=1"`).
+// Rules holds every constraint other than required, keyed by the rule name with its parameter as the value (empty for rules without one).
 type ValidationTag struct {
-	IsRequired bool `json:"is_required,omitempty" yaml:"is_required,omitempty"`
+	IsRequired bool              `json:"is_required,omitempty" yaml:"is_required,omitempty"`
+	Rules      map[string]string `json:"rules,omitempty" yaml:"rules,omitempty"`
 }
 
 type ValidationTagMap map[ValidationTagType]ValidationTag
@@ -83,8 +87,91 @@ func ParseStructTag(field string, tag string) (BindingTagMap, ValidationTagMap)
 
 		validationTags[validationTag] = ValidationTag{
 			IsRequired: strings.Contains(tagValue, "required"),
+			Rules:      parseValidationRules(tagValue),
 		}
 	}
 
 	return bindingTags, validationTags
 }
+
+// parseValidationRules splits a validation tag value into its rules.
+// Rules after "dive" apply to the elements of a slice or map, and rules combined with "|" are alternatives, so neither describe the field itself and both are skipped.
+func parseValidationRules(tagValue string) map[string]string {
+	var rules map[string]string
+	for _, rule := range strings.Split(tagValue, ",") {
+		rule = strings.TrimSpace(rule)
+		if rule == "dive" {
+			break
+		}
+		if rule == "" || rule == "required" || rule == "omitempty" || strings.Contains(rule, "|") {
+			continue
+		}
+
+		name, value, _ := strings.Cut(rule, "=")
+		if rules == nil {
+			rules = make(map[string]string)
+		}
+		rules[name] = value
+	}
+
+	return rules
+}
+
+// ExtensionPrefix is the prefix of the names of vendor extensions (i.e. x-ratelimit), which the struct tags that are vendor extensions of a field start with too (i.e. `x-go-type:"uuid.UUID"`).
+// Extensions without it aren't valid, as they could clash with the fields of the outputs.
+const ExtensionPrefix = "x-"
+
+// ParseExtensionTags finds the vendor extensions in a struct tag, by their names.
+// They are every key starting with x-, which can't be looked up by name like the other tags as their names aren't known.
+func ParseExtensionTags(tag string) map[string]string {
+	var extensions map[string]string
+	for tag != "" {
+		// The struct tag is parsed in the same way as reflect.StructTag.Lookup
+		i := 0
+		for i < len(tag) && tag[i] == ' ' {
+			i++
+		}
+		tag = tag[i:]
+		if tag == "" {
+			break
+		}
+
+		i = 0
+		for i < len(tag) && tag[i] > ' ' && tag[i] != ':' && tag[i] != '"' && tag[i] != 0x7f {
+			i++
+		}
+		if i == 0 || i+1 >= len(tag) || tag[i] != ':' || tag[i+1] != '"' {
+			break
+		}
+		name := tag[:i]
+		tag = tag[i+1:]
+
+		i = 1
+		for i < len(tag) && tag[i] != '"' {
+			if tag[i] == '\\' {
+				i++
+			}
+			i++
+		}
+		if i >= len(tag) {
+			break
+		}
+		quotedValue := tag[:i+1]
+		tag = tag[i+1:]
+
+		if !strings.HasPrefix(name, ExtensionPrefix) {
+			continue
+		}
+		value, err := strconv.Unquote(quotedValue)
+		if err != nil {
+			break
+		}
+
+		if extensions == nil {
+			extensions = make(map[string]string)
+		}
+		extensions[name] = value
+	}
+
+	return extensions
+}
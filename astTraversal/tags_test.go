@@ -100,6 +100,33 @@ func TestParseStructTag(t *testing.T) {
 			},
 			expectedValidationTags: ValidationTagMap{},
 		},
+		{
+			field: "Field8",
+			tag:   `uri:"id" binding:"required,min=1,max=100,uuid" validate:"oneof=a b,dive,max=3"`,
+			expectedBindingTags: BindingTagMap{
+				URIBindingTag: {
+					Name:           "id",
+					NotShown:       false,
+					ReturnOptional: false,
+				},
+			},
+			expectedValidationTags: ValidationTagMap{
+				GinValidationTag: {
+					IsRequired: true,
+					Rules: map[string]string{
+						"min":  "1",
+						"max":  "100",
+						"uuid": "",
+					},
+				},
+				ValidatorValidationTag: {
+					IsRequired: false,
+					Rules: map[string]string{
+						"oneof": "a b",
+					},
+				},
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -116,3 +143,49 @@ func TestParseStructTag(t *testing.T) {
 		})
 	}
 }
+
+func TestParseExtensionTags(t *testing.T) {
+	testCases := []struct {
+		name               string
+		tag                string
+		expectedExtensions map[string]string
+	}{
+		{
+			name:               "no extensions",
+			tag:                `json:"id" binding:"required"`,
+			expectedExtensions: nil,
+		},
+		{
+			name: "extensions",
+			tag:  `json:"id" x-go-type:"uuid.UUID" x-internal:"true"`,
+			expectedExtensions: map[string]string{
+				"x-go-type":  "uuid.UUID",
+				"x-internal": "true",
+			},
+		},
+		{
+			name: "escaped value",
+			tag:  `x-oapi-codegen-extra-tags:"{\"db\":\"id\"}"`,
+			expectedExtensions: map[string]string{
+				"x-oapi-codegen-extra-tags": `{"db":"id"}`,
+			},
+		},
+		{
+			name: "invalid tag",
+			tag:  `x-ratelimit:"100" json`,
+			expectedExtensions: map[string]string{
+				"x-ratelimit": "100",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			extensions := ParseExtensionTags(testCase.tag)
+
+			if !reflect.DeepEqual(extensions, testCase.expectedExtensions) {
+				t.Errorf("Expected Extensions: %v, but got: %v", testCase.expectedExtensions, extensions)
+			}
+		})
+	}
+}
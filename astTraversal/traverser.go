@@ -4,6 +4,7 @@ import (
 	"errors"
 	"go/ast"
 	"go/types"
+	"maps"
 
 	"github.com/rs/zerolog"
 )
@@ -23,6 +24,10 @@ type BaseTraverser struct {
 	callExprFuncErrCache map[*ast.CallExpr]error
 	callExprTypeCache    map[*ast.CallExpr]*types.Func
 	callExprTypeErrCache map[*ast.CallExpr]error
+	// typeArguments are the concrete types that the type parameters of the generic functions being traversed are instantiated with.
+	typeArguments map[*types.TypeParam]types.Type
+	// argumentValues are the constant values that the parameters of the functions being traversed are called with.
+	argumentValues map[*types.Var]string
 }
 
 func New(workDir string) *BaseTraverser {
@@ -55,6 +60,54 @@ func (t *BaseTraverser) SetActiveFile(file *FileNode) *BaseTraverser {
 	return t
 }
 
+// SetTypeArguments sets the concrete types that the type parameters of a generic function are instantiated with, while it is traversed.
+// The type arguments are added to those already set, as generic functions can call each other.
+// It returns a function that restores the previous type arguments, once the generic function has been traversed.
+func (t *BaseTraverser) SetTypeArguments(typeArguments map[*types.TypeParam]types.Type) func() {
+	previous := t.typeArguments
+	if len(typeArguments) == 0 {
+		return func() {}
+	}
+
+	merged := make(map[*types.TypeParam]types.Type, len(previous)+len(typeArguments))
+	maps.Copy(merged, previous)
+	for typeParam, typeArgument := range typeArguments {
+		// A type argument can be a type parameter of the calling generic function, which is already instantiated
+		if callerTypeParam, ok := typeArgument.(*types.TypeParam); ok {
+			if callerTypeArgument, ok := previous[callerTypeParam]; ok {
+				typeArgument = callerTypeArgument
+			} else if callerTypeParam == typeParam {
+				continue
+			}
+		}
+		merged[typeParam] = typeArgument
+	}
+	t.typeArguments = merged
+
+	return func() {
+		t.typeArguments = previous
+	}
+}
+
+// SetArgumentValues sets the constant values that the parameters of a function are called with, while it is traversed.
+// This allows values passed through a helper function (i.e. a status code) to be resolved within it.
+// It returns a function that restores the previous argument values, once the function has been traversed.
+func (t *BaseTraverser) SetArgumentValues(argumentValues map[*types.Var]string) func() {
+	previous := t.argumentValues
+	if len(argumentValues) == 0 {
+		return func() {}
+	}
+
+	merged := make(map[*types.Var]string, len(previous)+len(argumentValues))
+	maps.Copy(merged, previous)
+	maps.Copy(merged, argumentValues)
+	t.argumentValues = merged
+
+	return func() {
+		t.argumentValues = previous
+	}
+}
+
 func (t *BaseTraverser) Reset() {
 	t.activeFile = t.baseActiveFile
 }
@@ -102,6 +155,8 @@ func (t *BaseTraverser) FindDeclarationForNode(node ast.Node) (*DeclarationTrave
 	switch nodeType := node.(type) {
 	case *ast.UnaryExpr:
 		return t.FindDeclarationForNode(nodeType.X)
+	case *ast.IndexExpr, *ast.IndexListExpr:
+		return t.FindDeclarationForNode(uninstantiatedExpr(nodeType.(ast.Expr)))
 	case *ast.Ident:
 		if nodeType.Obj != nil { // Defined in file.
 			declNode, ok := nodeType.Obj.Decl.(ast.Node)
@@ -4,6 +4,7 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"go/ast"
+	"go/types"
 	"testing"
 )
 
@@ -116,3 +117,37 @@ func TestTraverser_ExtractVarName(t *testing.T) {
 		assert.Contains(t, result.Package.Path(), "testfiles") // We don't change the active file here
 	})
 }
+
+func TestTraverser_SetTypeArguments(t *testing.T) {
+	outer := types.NewTypeParam(types.NewTypeName(0, nil, "T", nil), nil)
+	inner := types.NewTypeParam(types.NewTypeName(0, nil, "U", nil), nil)
+
+	traverser := &BaseTraverser{}
+
+	restoreOuter := traverser.SetTypeArguments(map[*types.TypeParam]types.Type{outer: types.Typ[types.String]})
+	assert.Equal(t, types.Typ[types.String], traverser.typeArguments[outer])
+
+	t.Run("should resolve type arguments that are type parameters of the caller", func(t *testing.T) {
+		restoreInner := traverser.SetTypeArguments(map[*types.TypeParam]types.Type{inner: outer})
+		assert.Equal(t, types.Typ[types.String], traverser.typeArguments[inner])
+		assert.Equal(t, types.Typ[types.String], traverser.typeArguments[outer])
+
+		restoreInner()
+		assert.NotContains(t, traverser.typeArguments, inner)
+	})
+
+	restoreOuter()
+	assert.Nil(t, traverser.typeArguments)
+}
+
+func TestTraverser_SetArgumentValues(t *testing.T) {
+	status := types.NewParam(0, nil, "status", types.Typ[types.Int])
+
+	traverser := &BaseTraverser{}
+
+	restore := traverser.SetArgumentValues(map[*types.Var]string{status: "201"})
+	assert.Equal(t, "201", traverser.argumentValues[status])
+
+	restore()
+	assert.Nil(t, traverser.argumentValues)
+}
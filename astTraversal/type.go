@@ -9,6 +9,14 @@ import (
 	"strings"
 )
 
+// FileType is the type that uploaded files resolve to, instead of the structure of their headers.
+const FileType = "file"
+
+// fileTypes is a set of the fully qualified named types that represent uploaded files.
+var fileTypes = map[string]bool{
+	"mime/multipart.FileHeader": true,
+}
+
 type TypeTraverser struct {
 	Traverser *BaseTraverser
 	Node      types.Type
@@ -59,6 +67,13 @@ func (t *TypeTraverser) Result() (Result, error) {
 		}
 	}()
 
+	// Type parameters are replaced with the concrete types the generic function being traversed is instantiated with
+	if typeParam, ok := t.Node.(*types.TypeParam); ok && t.Traverser != nil {
+		if typeArgument, ok := t.Traverser.typeArguments[typeParam]; ok {
+			return t.Traverser.Type(typeArgument, t.Package).SetName(t.name).Result()
+		}
+	}
+
 	cacheKey := typeCacheKey(t)
 	if t.Traverser != nil && cacheKey != "" {
 		if cached, ok := t.Traverser.typeResultCache[cacheKey]; ok {
@@ -219,6 +234,14 @@ func (t *TypeTraverser) Result() (Result, error) {
 		}
 
 	case *types.Named:
+		if n.Obj().Pkg() != nil && fileTypes[n.Obj().Pkg().Path()+"."+n.Obj().Name()] {
+			result = Result{
+				Type:    FileType,
+				Package: t.Package,
+			}
+			break
+		}
+
 		var pkg *PackageNode
 		if n.Obj().Pkg() != nil {
 			pkgPath := n.Obj().Pkg().Path()
@@ -349,6 +372,10 @@ func (t *TypeTraverser) Result() (Result, error) {
 			}
 
 			structFieldResult.IsEmbedded = isEmbedded
+			// Pointer fields can be nil, which is encoded as null
+			_, structFieldResult.IsNullable = f.Type().Underlying().(*types.Pointer)
+			structFieldResult.Example = reflect.StructTag(n.Tag(i)).Get("example")
+			structFieldResult.Extensions = ParseExtensionTags(n.Tag(i))
 			structFieldResult.StructFieldBindingTags = bindingTag
 			structFieldResult.StructFieldValidationTags = validationTags
 
@@ -365,6 +392,12 @@ func (t *TypeTraverser) Result() (Result, error) {
 			Type:    "any",
 			Package: t.Package,
 		}
+	case *types.TypeParam:
+		// The type parameter isn't instantiated with a concrete type, so it could be anything
+		result = Result{
+			Type:    "any",
+			Package: t.Package,
+		}
 	}
 
 	if t.name != "" {
@@ -525,6 +558,10 @@ func typeCacheKey(t *TypeTraverser) string {
 	if t == nil || t.Node == nil {
 		return ""
 	}
+	// The result of a type with type parameters depends on the type arguments it is traversed with
+	if containsTypeParam(t.Node) {
+		return ""
+	}
 	switch n := t.Node.(type) {
 	case *types.Named:
 		if n.Obj() != nil && n.Obj().Pkg() != nil {
@@ -537,6 +574,36 @@ func typeCacheKey(t *TypeTraverser) string {
 	return "type:" + nString(t.Node)
 }
 
+// containsTypeParam checks whether a type is, or is composed of, a type parameter.
+func containsTypeParam(node types.Type) bool {
+	switch n := node.(type) {
+	case *types.TypeParam:
+		return true
+	case *types.Pointer:
+		return containsTypeParam(n.Elem())
+	case *types.Slice:
+		return containsTypeParam(n.Elem())
+	case *types.Array:
+		return containsTypeParam(n.Elem())
+	case *types.Map:
+		return containsTypeParam(n.Key()) || containsTypeParam(n.Elem())
+	case *types.Named:
+		for i := 0; i < n.TypeArgs().Len(); i++ {
+			if containsTypeParam(n.TypeArgs().At(i)) {
+				return true
+			}
+		}
+	case *types.Struct:
+		for i := 0; i < n.NumFields(); i++ {
+			if containsTypeParam(n.Field(i).Type()) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func (t *TypeTraverser) Doc() (string, error) {
 	if named, ok := t.Node.(*types.Named); ok {
 		if t.Traverser == nil || t.Traverser.Packages == nil {
@@ -66,6 +66,18 @@ func TestTypeTraverser_Result(t *testing.T) {
 		assert.Equal(t, "MyStruct", res.Type)
 	})
 
+	t.Run("File", func(t *testing.T) {
+		// A *multipart.FileHeader resolves to the file type, rather than a component of its own
+		multipartPkg := types.NewPackage("mime/multipart", "multipart")
+		fileHeader := types.NewNamed(types.NewTypeName(token.NoPos, multipartPkg, "FileHeader", nil), types.NewStruct(nil, nil), nil)
+
+		tt := baseTraverser.Type(types.NewSlice(types.NewPointer(fileHeader)), nil)
+		res, err := tt.Result()
+		assert.Nil(t, err)
+		assert.Equal(t, "slice", res.Type)
+		assert.Equal(t, FileType, res.SliceType)
+	})
+
 	t.Run("Struct", func(t *testing.T) {
 		// Creating a simple struct with a field "Age" of type int
 		fields := []*types.Var{
@@ -84,6 +96,23 @@ func TestTypeTraverser_Result(t *testing.T) {
 		assert.Equal(t, "int", ageField.Type)
 		assert.True(t, ageField.StructFieldValidationTags[GinValidationTag].IsRequired)
 	})
+
+	t.Run("Struct Nullable And Example Fields", func(t *testing.T) {
+		fields := []*types.Var{
+			types.NewField(token.NoPos, nil, "Name", types.NewPointer(types.Typ[types.String]), false),
+			types.NewField(token.NoPos, nil, "Age", types.Typ[types.Int], false),
+		}
+		structType := types.NewStruct(fields, []string{"json:\"name\"", "json:\"age\" example:\"3\""})
+
+		tt := baseTraverser.Type(structType, nil)
+		res, err := tt.Result()
+		assert.Nil(t, err)
+
+		assert.True(t, res.StructFields["Name"].IsNullable)
+		assert.Empty(t, res.StructFields["Name"].Example)
+		assert.False(t, res.StructFields["Age"].IsNullable)
+		assert.Equal(t, "3", res.StructFields["Age"].Example)
+	})
 }
 
 func TestTypeTraverser_Doc(t *testing.T) {
@@ -27,6 +27,9 @@ func ExtractBindingTags(fields map[string]Field) (bindingTags []astTraversal.Bin
 		return astTraversal.BindingTags, uniqueBindings
 	}
 
+	// The fields and their tags are maps, so the binding tags are sorted to be in the same order every time
+	slices.Sort(bindingTags)
+
 	return bindingTags, uniqueBindings
 }
 
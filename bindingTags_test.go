@@ -82,6 +82,29 @@ func TestExtractBindingTags(t *testing.T) {
 		require.False(t, uniqueBindings)
 	})
 
+	t.Run("Sorted Binding Tags", func(t *testing.T) {
+		fields := map[string]Field{
+			"Name": {
+				StructFieldBindingTags: map[astTraversal.BindingTagType]astTraversal.BindingTag{
+					astTraversal.XMLBindingTag: {
+						Name: "name",
+					},
+					astTraversal.YAMLBindingTag: {
+						Name: "name",
+					},
+					astTraversal.JSONBindingTag: {
+						Name: "name",
+					},
+				},
+			},
+		}
+
+		for i := 0; i < 10; i++ {
+			bindingTags, _ := ExtractBindingTags(fields)
+			require.Equal(t, []astTraversal.BindingTagType{astTraversal.JSONBindingTag, astTraversal.XMLBindingTag, astTraversal.YAMLBindingTag}, bindingTags)
+		}
+	})
+
 	t.Run("No Binding Tags", func(t *testing.T) {
 		fields := map[string]Field{
 			"Name": {
@@ -86,11 +86,41 @@ func (s *Service) LoadCache() error {
 // If the file does not exist, it will return an error.
 // Requires the path to the cache file.
 func (s *Service) LoadCacheFromCustomPath(cachePath string) error {
-	f, err := os.Open(cachePath)
+	service, err := readCache(cachePath)
 	if err != nil {
 		return err
 	}
 
+	s.Inputs = service.Inputs
+	s.Outputs = service.Outputs
+	s.Config = service.Config
+	s.Routes = service.Routes
+	s.Components = service.Components
+	s.Tags = service.Tags
+	s.Packages = service.Packages
+	if service.TagStrategy != TagStrategyNone {
+		s.TagStrategy = service.TagStrategy
+	}
+	if len(service.SecurityMiddleware) > 0 {
+		s.SecurityMiddleware = service.SecurityMiddleware
+	}
+	if service.StripDocSummary {
+		s.StripDocSummary = true
+	}
+	if service.GoTypeExtensions {
+		s.GoTypeExtensions = true
+	}
+	return nil
+}
+
+// readCache reads the service from a file cache, in JSON or YAML format by its extension.
+func readCache(cachePath string) (Service, error) {
+	f, err := os.Open(cachePath)
+	if err != nil {
+		return Service{}, err
+	}
+	defer f.Close()
+
 	var service Service
 	if strings.HasSuffix(cachePath, ".json") {
 		err = json.NewDecoder(f).Decode(&service)
@@ -100,15 +130,53 @@ func (s *Service) LoadCacheFromCustomPath(cachePath string) error {
 		err = errors.New("unsupported file format")
 	}
 
+	return service, err
+}
+
+// generatedIOConfigurationKeys are the keys of the configurations of the outputs that are generated by the outputs rather than set by their options (i.e. the field numbers of the Protocol Buffers output).
+// They are kept in the cache, so the outputs generate the same values every time.
+var generatedIOConfigurationKeys = []IOConfigurationKey{IOConfigurationKeyFieldNumbers}
+
+// RestoreCachedOutputs restores the generated configurations of the outputs from the cache, before it is replaced.
+// The configurations are restored to the outputs of the same mode and path, unless they are already set.
+// If the file does not exist, it will not return an error.
+func (s *Service) RestoreCachedOutputs() error {
+	s.Log.Debug().Msg("Restoring cached outputs")
+
+	var cachePath string
+	if s.CachePath != "" {
+		cachePath = s.CachePath
+	} else {
+		cachePath = path.Join(s.getAstraDirPath(), cacheFileName)
+	}
+
+	if _, err := os.Stat(cachePath); err != nil {
+		s.Log.Debug().Msg("No cached outputs to restore")
+		return nil
+	}
+
+	service, err := readCache(cachePath)
 	if err != nil {
 		return err
 	}
 
-	s.Inputs = service.Inputs
-	s.Outputs = service.Outputs
-	s.Config = service.Config
-	s.Routes = service.Routes
-	s.Components = service.Components
+	for _, output := range s.Outputs {
+		for _, cachedOutput := range service.Outputs {
+			if cachedOutput.Mode != output.Mode ||
+				cachedOutput.Configuration[IOConfigurationKeyFilePath] != output.Configuration[IOConfigurationKeyFilePath] ||
+				cachedOutput.Configuration[IOConfigurationKeyDirectoryPath] != output.Configuration[IOConfigurationKeyDirectoryPath] {
+				continue
+			}
+
+			for _, key := range generatedIOConfigurationKeys {
+				if value, ok := cachedOutput.Configuration[key]; ok && output.Configuration[key] == nil {
+					output.Configuration[key] = value
+				}
+			}
+		}
+	}
+
+	s.Log.Debug().Msg("Restored cached outputs")
 	return nil
 }
 
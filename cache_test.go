@@ -402,6 +402,101 @@ func TestService_LoadCacheFromCustomPath(t *testing.T) {
 			require.Equal(t, "TestComponent", topLevelService.Components[0].Name)
 			require.Equal(t, "test", topLevelService.Components[0].Package)
 		})
+
+		t.Run("Tags", func(t *testing.T) {
+			topLevelService := &Service{}
+
+			cachedService := Service{
+				TagStrategy: TagStrategyPackage,
+				Tags: []Tag{
+					{
+						Name:        "pets",
+						Description: "Package pets manages the pets.",
+					},
+				},
+				Packages: map[string]Package{
+					"example.com/pets": {
+						Name: "pets",
+						Doc:  "Package pets manages the pets.",
+					},
+				},
+			}
+
+			setupCache("./test-cache.json", cachedService, t)
+			defer cleanupCache("./test-cache.json", t)
+
+			require.Empty(t, topLevelService.Tags)
+
+			err := topLevelService.LoadCacheFromCustomPath("./test-cache.json")
+			require.NoError(t, err)
+
+			require.Equal(t, TagStrategyPackage, topLevelService.TagStrategy)
+			require.Equal(t, "pets", topLevelService.Tags[0].Name)
+			require.Equal(t, "Package pets manages the pets.", topLevelService.Tags[0].Description)
+			require.Equal(t, "pets", topLevelService.Packages["example.com/pets"].Name)
+		})
+
+		t.Run("Security", func(t *testing.T) {
+			topLevelService := &Service{}
+
+			cachedService := Service{
+				Config: &Config{
+					SecuritySchemes: map[string]SecurityScheme{
+						"bearerAuth": NewBearerSecurityScheme("JWT"),
+					},
+				},
+				SecurityMiddleware: map[string]SecurityRequirement{
+					"example.com/auth.RequireJWT": {"bearerAuth": {}},
+				},
+			}
+
+			setupCache("./test-cache.json", cachedService, t)
+			defer cleanupCache("./test-cache.json", t)
+
+			require.Empty(t, topLevelService.SecurityMiddleware)
+
+			err := topLevelService.LoadCacheFromCustomPath("./test-cache.json")
+			require.NoError(t, err)
+
+			require.Equal(t, NewBearerSecurityScheme("JWT"), topLevelService.Config.SecuritySchemes["bearerAuth"])
+			require.Equal(t, SecurityRequirement{"bearerAuth": {}}, topLevelService.SecurityMiddleware["example.com/auth.RequireJWT"])
+		})
+
+		t.Run("Strip Doc Summary", func(t *testing.T) {
+			topLevelService := &Service{}
+
+			cachedService := Service{
+				StripDocSummary: true,
+			}
+
+			setupCache("./test-cache.json", cachedService, t)
+			defer cleanupCache("./test-cache.json", t)
+
+			require.False(t, topLevelService.StripDocSummary)
+
+			err := topLevelService.LoadCacheFromCustomPath("./test-cache.json")
+			require.NoError(t, err)
+
+			require.True(t, topLevelService.StripDocSummary)
+		})
+
+		t.Run("Go Type Extensions", func(t *testing.T) {
+			topLevelService := &Service{}
+
+			cachedService := Service{
+				GoTypeExtensions: true,
+			}
+
+			setupCache("./test-cache.json", cachedService, t)
+			defer cleanupCache("./test-cache.json", t)
+
+			require.False(t, topLevelService.GoTypeExtensions)
+
+			err := topLevelService.LoadCacheFromCustomPath("./test-cache.json")
+			require.NoError(t, err)
+
+			require.True(t, topLevelService.GoTypeExtensions)
+		})
 	})
 }
 
@@ -449,3 +544,101 @@ func TestService_ClearCache(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestService_RestoreCachedOutputs(t *testing.T) {
+	setupCache := func(t *testing.T, path string) {
+		t.Helper()
+
+		service := Service{
+			Outputs: []Output{
+				{
+					Mode: "protobuf",
+					Configuration: IOConfiguration{
+						IOConfigurationKeyFilePath:     "./petstore.proto",
+						IOConfigurationKeyFieldNumbers: map[string]map[string]int{"Pet": {"id": 1}},
+					},
+				},
+				{
+					Mode: "protobuf",
+					Configuration: IOConfiguration{
+						IOConfigurationKeyFilePath:     "./other.proto",
+						IOConfigurationKeyFieldNumbers: map[string]map[string]int{"Other": {"id": 1}},
+					},
+				},
+			},
+		}
+
+		buff, err := json.Marshal(service)
+		require.NoError(t, err)
+
+		err = os.WriteFile(path, buff, 0644)
+		require.NoError(t, err)
+	}
+
+	t.Run("restores the generated configurations of the outputs of the same mode and path", func(t *testing.T) {
+		setupCache(t, "./test-cache.json")
+		defer func() {
+			err := os.Remove("./test-cache.json")
+			require.NoError(t, err)
+		}()
+
+		service := &Service{
+			CachePath: "./test-cache.json",
+			Outputs: []Output{
+				{
+					Mode: "protobuf",
+					Configuration: IOConfiguration{
+						IOConfigurationKeyFilePath: "./petstore.proto",
+					},
+				},
+				{
+					Mode: "json",
+					Configuration: IOConfiguration{
+						IOConfigurationKeyFilePath: "./other.proto",
+					},
+				},
+			},
+		}
+
+		err := service.RestoreCachedOutputs()
+		require.NoError(t, err)
+
+		require.Equal(t, map[string]any{"Pet": map[string]any{"id": float64(1)}}, service.Outputs[0].Configuration[IOConfigurationKeyFieldNumbers])
+		require.NotContains(t, service.Outputs[1].Configuration, IOConfigurationKeyFieldNumbers)
+	})
+
+	t.Run("doesn't replace configurations that are already set", func(t *testing.T) {
+		setupCache(t, "./test-cache.json")
+		defer func() {
+			err := os.Remove("./test-cache.json")
+			require.NoError(t, err)
+		}()
+
+		service := &Service{
+			CachePath: "./test-cache.json",
+			Outputs: []Output{
+				{
+					Mode: "protobuf",
+					Configuration: IOConfiguration{
+						IOConfigurationKeyFilePath:     "./petstore.proto",
+						IOConfigurationKeyFieldNumbers: map[string]map[string]int{"Pet": {"id": 2}},
+					},
+				},
+			},
+		}
+
+		err := service.RestoreCachedOutputs()
+		require.NoError(t, err)
+
+		require.Equal(t, map[string]map[string]int{"Pet": {"id": 2}}, service.Outputs[0].Configuration[IOConfigurationKeyFieldNumbers])
+	})
+
+	t.Run("doesn't return an error if there is no cache", func(t *testing.T) {
+		service := &Service{
+			CachePath: "./test-cache.json",
+		}
+
+		err := service.RestoreCachedOutputs()
+		require.NoError(t, err)
+	})
+}
@@ -4,9 +4,12 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"reflect"
 
 	"github.com/ls6-events/astra"
 	"github.com/ls6-events/astra/cli"
+	"github.com/ls6-events/astra/outputs"
+	"github.com/ls6-events/astra/outputs/protobuf"
 	"github.com/spf13/cobra"
 )
 
@@ -44,6 +47,9 @@ var generateCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
+		// The field numbers are read before the outputs are rebound, so they can be compared with those that are generated
+		cachedFieldNumbers := protobufFieldNumbers(s.Outputs)
+
 		err = rebindOptions(s)
 		if err != nil {
 			s.Log.Error().Err(err).Msg("Failed to rebind options")
@@ -56,10 +62,33 @@ var generateCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
+		// The field numbers generated by the Protocol Buffers outputs are cached, so they are the same the next time the service is generated
+		// The cache is only replaced if they changed, as the rest of it isn't all restored when it is loaded (i.e. the custom type mapping)
+		if !reflect.DeepEqual(cachedFieldNumbers, protobufFieldNumbers(s.Outputs)) {
+			s.CachePath = cacheFile
+			err = s.Cache()
+			if err != nil {
+				s.Log.Error().Err(err).Msg("Failed to cache service")
+				os.Exit(1)
+			}
+		}
+
 		s.Log.Info().Msg("Service built")
 	},
 }
 
+// protobufFieldNumbers returns the field numbers of the Protocol Buffers outputs, in the order of the outputs
+func protobufFieldNumbers(serviceOutputs []astra.Output) []protobuf.FieldNumbers {
+	var fieldNumbers []protobuf.FieldNumbers
+	for _, output := range serviceOutputs {
+		if output.Mode == outputs.OutputModeProtobuf {
+			fieldNumbers = append(fieldNumbers, protobuf.ParseFieldNumbers(output.Configuration[astra.IOConfigurationKeyFieldNumbers]))
+		}
+	}
+
+	return fieldNumbers
+}
+
 func init() {
 	generateCmd.Flags().StringVarP(&cacheFile, "cache", "c", cacheFile, "Location of the cache.json file")
 	generateCmd.Flags().StringVarP(&cwd, "dir", "d", cwd, "Current working directory (where main.go is located)")
@@ -4,6 +4,12 @@ import (
 	"github.com/ls6-events/astra"
 	"github.com/ls6-events/astra/inputs"
 	"github.com/ls6-events/astra/outputs"
+	"github.com/ls6-events/astra/outputs/goclient"
+	"github.com/ls6-events/astra/outputs/httpfile"
+	"github.com/ls6-events/astra/outputs/markdown"
+	"github.com/ls6-events/astra/outputs/openapi"
+	"github.com/ls6-events/astra/outputs/postman"
+	"github.com/ls6-events/astra/outputs/protobuf"
 )
 
 // These functions are used to rebind the inputs and outputs to the service, as the JSON unmarshalling does not call the functions to bind the inputs and outputs, and loses all their referenced functions
@@ -60,12 +66,78 @@ func rebindOutputs(s *astra.Service) error {
 				return astra.ErrOutputFilePathRequired
 			}
 			outputs.WithJSONOutput(filePath)(s)
+		case outputs.OutputModeJSONSchema:
+			directoryPath, ok := output.Configuration[astra.IOConfigurationKeyDirectoryPath].(string)
+			if !ok || directoryPath == "" {
+				return astra.ErrOutputDirectoryPathRequired
+			}
+			outputs.WithJSONSchemaOutput(directoryPath)(s)
 		case outputs.OutputModeOpenAPI:
 			filePath, ok := output.Configuration[astra.IOConfigurationKeyFilePath].(string)
 			if !ok || filePath == "" {
 				return astra.ErrOutputFilePathRequired
 			}
-			outputs.WithOpenAPIOutput(filePath)(s)
+			outputs.WithOpenAPIOutput(filePath, rebindOpenAPIOptions(output.Configuration)...)(s)
+		case outputs.OutputModeOpenAPISplit:
+			directoryPath, ok := output.Configuration[astra.IOConfigurationKeyDirectoryPath].(string)
+			if !ok || directoryPath == "" {
+				return astra.ErrOutputDirectoryPathRequired
+			}
+			outputs.WithOpenAPISplitOutput(directoryPath, rebindOpenAPIOptions(output.Configuration)...)(s)
+		case outputs.OutputModeTypeScript:
+			filePath, ok := output.Configuration[astra.IOConfigurationKeyFilePath].(string)
+			if !ok || filePath == "" {
+				return astra.ErrOutputFilePathRequired
+			}
+			outputs.WithTypeScriptOutput(filePath)(s)
+		case outputs.OutputModeTypeScriptClient:
+			filePath, ok := output.Configuration[astra.IOConfigurationKeyFilePath].(string)
+			if !ok || filePath == "" {
+				return astra.ErrOutputFilePathRequired
+			}
+			outputs.WithTypeScriptClientOutput(filePath)(s)
+		case outputs.OutputModeZod:
+			filePath, ok := output.Configuration[astra.IOConfigurationKeyFilePath].(string)
+			if !ok || filePath == "" {
+				return astra.ErrOutputFilePathRequired
+			}
+			outputs.WithZodOutput(filePath)(s)
+		case outputs.OutputModeGoClient:
+			directoryPath, ok := output.Configuration[astra.IOConfigurationKeyDirectoryPath].(string)
+			if !ok || directoryPath == "" {
+				return astra.ErrOutputDirectoryPathRequired
+			}
+			outputs.WithGoClientOutput(directoryPath, rebindGoClientOptions(output.Configuration)...)(s)
+		case outputs.OutputModePostman:
+			filePath, ok := output.Configuration[astra.IOConfigurationKeyFilePath].(string)
+			if !ok || filePath == "" {
+				return astra.ErrOutputFilePathRequired
+			}
+			outputs.WithPostmanOutput(filePath, rebindPostmanOptions(output.Configuration)...)(s)
+		case outputs.OutputModeHTTP:
+			directoryPath, ok := output.Configuration[astra.IOConfigurationKeyDirectoryPath].(string)
+			if !ok || directoryPath == "" {
+				return astra.ErrOutputDirectoryPathRequired
+			}
+			outputs.WithHTTPOutput(directoryPath, rebindHTTPOptions(output.Configuration)...)(s)
+		case outputs.OutputModeMarkdown:
+			filePath, ok := output.Configuration[astra.IOConfigurationKeyFilePath].(string)
+			if !ok || filePath == "" {
+				return astra.ErrOutputFilePathRequired
+			}
+			outputs.WithMarkdownOutput(filePath, rebindMarkdownOptions(output.Configuration)...)(s)
+		case outputs.OutputModeHTML:
+			filePath, ok := output.Configuration[astra.IOConfigurationKeyFilePath].(string)
+			if !ok || filePath == "" {
+				return astra.ErrOutputFilePathRequired
+			}
+			outputs.WithHTMLOutput(filePath)(s)
+		case outputs.OutputModeProtobuf:
+			filePath, ok := output.Configuration[astra.IOConfigurationKeyFilePath].(string)
+			if !ok || filePath == "" {
+				return astra.ErrOutputFilePathRequired
+			}
+			outputs.WithProtobufOutput(filePath, rebindProtobufOptions(output.Configuration)...)(s)
 		default:
 			return astra.ErrOutputModeNotFound
 		}
@@ -73,3 +145,67 @@ func rebindOutputs(s *astra.Service) error {
 
 	return nil
 }
+
+// rebindOpenAPIOptions is used to rebind the options of the OpenAPI outputs from their configuration
+// The options are optional, as caches from before they could be picked don't have them
+func rebindOpenAPIOptions(configuration astra.IOConfiguration) []openapi.Option {
+	version, _ := configuration[astra.IOConfigurationKeyVersion].(string)
+	// The threshold is a number in the cache, which JSON unmarshals as a float
+	componentThreshold, _ := configuration[astra.IOConfigurationKeyComponentThreshold].(float64)
+	splitStrategy, _ := configuration[astra.IOConfigurationKeySplitStrategy].(string)
+
+	return []openapi.Option{
+		openapi.Version(version),
+		openapi.WithComponentThreshold(int(componentThreshold)),
+		openapi.WithSplitStrategy(openapi.SplitStrategy(splitStrategy)),
+	}
+}
+
+// rebindGoClientOptions is used to rebind the options of the Go client output from its configuration
+func rebindGoClientOptions(configuration astra.IOConfiguration) []goclient.Option {
+	packageName, _ := configuration[astra.IOConfigurationKeyPackageName].(string)
+	originalTypes, _ := configuration[astra.IOConfigurationKeyOriginalTypes].(bool)
+
+	return []goclient.Option{
+		goclient.WithPackageName(packageName),
+		goclient.WithOriginalTypes(originalTypes),
+	}
+}
+
+// rebindPostmanOptions is used to rebind the options of the Postman collection output from its configuration
+func rebindPostmanOptions(configuration astra.IOConfiguration) []postman.Option {
+	folderStrategy, _ := configuration[astra.IOConfigurationKeyFolderStrategy].(string)
+
+	return []postman.Option{
+		postman.WithFolderStrategy(postman.FolderStrategy(folderStrategy)),
+	}
+}
+
+// rebindHTTPOptions is used to rebind the options of the HTTP request files output from its configuration
+func rebindHTTPOptions(configuration astra.IOConfiguration) []httpfile.Option {
+	groupStrategy, _ := configuration[astra.IOConfigurationKeyGroupStrategy].(string)
+
+	return []httpfile.Option{
+		httpfile.WithGroupStrategy(httpfile.GroupStrategy(groupStrategy)),
+	}
+}
+
+// rebindMarkdownOptions is used to rebind the options of the Markdown reference output from its configuration
+func rebindMarkdownOptions(configuration astra.IOConfiguration) []markdown.Option {
+	templateFile, _ := configuration[astra.IOConfigurationKeyTemplateFile].(string)
+
+	return []markdown.Option{
+		markdown.WithTemplateFile(templateFile),
+	}
+}
+
+// rebindProtobufOptions is used to rebind the options of the Protocol Buffers output from its configuration
+// The field numbers that were generated are its options, so the fields keep their numbers
+func rebindProtobufOptions(configuration astra.IOConfiguration) []protobuf.Option {
+	packageName, _ := configuration[astra.IOConfigurationKeyPackageName].(string)
+
+	return []protobuf.Option{
+		protobuf.WithPackageName(packageName),
+		protobuf.WithFieldNumbers(protobuf.ParseFieldNumbers(configuration[astra.IOConfigurationKeyFieldNumbers])),
+	}
+}
@@ -13,6 +13,15 @@ type Config struct {
 	Host     string `json:"host"`
 	BasePath string `json:"basePath"`
 	Port     int    `json:"port"`
+
+	// Servers are the servers the API is deployed to, which replace the server from the fields above if there are any
+	Servers []Server `json:"servers,omitempty"`
+
+	// SecuritySchemes are the schemes the routes can be secured by, by their names
+	SecuritySchemes map[string]SecurityScheme `json:"securitySchemes,omitempty"`
+
+	// Extensions are the vendor extensions of the API (i.e. x-logo), by their names
+	Extensions map[string]any `json:"extensions,omitempty"`
 }
 
 type Contact struct {
@@ -30,17 +39,22 @@ type License struct {
 // It will also set default values for some fields.
 func (c *Config) Validate() error {
 	// For now, only the port is required, the rest are set to default values.
+	// The port isn't required if there are servers, as they replace the server from the host and port.
 	if c.Host == "" {
 		c.Host = "localhost"
 	}
-	if c.Port == 0 {
+	if c.Port == 0 && len(c.Servers) == 0 {
 		return ErrConfigPortRequired
 	}
 	if c.BasePath == "" {
 		c.BasePath = "/"
 	}
 
-	return nil
+	if err := c.validateServers(); err != nil {
+		return err
+	}
+
+	return c.validateExtensions()
 }
 
 // SetConfig sets the configuration for the generator.
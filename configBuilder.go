@@ -59,6 +59,21 @@ func (c *ConfigBuilder) SetPort(port int) *ConfigBuilder {
 	return c
 }
 
+func (c *ConfigBuilder) AddServer(server Server) *ConfigBuilder {
+	c.config.AddServer(server)
+	return c
+}
+
+func (c *ConfigBuilder) AddSecurityScheme(name string, scheme SecurityScheme) *ConfigBuilder {
+	c.config.AddSecurityScheme(name, scheme)
+	return c
+}
+
+func (c *ConfigBuilder) AddExtension(name string, value any) *ConfigBuilder {
+	c.config.AddExtension(name, value)
+	return c
+}
+
 func (c *ConfigBuilder) Build() (*Config, error) {
 	if err := c.config.Validate(); err != nil {
 		return nil, err
@@ -117,6 +117,36 @@ func TestConfigBuilder_SetPort(t *testing.T) {
 	require.Equal(t, 8000, configBuilder.config.Port)
 }
 
+func TestConfigBuilder_AddServer(t *testing.T) {
+	configBuilder := NewConfigBuilder()
+
+	require.Empty(t, configBuilder.config.Servers)
+
+	configBuilder.AddServer(Server{URL: "https://api.example.com", Description: "Production"})
+
+	require.Equal(t, []Server{{URL: "https://api.example.com", Description: "Production"}}, configBuilder.config.Servers)
+}
+
+func TestConfigBuilder_AddSecurityScheme(t *testing.T) {
+	configBuilder := NewConfigBuilder()
+
+	require.Empty(t, configBuilder.config.SecuritySchemes)
+
+	configBuilder.AddSecurityScheme("basicAuth", NewBasicSecurityScheme())
+
+	require.Equal(t, NewBasicSecurityScheme(), configBuilder.config.SecuritySchemes["basicAuth"])
+}
+
+func TestConfigBuilder_AddExtension(t *testing.T) {
+	configBuilder := NewConfigBuilder()
+
+	require.Empty(t, configBuilder.config.Extensions)
+
+	configBuilder.AddExtension("x-audience", "public")
+
+	require.Equal(t, "public", configBuilder.config.Extensions["x-audience"])
+}
+
 func TestConfigBuilder_Build(t *testing.T) {
 	t.Run("valid config", func(t *testing.T) {
 		configBuilder := NewConfigBuilder()
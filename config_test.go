@@ -42,6 +42,28 @@ func TestConfig_Validate(t *testing.T) {
 		require.Equal(t, "/", config.BasePath)
 	})
 
+	t.Run("valid config with servers", func(t *testing.T) {
+		config := &Config{
+			Servers: []Server{
+				{URL: "https://api.example.com"},
+			},
+		}
+
+		err := config.Validate()
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid config with servers", func(t *testing.T) {
+		config := &Config{
+			Servers: []Server{
+				{Description: "Production"},
+			},
+		}
+
+		err := config.Validate()
+		require.ErrorIs(t, err, ErrConfigServerURLRequired)
+	})
+
 	t.Run("invalid config", func(t *testing.T) {
 		config := &Config{}
 
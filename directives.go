@@ -0,0 +1,306 @@
+package astra
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/ls6-events/astra/astTraversal"
+)
+
+// The directives that can be written in the doc comment of a handler.
+// They override or add to the metadata of the route, for the things that can't be inferred from the handler itself.
+const (
+	// SummaryDirective sets the summary of the route (i.e. @summary Get a pet).
+	SummaryDirective = "summary"
+	// TagsDirective adds comma separated tags to the route (i.e. @tags pets, store).
+	TagsDirective = "tags"
+	// SecurityDirective adds a security requirement to the route, by the name of the scheme and its scopes (i.e. @security oauth2 read:pets).
+	// Each security directive is an alternative to the others.
+	SecurityDirective = "security"
+	// DeprecatedDirective marks the route as deprecated.
+	DeprecatedDirective = "deprecated"
+	// ResponseDirective adds a response to the route, by its status code, optional type and optional description (i.e. @response 404 ErrorResponse "not found").
+	// It replaces any responses with the same status code that are found from the handler.
+	ResponseDirective = "response"
+	// ParamDirective adds a param to the route, by its name, location, type, whether it is required and optional description (i.e. @param id path int true "the ID of the pet").
+	// It replaces any param with the same name and location that is found from the handler.
+	ParamDirective = "param"
+	// OperationIDDirective sets the operation ID of the route (i.e. @operationId getPet).
+	OperationIDDirective = "operationid"
+	// HiddenDirective hides the route from the outputs.
+	HiddenDirective = "hidden"
+	// WebhookDirective documents the route as a webhook by its name (i.e. @webhook newPet), for the outputs that support webhooks.
+	WebhookDirective = "webhook"
+	// ExtensionDirective adds a vendor extension to the route, by its name and value (i.e. @extension x-ratelimit 100).
+	// The value is the rest of the line, which is parsed as JSON if it is valid JSON (i.e. true or {"limit": 100}).
+	ExtensionDirective = "extension"
+)
+
+// The locations that a param directive can be in.
+const (
+	ParamDirectivePath   = "path"
+	ParamDirectiveQuery  = "query"
+	ParamDirectiveHeader = "header"
+)
+
+// Directives are the directives parsed from the doc comment of a handler.
+type Directives struct {
+	Summary     string
+	Tags        []string
+	Security    []SecurityRequirement
+	Deprecated  bool
+	Responses   []ResponseDirectiveValue
+	Params      []ParamDirectiveValue
+	OperationID string
+	Hidden      bool
+	Webhook     string
+	Extensions  map[string]any
+}
+
+// ResponseDirectiveValue is a response declared by a response directive.
+// The type is written as it is in the source (i.e. []models.Pet), and is empty if the response has no body.
+type ResponseDirectiveValue struct {
+	StatusCode  int
+	Type        string
+	Description string
+}
+
+// ParamDirectiveValue is a param declared by a param directive.
+// The type is written as it is in the source (i.e. int).
+type ParamDirectiveValue struct {
+	Name        string
+	In          string
+	Type        string
+	IsRequired  bool
+	Description string
+}
+
+// ParseDirectives separates the directives (lines starting with @) from the rest of a doc comment.
+// It returns the doc without the directives, the directives that were parsed, and an error for each directive that is unknown or invalid.
+// Invalid directives are skipped, so one mistake doesn't prevent the rest from being applied.
+func ParseDirectives(doc string) (string, Directives, []error) {
+	var directives Directives
+	var errs []error
+
+	lines := strings.Split(doc, "\n")
+	docLines := make([]string, 0, len(lines))
+	for _, line := range lines {
+		name, text, ok := splitDirective(line)
+		if !ok {
+			docLines = append(docLines, line)
+			continue
+		}
+
+		if err := directives.add(name, text); err != nil {
+			errs = append(errs, fmt.Errorf("@%s: %w", name, err))
+		}
+	}
+
+	return strings.TrimSpace(strings.Join(docLines, "\n")), directives, errs
+}
+
+// splitDirective splits a line of a doc comment into the name of the directive and the text following it.
+// It returns false if the line isn't a directive.
+func splitDirective(line string) (string, string, bool) {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, "@") {
+		return "", "", false
+	}
+
+	name, text, _ := strings.Cut(line[1:], " ")
+	if name == "" || strings.ContainsFunc(name, func(r rune) bool { return !unicode.IsLetter(r) }) {
+		return "", "", false
+	}
+
+	return name, strings.TrimSpace(text), true
+}
+
+// add validates a directive and adds it to the directives.
+func (d *Directives) add(name string, text string) error {
+	args, err := splitDirectiveArgs(text)
+	if err != nil {
+		return err
+	}
+
+	switch strings.ToLower(name) {
+	case SummaryDirective:
+		if text == "" {
+			return ErrDirectiveMissingArguments
+		}
+		d.Summary = text
+	case TagsDirective:
+		var tags []string
+		for _, tag := range strings.Split(text, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+		if len(tags) == 0 {
+			return ErrDirectiveMissingArguments
+		}
+		d.Tags = append(d.Tags, tags...)
+	case SecurityDirective:
+		if len(args) == 0 {
+			return ErrDirectiveMissingArguments
+		}
+		d.Security = append(d.Security, SecurityRequirement{
+			args[0]: append([]string{}, args[1:]...),
+		})
+	case DeprecatedDirective:
+		d.Deprecated = true
+	case ResponseDirective:
+		response, err := parseResponseDirective(args)
+		if err != nil {
+			return err
+		}
+		d.Responses = append(d.Responses, response)
+	case ParamDirective:
+		param, err := parseParamDirective(args)
+		if err != nil {
+			return err
+		}
+		d.Params = append(d.Params, param)
+	case OperationIDDirective:
+		if len(args) == 0 {
+			return ErrDirectiveMissingArguments
+		}
+		if len(args) > 1 {
+			return fmt.Errorf("%w: unexpected %s", ErrDirectiveInvalidArgument, args[1])
+		}
+		d.OperationID = args[0]
+	case HiddenDirective:
+		d.Hidden = true
+	case WebhookDirective:
+		if len(args) == 0 {
+			return ErrDirectiveMissingArguments
+		}
+		if len(args) > 1 {
+			return fmt.Errorf("%w: unexpected %s", ErrDirectiveInvalidArgument, args[1])
+		}
+		d.Webhook = args[0]
+	case ExtensionDirective:
+		name, value, _ := strings.Cut(text, " ")
+		value = strings.TrimSpace(value)
+		if name == "" || value == "" {
+			return ErrDirectiveMissingArguments
+		}
+		if !strings.HasPrefix(name, astTraversal.ExtensionPrefix) {
+			return fmt.Errorf("%w: extension name %s doesn't start with %s", ErrDirectiveInvalidArgument, name, astTraversal.ExtensionPrefix)
+		}
+		if d.Extensions == nil {
+			d.Extensions = make(map[string]any)
+		}
+		d.Extensions[name] = ParseExtensionValue(value)
+	default:
+		return ErrDirectiveUnknown
+	}
+
+	return nil
+}
+
+// parseResponseDirective parses the arguments of a response directive: the status code, then the optional type and description.
+// The description is always quoted, which distinguishes it from the type.
+func parseResponseDirective(args []string) (ResponseDirectiveValue, error) {
+	if len(args) == 0 {
+		return ResponseDirectiveValue{}, ErrDirectiveMissingArguments
+	}
+
+	statusCode, err := strconv.Atoi(args[0])
+	if err != nil || statusCode < 100 || statusCode > 599 {
+		return ResponseDirectiveValue{}, fmt.Errorf("%w: invalid status code %s", ErrDirectiveInvalidArgument, args[0])
+	}
+
+	response := ResponseDirectiveValue{
+		StatusCode: statusCode,
+	}
+	for _, arg := range args[1:] {
+		if description, ok := unquoteDirectiveArg(arg); ok {
+			response.Description = description
+		} else if response.Type == "" && response.Description == "" {
+			response.Type = arg
+		} else {
+			return ResponseDirectiveValue{}, fmt.Errorf("%w: unexpected %s", ErrDirectiveInvalidArgument, arg)
+		}
+	}
+
+	return response, nil
+}
+
+// parseParamDirective parses the arguments of a param directive: the name, location, type and whether it is required, then the optional description.
+func parseParamDirective(args []string) (ParamDirectiveValue, error) {
+	if len(args) < 4 {
+		return ParamDirectiveValue{}, ErrDirectiveMissingArguments
+	}
+	if len(args) > 5 {
+		return ParamDirectiveValue{}, fmt.Errorf("%w: unexpected %s", ErrDirectiveInvalidArgument, args[5])
+	}
+
+	param := ParamDirectiveValue{
+		Name: args[0],
+		In:   strings.ToLower(args[1]),
+		Type: args[2],
+	}
+
+	switch param.In {
+	case ParamDirectivePath, ParamDirectiveQuery, ParamDirectiveHeader:
+	default:
+		return ParamDirectiveValue{}, fmt.Errorf("%w: invalid location %s", ErrDirectiveInvalidArgument, args[1])
+	}
+
+	isRequired, err := strconv.ParseBool(args[3])
+	if err != nil {
+		return ParamDirectiveValue{}, fmt.Errorf("%w: invalid required %s", ErrDirectiveInvalidArgument, args[3])
+	}
+	param.IsRequired = isRequired
+
+	if len(args) == 5 {
+		description, ok := unquoteDirectiveArg(args[4])
+		if !ok {
+			return ParamDirectiveValue{}, fmt.Errorf("%w: unquoted description %s", ErrDirectiveInvalidArgument, args[4])
+		}
+		param.Description = description
+	}
+
+	return param, nil
+}
+
+// splitDirectiveArgs splits the text of a directive into its arguments by whitespace, keeping quoted arguments (with their quotes) together.
+func splitDirectiveArgs(text string) ([]string, error) {
+	var args []string
+	var current strings.Builder
+	inQuotes := false
+	for _, r := range text {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			current.WriteRune(r)
+		case unicode.IsSpace(r) && !inQuotes:
+			if current.Len() > 0 {
+				args = append(args, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if inQuotes {
+		return nil, fmt.Errorf("%w: unterminated quote", ErrDirectiveInvalidArgument)
+	}
+	if current.Len() > 0 {
+		args = append(args, current.String())
+	}
+
+	return args, nil
+}
+
+// unquoteDirectiveArg removes the quotes from a quoted argument.
+// It returns false if the argument isn't quoted.
+func unquoteDirectiveArg(arg string) (string, bool) {
+	if len(arg) < 2 || !strings.HasPrefix(arg, "\"") || !strings.HasSuffix(arg, "\"") {
+		return "", false
+	}
+
+	return arg[1 : len(arg)-1], true
+}
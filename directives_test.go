@@ -0,0 +1,140 @@
+package astra
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseDirectives(t *testing.T) {
+	t.Run("No Directives", func(t *testing.T) {
+		doc, directives, errs := ParseDirectives("getPet gets a pet.\nIt is found by its ID.")
+		require.Equal(t, "getPet gets a pet.\nIt is found by its ID.", doc)
+		require.Equal(t, Directives{}, directives)
+		require.Empty(t, errs)
+	})
+
+	t.Run("Separates Directives From Doc", func(t *testing.T) {
+		doc, directives, errs := ParseDirectives(`getPet gets a pet.
+@summary Get a pet
+@tags pets, store
+@security bearerAuth
+@security oauth2 read:pets write:pets
+@deprecated
+@operationId findPet
+@hidden
+@webhook newPet
+It is found by its ID.`)
+		require.Empty(t, errs)
+		require.Equal(t, "getPet gets a pet.\nIt is found by its ID.", doc)
+		require.Equal(t, Directives{
+			Summary: "Get a pet",
+			Tags:    []string{"pets", "store"},
+			Security: []SecurityRequirement{
+				{"bearerAuth": {}},
+				{"oauth2": {"read:pets", "write:pets"}},
+			},
+			Deprecated:  true,
+			OperationID: "findPet",
+			Hidden:      true,
+			Webhook:     "newPet",
+		}, directives)
+	})
+
+	t.Run("Names Are Case Insensitive", func(t *testing.T) {
+		_, directives, errs := ParseDirectives("@Summary Get a pet\n@OperationID findPet")
+		require.Empty(t, errs)
+		require.Equal(t, "Get a pet", directives.Summary)
+		require.Equal(t, "findPet", directives.OperationID)
+	})
+
+	t.Run("Response Directives", func(t *testing.T) {
+		_, directives, errs := ParseDirectives(`@response 404 ErrorResponse "not found"
+@response 200 []models.Pet
+@response 204 "no content"
+@response 500`)
+		require.Empty(t, errs)
+		require.Equal(t, []ResponseDirectiveValue{
+			{StatusCode: 404, Type: "ErrorResponse", Description: "not found"},
+			{StatusCode: 200, Type: "[]models.Pet"},
+			{StatusCode: 204, Description: "no content"},
+			{StatusCode: 500},
+		}, directives.Responses)
+	})
+
+	t.Run("Param Directives", func(t *testing.T) {
+		_, directives, errs := ParseDirectives(`@param id path int true "the ID of the pet"
+@param tags query []string false
+@param X-Request-ID HEADER string true`)
+		require.Empty(t, errs)
+		require.Equal(t, []ParamDirectiveValue{
+			{Name: "id", In: ParamDirectivePath, Type: "int", IsRequired: true, Description: "the ID of the pet"},
+			{Name: "tags", In: ParamDirectiveQuery, Type: "[]string"},
+			{Name: "X-Request-ID", In: ParamDirectiveHeader, Type: "string", IsRequired: true},
+		}, directives.Params)
+	})
+
+	t.Run("Extension Directives", func(t *testing.T) {
+		_, directives, errs := ParseDirectives(`@extension x-ratelimit 100
+@extension x-internal true
+@extension x-owner team pets
+@extension x-codegen {"skip": false}`)
+		require.Empty(t, errs)
+		require.Equal(t, map[string]any{
+			"x-ratelimit": float64(100),
+			"x-internal":  true,
+			"x-owner":     "team pets",
+			"x-codegen":   map[string]any{"skip": false},
+		}, directives.Extensions)
+	})
+
+	t.Run("Invalid Directives Are Skipped", func(t *testing.T) {
+		doc, directives, errs := ParseDirectives(`getPet gets a pet.
+@sumary Get a pet
+@summary
+@response abc ErrorResponse
+@response 404 ErrorResponse "not found" extra
+@param id body int true
+@param id path int yes
+@param id path int
+@operationId find pet
+@tags ,
+@security
+@response 404 "unterminated
+@webhook
+@webhook new pet
+@extension ratelimit 100
+@extension x-ratelimit
+@response 404 ErrorResponse "not found"`)
+		require.Equal(t, "getPet gets a pet.", doc)
+		require.Equal(t, Directives{
+			Responses: []ResponseDirectiveValue{
+				{StatusCode: 404, Type: "ErrorResponse", Description: "not found"},
+			},
+		}, directives)
+
+		require.Len(t, errs, 15)
+		require.ErrorIs(t, errs[0], ErrDirectiveUnknown)
+		require.ErrorIs(t, errs[1], ErrDirectiveMissingArguments)
+		require.ErrorIs(t, errs[2], ErrDirectiveInvalidArgument)
+		require.ErrorIs(t, errs[3], ErrDirectiveInvalidArgument)
+		require.ErrorIs(t, errs[4], ErrDirectiveInvalidArgument)
+		require.ErrorIs(t, errs[5], ErrDirectiveInvalidArgument)
+		require.ErrorIs(t, errs[6], ErrDirectiveMissingArguments)
+		require.ErrorIs(t, errs[7], ErrDirectiveInvalidArgument)
+		require.ErrorIs(t, errs[8], ErrDirectiveMissingArguments)
+		require.ErrorIs(t, errs[9], ErrDirectiveMissingArguments)
+		require.ErrorIs(t, errs[10], ErrDirectiveInvalidArgument)
+		require.ErrorIs(t, errs[11], ErrDirectiveMissingArguments)
+		require.ErrorIs(t, errs[12], ErrDirectiveInvalidArgument)
+		require.ErrorIs(t, errs[13], ErrDirectiveInvalidArgument)
+		require.ErrorIs(t, errs[14], ErrDirectiveMissingArguments)
+		require.ErrorContains(t, errs[0], "@sumary")
+	})
+
+	t.Run("Lines That Aren't Directives Are Kept", func(t *testing.T) {
+		doc, directives, errs := ParseDirectives("Contact @ the team.\n@ mentions aren't directives.\n@v2 neither.")
+		require.Empty(t, errs)
+		require.Equal(t, Directives{}, directives)
+		require.Equal(t, "Contact @ the team.\n@ mentions aren't directives.\n@v2 neither.", doc)
+	})
+}
@@ -7,9 +7,18 @@ var (
 	ErrConfigNotFound     = errors.New("config not found")
 	ErrConfigPortRequired = errors.New("config port is required")
 
+	ErrConfigServerURLRequired              = errors.New("config server url is required")
+	ErrConfigServerVariableDefaultNotInEnum = errors.New("config server variable default is not in its enum")
+
+	ErrConfigExtensionNameInvalid = errors.New("config extension name must start with x-")
+
 	ErrInputModeNotFound = errors.New("input mode not found")
 
 	ErrOutputModeNotFound          = errors.New("output mode not found")
 	ErrOutputFilePathRequired      = errors.New("output file path is required")
 	ErrOutputDirectoryPathRequired = errors.New("output directory path is required")
+
+	ErrDirectiveUnknown          = errors.New("unknown directive")
+	ErrDirectiveMissingArguments = errors.New("directive is missing arguments")
+	ErrDirectiveInvalidArgument  = errors.New("directive has an invalid argument")
 )
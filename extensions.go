@@ -0,0 +1,98 @@
+package astra
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ls6-events/astra/astTraversal"
+)
+
+// ExtensionFunc is a custom function that returns the vendor extensions of a route, by their names.
+type ExtensionFunc func(Route) map[string]any
+
+// WithExtensionFunc is an option to add vendor extensions to the routes by a custom function.
+// Extensions that are added by the @extension directive keep their values instead.
+// The function can't be cached, so it isn't used when the routes are parsed by the CLI.
+func WithExtensionFunc(extensionFunc ExtensionFunc) Option {
+	return func(s *Service) {
+		s.ExtensionFunc = extensionFunc
+	}
+}
+
+// WithGoTypeExtensions is an option to add the Go type and package of each component to it, as the x-go-type and x-go-package extensions.
+func WithGoTypeExtensions() Option {
+	return func(s *Service) {
+		s.GoTypeExtensions = true
+	}
+}
+
+// AddExtension adds a vendor extension of the API to the config by its name (i.e. x-logo).
+func (c *Config) AddExtension(name string, value any) *Config {
+	if c.Extensions == nil {
+		c.Extensions = make(map[string]any)
+	}
+
+	c.Extensions[name] = value
+	return c
+}
+
+// validateExtensions validates the names of the vendor extensions of the config.
+func (c *Config) validateExtensions() error {
+	for name := range c.Extensions {
+		if !strings.HasPrefix(name, astTraversal.ExtensionPrefix) {
+			return fmt.Errorf("%w: %s", ErrConfigExtensionNameInvalid, name)
+		}
+	}
+
+	return nil
+}
+
+// ExtendRoutes adds the vendor extensions from the extension function to the routes.
+func (s *Service) ExtendRoutes() {
+	if s.ExtensionFunc == nil {
+		return
+	}
+
+	for i, route := range s.Routes {
+		for name, value := range s.ExtensionFunc(route) {
+			if !strings.HasPrefix(name, astTraversal.ExtensionPrefix) {
+				s.Log.Warn().Str("extension", name).Str("path", route.Path).Str("method", route.Method).Msg("Extension name doesn't start with x-")
+				continue
+			}
+			if _, ok := route.Extensions[name]; ok {
+				continue
+			}
+
+			if s.Routes[i].Extensions == nil {
+				s.Routes[i].Extensions = make(map[string]any)
+			}
+			s.Routes[i].Extensions[name] = value
+		}
+	}
+}
+
+// ParseExtensionValue parses the value of a vendor extension written in a struct tag or directive.
+// Values that are valid JSON (i.e. true, 100 or {"db":"id"}) are the values they encode, and anything else is a string.
+func ParseExtensionValue(value string) any {
+	var parsed any
+	if err := json.Unmarshal([]byte(value), &parsed); err != nil {
+		return value
+	}
+
+	return parsed
+}
+
+// ParseExtensionValues parses the values of vendor extensions written in struct tags or directives, by their names.
+func ParseExtensionValues(values map[string]string) map[string]any {
+	if len(values) == 0 {
+		return nil
+	}
+
+	extensions := make(map[string]any, len(values))
+	for name, value := range values {
+		extensions[name] = ParseExtensionValue(value)
+	}
+
+	return extensions
+}
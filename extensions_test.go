@@ -0,0 +1,91 @@
+package astra
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestWithExtensionFunc(t *testing.T) {
+	service := &Service{}
+
+	WithExtensionFunc(func(route Route) map[string]any {
+		return map[string]any{"x-method": route.Method}
+	})(service)
+
+	require.NotNil(t, service.ExtensionFunc)
+}
+
+func TestWithGoTypeExtensions(t *testing.T) {
+	service := &Service{}
+
+	WithGoTypeExtensions()(service)
+
+	require.True(t, service.GoTypeExtensions)
+}
+
+func TestConfig_AddExtension(t *testing.T) {
+	config := &Config{}
+
+	config.AddExtension("x-logo", map[string]any{"url": "https://example.com/logo.png"}).
+		AddExtension("x-audience", "public")
+
+	require.Equal(t, map[string]any{
+		"x-logo":     map[string]any{"url": "https://example.com/logo.png"},
+		"x-audience": "public",
+	}, config.Extensions)
+}
+
+func TestConfig_ValidateExtensions(t *testing.T) {
+	t.Run("valid extensions", func(t *testing.T) {
+		config := &Config{Extensions: map[string]any{"x-audience": "public"}}
+
+		require.NoError(t, config.validateExtensions())
+	})
+
+	t.Run("extension without prefix", func(t *testing.T) {
+		config := &Config{Extensions: map[string]any{"audience": "public"}}
+
+		require.ErrorIs(t, config.validateExtensions(), ErrConfigExtensionNameInvalid)
+	})
+}
+
+func TestService_ExtendRoutes(t *testing.T) {
+	service := &Service{
+		Routes: []Route{
+			{Method: "GET", Path: "/pets"},
+			{Method: "POST", Path: "/pets", Extensions: map[string]any{"x-ratelimit": float64(10)}},
+		},
+		ExtensionFunc: func(route Route) map[string]any {
+			return map[string]any{
+				"x-ratelimit": 100,
+				"x-internal":  route.Method == "POST",
+				"ratelimit":   100,
+			}
+		},
+	}
+
+	service.ExtendRoutes()
+
+	require.Equal(t, map[string]any{"x-ratelimit": 100, "x-internal": false}, service.Routes[0].Extensions)
+	require.Equal(t, map[string]any{"x-ratelimit": float64(10), "x-internal": true}, service.Routes[1].Extensions)
+}
+
+func TestParseExtensionValue(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected any
+	}{
+		{value: "100", expected: float64(100)},
+		{value: "true", expected: true},
+		{value: `{"db":"id"}`, expected: map[string]any{"db": "id"}},
+		{value: `["a","b"]`, expected: []any{"a", "b"}},
+		{value: `"quoted"`, expected: "quoted"},
+		{value: "uuid.UUID", expected: "uuid.UUID"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.value, func(t *testing.T) {
+			require.Equal(t, testCase.expected, ParseExtensionValue(testCase.value))
+		})
+	}
+}
@@ -9,10 +9,10 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-// createRoute creates a route from a gin RouteInfo.
+// createRoute creates a route from a gin RouteInfo, and the middleware it is handled by.
 // It will only create the route and refer to the handler function by name, file and line number.
 // The route will be populated later by parseRoute.
-func createRoute(s *astra.Service, file string, line int, info gin.RouteInfo) error {
+func createRoute(s *astra.Service, file string, line int, info gin.RouteInfo, middleware []string) error {
 	log := s.Log.With().Str("path", info.Path).Str("method", info.Method).Str("handler", info.Handler).Logger()
 
 	cwd, err := os.Getwd()
@@ -33,6 +33,7 @@ func createRoute(s *astra.Service, file string, line int, info gin.RouteInfo) er
 		LineNo:      line,
 		Path:        info.Path,
 		Method:      info.Method,
+		Middleware:  middleware,
 		PathParams:  make([]astra.Param, 0),
 		Body:        make([]astra.BodyParam, 0),
 		QueryParams: make([]astra.Param, 0),
@@ -76,6 +76,7 @@ func loadRouteIndex() map[string]routeIndexLocation {
 func CreateRoutes(router *gin.Engine) astra.ServiceFunction {
 	return func(s *astra.Service) error {
 		s.Log.Debug().Msg("Populating service with gin routes")
+		routeMiddleware := findRouteMiddleware(router)
 		for _, route := range router.Routes() {
 			s.Log.Debug().Str("path", route.Path).Str("method", route.Method).Msg("Populating route")
 
@@ -106,7 +107,7 @@ func CreateRoutes(router *gin.Engine) astra.ServiceFunction {
 			s.Log.Debug().Str("path", route.Path).Str("method", route.Method).Str("file", file).Int("line", line).Msg("Found route handler")
 
 			s.Log.Debug().Str("path", route.Path).Str("method", route.Method).Str("file", file).Int("line", line).Msg("Parsing route")
-			err := createRoute(s, file, line, route)
+			err := createRoute(s, file, line, route, routeMiddleware[route.Method+" "+route.Path])
 			if err != nil {
 				s.Log.Error().Str("path", route.Path).Str("method", route.Method).Str("file", file).Int("line", line).Err(err).Msg("Failed to parse route")
 				return err
@@ -0,0 +1,171 @@
+package gin
+
+import (
+	"fmt"
+	"go/types"
+	"slices"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
+)
+
+// applyDirectives applies the directives from the doc comment of a handler to its route.
+// They are applied once the handler has been parsed, as they override what is found from it.
+// The types of responses and params are resolved as if they were written in the handler, so they can use the imports of its file.
+// Directives that can't be applied are logged and skipped.
+func applyDirectives(traverser *astTraversal.BaseTraverser, funcTraverser *astTraversal.FunctionTraverser, route *astra.Route, directives astra.Directives) {
+	log := traverser.Log
+
+	if directives.Summary != "" {
+		route.Summary = directives.Summary
+	}
+	for _, tag := range directives.Tags {
+		if !slices.Contains(route.Tags, tag) {
+			route.Tags = append(route.Tags, tag)
+		}
+	}
+	route.Security = append(route.Security, directives.Security...)
+	if directives.Deprecated {
+		route.Deprecated = true
+	}
+	if directives.OperationID != "" {
+		route.OperationID = directives.OperationID
+	}
+	if directives.Hidden {
+		route.Hidden = true
+	}
+	if directives.Webhook != "" {
+		route.Webhook = directives.Webhook
+	}
+	for name, value := range directives.Extensions {
+		if route.Extensions == nil {
+			route.Extensions = make(map[string]any)
+		}
+		route.Extensions[name] = value
+	}
+
+	// The responses declared for a status code replace those found for it
+	declaredStatusCodes := make([]int, 0, len(directives.Responses))
+	for _, response := range directives.Responses {
+		declaredStatusCodes = append(declaredStatusCodes, response.StatusCode)
+	}
+	route.ReturnTypes = slices.DeleteFunc(route.ReturnTypes, func(returnType astra.ReturnType) bool {
+		return slices.Contains(declaredStatusCodes, returnType.StatusCode)
+	})
+	for _, response := range directives.Responses {
+		returnType := astra.ReturnType{
+			StatusCode: response.StatusCode,
+			Field: astra.Field{
+				Type: "nil",
+			},
+			Description: response.Description,
+		}
+
+		if response.Type != "" {
+			result, err := directiveTypeResult(traverser, funcTraverser, response.Type)
+			if err != nil {
+				if log != nil {
+					log.Warn().Err(err).Int("statusCode", response.StatusCode).Str("type", response.Type).Msg("Failed to resolve the type of the @response directive")
+				}
+				continue
+			}
+
+			returnType.ContentType = "application/json"
+			returnType.Field = astra.ParseResultToField(result)
+		}
+
+		route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+	}
+
+	for _, paramDirective := range directives.Params {
+		param, err := directiveParam(traverser, funcTraverser, paramDirective)
+		if err != nil {
+			if log != nil {
+				log.Warn().Err(err).Str("param", paramDirective.Name).Str("type", paramDirective.Type).Msg("Failed to resolve the type of the @param directive")
+			}
+			continue
+		}
+
+		switch paramDirective.In {
+		case astra.ParamDirectivePath:
+			// The path is the source of truth for which path params exist and whether they are required
+			index := slices.IndexFunc(route.PathParams, func(pathParam astra.Param) bool {
+				return pathParam.Name == param.Name && !pathParam.IsBound
+			})
+			if index == -1 {
+				if log != nil {
+					log.Warn().Str("param", param.Name).Str("path", route.Path).Msg("The @param directive is for a path param that isn't in the path")
+				}
+				continue
+			}
+			param.IsRequired = route.PathParams[index].IsRequired
+			route.PathParams[index] = param
+		case astra.ParamDirectiveQuery:
+			route.QueryParams = replaceParam(route.QueryParams, param)
+		case astra.ParamDirectiveHeader:
+			route.RequestHeaders = replaceParam(route.RequestHeaders, param)
+		}
+	}
+}
+
+// directiveParam creates the param declared by a param directive.
+// Slices and maps are the arrays and maps of their element type, the same as the params that are found from the handler.
+func directiveParam(traverser *astTraversal.BaseTraverser, funcTraverser *astTraversal.FunctionTraverser, paramDirective astra.ParamDirectiveValue) (astra.Param, error) {
+	paramType, err := evalDirectiveType(funcTraverser, paramDirective.Type)
+	if err != nil {
+		return astra.Param{}, err
+	}
+
+	param := astra.Param{
+		Name:       paramDirective.Name,
+		IsRequired: paramDirective.IsRequired,
+		Doc:        paramDirective.Description,
+	}
+	switch t := paramType.(type) {
+	case *types.Slice:
+		param.IsArray = true
+		paramType = t.Elem()
+	case *types.Map:
+		param.IsMap = true
+		paramType = t.Elem()
+	}
+
+	result, err := traverser.Type(paramType, funcTraverser.File.Package).Result()
+	if err != nil {
+		return astra.Param{}, err
+	}
+	param.Field = astra.ParseResultToField(result)
+
+	return param, nil
+}
+
+// directiveTypeResult resolves a type written in a directive.
+func directiveTypeResult(traverser *astTraversal.BaseTraverser, funcTraverser *astTraversal.FunctionTraverser, typeName string) (astTraversal.Result, error) {
+	directiveType, err := evalDirectiveType(funcTraverser, typeName)
+	if err != nil {
+		return astTraversal.Result{}, err
+	}
+
+	return traverser.Type(directiveType, funcTraverser.File.Package).Result()
+}
+
+// evalDirectiveType evaluates a type written in a directive, as if it were written in the body of the handler.
+func evalDirectiveType(funcTraverser *astTraversal.FunctionTraverser, typeName string) (types.Type, error) {
+	if funcTraverser.File == nil || funcTraverser.File.Package == nil {
+		return nil, fmt.Errorf("package of %s not found", typeName)
+	}
+
+	return funcTraverser.File.Package.EvalType(typeName, funcTraverser.Node.Body.Pos())
+}
+
+// replaceParam replaces the param with the same name, or adds it if there is none.
+func replaceParam(params []astra.Param, param astra.Param) []astra.Param {
+	for i, existingParam := range params {
+		if existingParam.Name == param.Name {
+			params[i] = param
+			return params
+		}
+	}
+
+	return append(params, param)
+}
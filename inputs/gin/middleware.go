@@ -0,0 +1,63 @@
+package gin
+
+import (
+	"reflect"
+	"runtime"
+
+	"github.com/gin-gonic/gin"
+)
+
+// findRouteMiddleware finds the function names of the middleware of every route, by its method and path (i.e. "GET /pets").
+// Gin only exposes the last handler of a route, so the middleware is found from the handler chains in the trees of the engine.
+// If the trees can't be read (i.e. gin has changed them), no middleware is found.
+func findRouteMiddleware(router *gin.Engine) map[string][]string {
+	middleware := make(map[string][]string)
+
+	trees := reflect.ValueOf(router).Elem().FieldByName("trees")
+	if !trees.IsValid() || trees.Kind() != reflect.Slice {
+		return middleware
+	}
+
+	for i := 0; i < trees.Len(); i++ {
+		tree := trees.Index(i)
+		method := tree.FieldByName("method")
+		root := tree.FieldByName("root")
+		if method.Kind() != reflect.String || root.Kind() != reflect.Pointer {
+			continue
+		}
+
+		findNodeMiddleware(method.String(), root, middleware)
+	}
+
+	return middleware
+}
+
+// findNodeMiddleware finds the middleware of the routes of a node in a tree, and those of its children.
+// The last handler in the chain of a route is its handler, so the rest are its middleware.
+func findNodeMiddleware(method string, node reflect.Value, middleware map[string][]string) {
+	if node.IsNil() {
+		return
+	}
+	node = node.Elem()
+
+	fullPath := node.FieldByName("fullPath")
+	handlers := node.FieldByName("handlers")
+	if fullPath.Kind() == reflect.String && handlers.Kind() == reflect.Slice && handlers.Len() > 1 {
+		names := make([]string, 0, handlers.Len()-1)
+		for i := 0; i < handlers.Len()-1; i++ {
+			runtimeFunc := runtime.FuncForPC(handlers.Index(i).Pointer())
+			if runtimeFunc != nil {
+				names = append(names, runtimeFunc.Name())
+			}
+		}
+		middleware[method+" "+fullPath.String()] = names
+	}
+
+	children := node.FieldByName("children")
+	if children.Kind() != reflect.Slice {
+		return
+	}
+	for i := 0; i < children.Len(); i++ {
+		findNodeMiddleware(method, children.Index(i), middleware)
+	}
+}
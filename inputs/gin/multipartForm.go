@@ -0,0 +1,98 @@
+package gin
+
+import (
+	"go/ast"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
+)
+
+const (
+	// multipartFormContentType is the content type of the request body read by c.MultipartForm and c.FormFile.
+	multipartFormContentType = "multipart/form-data"
+	// multipartFormFileField is the field of multipart.Form containing the file parts.
+	multipartFormFileField = "File"
+	// multipartFormValueField is the field of multipart.Form containing the value parts.
+	multipartFormValueField = "Value"
+)
+
+// findMultipartFormParts finds the parts that are read from the form returned by a c.MultipartForm call.
+// The parts are found by indexing the File and Value maps of the form with a constant key (i.e. form.File["upload"]).
+// If the form is not assigned to a variable, or no parts are read, a single part is returned that accepts any file.
+func findMultipartFormParts(traverser *astTraversal.BaseTraverser, body *ast.BlockStmt, call *ast.CallExpr) []astra.BodyParam {
+	var formObj *ast.Object
+	ast.Inspect(body, func(n ast.Node) bool {
+		if assignStmt, ok := n.(*ast.AssignStmt); ok && len(assignStmt.Rhs) == 1 && assignStmt.Rhs[0] == call {
+			if ident, ok := assignStmt.Lhs[0].(*ast.Ident); ok && ident.Obj != nil {
+				formObj = ident.Obj
+			}
+			return false
+		}
+
+		return formObj == nil
+	})
+
+	parts := make([]astra.BodyParam, 0)
+	if formObj != nil {
+		seen := make(map[string]bool)
+		ast.Inspect(body, func(n ast.Node) bool {
+			indexExpr, ok := n.(*ast.IndexExpr)
+			if !ok {
+				return true
+			}
+
+			selectorExpr, ok := indexExpr.X.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+
+			ident, ok := selectorExpr.X.(*ast.Ident)
+			if !ok || ident.Obj != formObj {
+				return true
+			}
+
+			name, err := traverser.Expression(indexExpr.Index).Value()
+			if err != nil || name == "" || seen[selectorExpr.Sel.Name+"."+name] {
+				return true
+			}
+
+			switch selectorExpr.Sel.Name {
+			case multipartFormFileField:
+				parts = append(parts, astra.BodyParam{
+					ContentType: multipartFormContentType,
+					Field: astra.Field{
+						Type: "file",
+					},
+					Name:    name,
+					IsArray: true,
+				})
+			case multipartFormValueField:
+				parts = append(parts, astra.BodyParam{
+					ContentType: multipartFormContentType,
+					Field: astra.Field{
+						Type: "string",
+					},
+					Name:    name,
+					IsArray: true,
+				})
+			default:
+				return true
+			}
+			seen[selectorExpr.Sel.Name+"."+name] = true
+
+			return true
+		})
+	}
+
+	if len(parts) == 0 {
+		parts = append(parts, astra.BodyParam{
+			ContentType: multipartFormContentType,
+			Field: astra.Field{
+				Type: "file",
+			},
+			IsMap: true,
+		})
+	}
+
+	return parts
+}
@@ -20,13 +20,23 @@ const (
 	GinContextIsPointer = true
 )
 
+// callSite is the context of the call to a function that is being parsed.
+type callSite struct {
+	// Headers are the response headers that have been set before the function is called.
+	Headers []astra.Param
+	// Condition is the condition that leads to the function being called.
+	Condition string
+}
+
 // parseFunction parses a function and adds it to the service.
 // It is designed to be called recursively should it be required.
 // The level parameter is used to determine the depth of recursion.
 // And the package name and path are used to determine the package of the currently analysed function.
 // The currRoute reference is used to manipulate the current route being analysed.
 // The imports are used to determine the package of the context variable.
-func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTraverser, currRoute *astra.Route, activeFile *astTraversal.FileNode, level int) error {
+// The call site is the context that the function is called in, which applies to the responses written within it.
+// It returns the response headers that the function sets unconditionally, so they precede the responses written after it.
+func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTraverser, currRoute *astra.Route, activeFile *astTraversal.FileNode, level int, site callSite) ([]astra.Param, error) {
 	if funcTraverser == nil || funcTraverser.Node == nil || funcTraverser.Node.Body == nil {
 		if funcTraverser != nil && funcTraverser.Traverser != nil && funcTraverser.Traverser.Log != nil {
 			fileName := ""
@@ -38,7 +48,7 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 				Str("file", fileName).
 				Msg("Function body is nil")
 		}
-		return errors.New("function body is nil")
+		return nil, errors.New("function body is nil")
 	}
 	traverser := funcTraverser.Traverser
 
@@ -58,13 +68,21 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 		funcName = funcTraverser.Name()
 	}
 
+	// The directives in the doc comment of the handler are applied once it has been parsed, as they override what is found from it
+	var directives astra.Directives
 	if level == 0 {
 		funcDoc, err := funcTraverser.Doc()
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if funcDoc != "" {
-			currRoute.Doc = strings.TrimSpace(funcDoc)
+			var directiveErrs []error
+			currRoute.Doc, directives, directiveErrs = astra.ParseDirectives(strings.TrimSpace(funcDoc))
+			for _, directiveErr := range directiveErrs {
+				if log != nil {
+					log.Warn().Err(directiveErr).Str("func", funcName).Msg("Invalid directive in handler doc comment")
+				}
+			}
 		}
 		if log != nil {
 			log.Info().
@@ -88,7 +106,41 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 				Str("file", fileName).
 				Msg("Context argument not found in function")
 		}
-		return errors.New("failed to find context variable name")
+		return nil, errors.New("failed to find context variable name")
+	}
+
+	// Raw string values (path and query params) are typed by the conversions applied to them
+	conversions := findValueConversions(traverser, funcTraverser.Node.Body)
+	// Response headers are attached to the responses written after they are set
+	headers := newResponseHeaders(funcTraverser.Node.Body, site.Headers)
+
+	// describeResponse describes when the response written at the node is written, by the condition leading to it
+	describeResponse := func(node ast.Node) string {
+		if condition := responseCondition(traverser, funcTraverser.Node.Body, node); condition != "" {
+			return condition
+		}
+
+		return site.Condition
+	}
+
+	// setResponseHeader records a response header, from a call taking the name and value of the header as its arguments
+	setResponseHeader := func(callExpr *astTraversal.CallExpressionTraverser) func(*astra.Route, []any) (*astra.Route, error) {
+		return func(route *astra.Route, params []any) (*astra.Route, error) {
+			name, ok := params[0].(string)
+			if !ok {
+				return nil, errors.New("failed to parse name")
+			}
+
+			param, ok := responseHeaderParam(traverser, name, callExpr.Node.Args[1])
+			if !ok {
+				return route, nil
+			}
+
+			headers.Add(callExpr.Node, param)
+			route.ResponseHeaders = astra.AddHeader(route.ResponseHeaders, param)
+
+			return route, nil
+		}
 	}
 
 	var err error
@@ -154,7 +206,27 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 				return true
 			}
 
-			err = parseFunction(s, function, currRoute, function.Traverser.ActiveFile(), level+1)
+			// Generic functions are parsed with the concrete types they are instantiated with at the call
+			typeArguments, typeArgumentsErr := callExpr.TypeArguments()
+			if typeArgumentsErr != nil && log != nil {
+				log.Debug().Err(typeArgumentsErr).Str("call", callExprName(callExpr)).Msg("failed to get type arguments")
+			}
+			restoreTypeArguments := traverser.SetTypeArguments(typeArguments)
+
+			// Constant arguments (i.e. a status code) are carried into the function for its parameters
+			argumentValues, argumentValuesErr := callExpr.ArgumentValues()
+			if argumentValuesErr != nil && log != nil {
+				log.Debug().Err(argumentValuesErr).Str("call", callExprName(callExpr)).Msg("failed to get argument values")
+			}
+			restoreArgumentValues := traverser.SetArgumentValues(argumentValues)
+
+			var functionHeaders []astra.Param
+			functionHeaders, err = parseFunction(s, function, currRoute, function.Traverser.ActiveFile(), level+1, callSite{
+				Headers:   headers.Preceding(callExpr.Node),
+				Condition: describeResponse(callExpr.Node),
+			})
+			restoreArgumentValues()
+			restoreTypeArguments()
 			if err != nil {
 				if log != nil {
 					log.Debug().Err(err).Str("call", callExprName(callExpr)).Msg("error parsing function")
@@ -162,6 +234,7 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 				resetActiveFile()
 				return true
 			}
+			headers.Add(callExpr.Node, functionHeaders...)
 
 			resetActiveFile()
 		} else {
@@ -210,6 +283,8 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 							StatusCode:  statusCode,
 							ContentType: "application/json",
 							Field:       astra.ParseResultToField(result),
+							Headers:     headers.Preceding(callExpr.Node),
+							Description: describeResponse(callExpr.Node),
 						}
 
 						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
@@ -239,6 +314,8 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 							StatusCode:  statusCode,
 							ContentType: "application/xml",
 							Field:       astra.ParseResultToField(result),
+							Headers:     headers.Preceding(callExpr.Node),
+							Description: describeResponse(callExpr.Node),
 						}
 
 						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
@@ -268,6 +345,8 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 							StatusCode:  statusCode,
 							ContentType: "application/yaml",
 							Field:       astra.ParseResultToField(result),
+							Headers:     headers.Preceding(callExpr.Node),
+							Description: describeResponse(callExpr.Node),
 						}
 
 						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
@@ -297,6 +376,8 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 							StatusCode:  statusCode,
 							ContentType: "application/protobuf",
 							Field:       astra.ParseResultToField(result),
+							Headers:     headers.Preceding(callExpr.Node),
+							Description: describeResponse(callExpr.Node),
 						}
 
 						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
@@ -323,8 +404,10 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 						}
 
 						returnType := astra.ReturnType{
-							StatusCode: statusCode,
-							Field:      astra.ParseResultToField(result),
+							StatusCode:  statusCode,
+							Field:       astra.ParseResultToField(result),
+							Headers:     headers.Preceding(callExpr.Node),
+							Description: describeResponse(callExpr.Node),
 						}
 
 						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
@@ -351,6 +434,8 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 							Field: astra.Field{
 								Type: "string",
 							},
+							Headers:     headers.Preceding(callExpr.Node),
+							Description: describeResponse(callExpr.Node),
 						}
 
 						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
@@ -376,6 +461,8 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 							Field: astra.Field{
 								Type: "nil",
 							},
+							Headers:     headers.Preceding(callExpr.Node),
+							Description: describeResponse(callExpr.Node),
 						}
 
 						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
@@ -391,11 +478,16 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 							return nil, errors.New("failed to parse name")
 						}
 
-						param := astra.Param{
-							Field: astra.Field{
+						field, converted := conversions[callExpr.Node]
+						if !converted {
+							field = astra.Field{
 								Type: "string",
-							},
-							Name: name,
+							}
+						}
+
+						param := astra.Param{
+							Field: field,
+							Name:  name,
 						}
 
 						route.QueryParams = append(route.QueryParams, param)
@@ -469,6 +561,52 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 						return false
 					}
 
+				// Path Param methods
+				case "Param":
+					currRoute, err = funcBuilder.Value().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						name, ok := params[0].(string)
+						if !ok {
+							return nil, errors.New("failed to parse name")
+						}
+
+						// The path params are already extracted from the path as strings, so only a conversion adds information
+						field, converted := conversions[callExpr.Node]
+						if !converted {
+							return route, nil
+						}
+
+						for i, pathParam := range route.PathParams {
+							if pathParam.Name == name && !pathParam.IsBound {
+								route.PathParams[i].Field = field
+							}
+						}
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+				case "ShouldBindUri", "BindUri":
+					currRoute, err = funcBuilder.ExpressionResult().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						result, ok := params[0].(astTraversal.Result)
+						if !ok {
+							return nil, errors.New("failed to parse result")
+						}
+
+						field := astra.ParseResultToField(result)
+
+						route.PathParams = append(route.PathParams, astra.Param{
+							IsBound:    true,
+							IsRequired: true,
+							Field:      field,
+						})
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
+
 				// Body Param methods
 				case "ShouldBind", "Bind":
 					currRoute, err = funcBuilder.ExpressionResult().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
@@ -637,7 +775,7 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 						}
 
 						param := astra.BodyParam{
-							ContentType: "multipart/form-data",
+							ContentType: multipartFormContentType,
 							Field: astra.Field{
 								Type: "file",
 							},
@@ -651,6 +789,15 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 					if err != nil {
 						return false
 					}
+				case "MultipartForm":
+					currRoute, err = funcBuilder.Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						route.Body = append(route.Body, findMultipartFormParts(traverser, funcTraverser.Node.Body, callExpr.Node)...)
+
+						return route, nil
+					})
+					if err != nil {
+						return false
+					}
 				case "GetHeader":
 					currRoute, err = funcBuilder.Value().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
 						name, ok := params[0].(string)
@@ -692,23 +839,10 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 						return false
 					}
 				case "Header":
-					currRoute, err = funcBuilder.Value().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
-						name, ok := params[0].(string)
-						if !ok {
-							return nil, errors.New("failed to parse name")
-						}
-
-						param := astra.Param{
-							Field: astra.Field{
-								Type: "string",
-							},
-							Name: name,
-						}
-
-						route.ResponseHeaders = append(route.ResponseHeaders, param)
-
-						return route, nil
-					})
+					currRoute, err = funcBuilder.Value().Ignored().Build(setResponseHeader(callExpr))
+					if err != nil {
+						return false
+					}
 				case "AbortWithError":
 					currRoute, err = funcBuilder.StatusCode().Ignored().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
 						statusCode, ok := params[0].(int)
@@ -721,6 +855,8 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 							Field: astra.Field{
 								Type: "nil",
 							},
+							Headers:     headers.Preceding(callExpr.Node),
+							Description: describeResponse(callExpr.Node),
 						}
 
 						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
@@ -746,6 +882,8 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 							Field: astra.Field{
 								Type: "nil",
 							},
+							Headers:     headers.Preceding(callExpr.Node),
+							Description: describeResponse(callExpr.Node),
 						}
 
 						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
@@ -775,6 +913,8 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 							ContentType: "application/json",
 							StatusCode:  statusCode,
 							Field:       astra.ParseResultToField(result),
+							Headers:     headers.Preceding(callExpr.Node),
+							Description: describeResponse(callExpr.Node),
 						}
 
 						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
@@ -789,6 +929,46 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 						return false
 					}
 				}
+			} else if selectorExpr, ok := callExpr.Node.Fun.(*ast.SelectorExpr); ok && isContextWriterHeader(ctxName, selectorExpr.X) {
+				ctxMethodCallCount++
+				switch funcType.Name() {
+				case "Set", "Add": // c.Writer.Header().Set
+					currRoute, err = funcBuilder.Value().Ignored().Build(setResponseHeader(callExpr))
+					if err != nil {
+						return false
+					}
+				}
+			} else if ok && isContextWriter(ctxName, selectorExpr.X) {
+				ctxMethodCallCount++
+				switch funcType.Name() {
+				case "WriteHeader": // c.Writer.WriteHeader
+					currRoute, err = funcBuilder.StatusCode().Build(func(route *astra.Route, params []any) (*astra.Route, error) {
+						statusCode, ok := params[0].(int)
+						if !ok {
+							return nil, errors.New("failed to parse status code")
+						}
+
+						returnType := astra.ReturnType{
+							StatusCode: statusCode,
+							Field: astra.Field{
+								Type: "nil",
+							},
+							Headers:     headers.Preceding(callExpr.Node),
+							Description: describeResponse(callExpr.Node),
+						}
+
+						route.ReturnTypes = astra.AddReturnType(route.ReturnTypes, returnType)
+						returnTypeCount++
+
+						return route, nil
+					})
+					if err != nil {
+						if log != nil {
+							log.Error().Err(err).Str("call", callExprName(callExpr)).Msg("failed to parse WriteHeader return type")
+						}
+						return false
+					}
+				}
 			}
 			resetActiveFile()
 		}
@@ -797,7 +977,7 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 	})
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if level == 0 {
@@ -820,8 +1000,9 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 					Str("method", method).
 					Msg("Current route is nil when checking return types")
 			}
-			return errors.New("current route is nil")
+			return nil, errors.New("current route is nil")
 		}
+		applyDirectives(traverser, funcTraverser, currRoute, directives)
 		if len(currRoute.ReturnTypes) == 0 && log != nil {
 			log.Warn().
 				Str("func", funcName).
@@ -844,11 +1025,12 @@ func parseFunction(s *astra.Service, funcTraverser *astTraversal.FunctionTravers
 				Field: astra.Field{
 					Type: "struct",
 				},
+				Headers: headers.Unconditional(),
 			})
 		}
 	}
 
-	return nil
+	return headers.Unconditional(), nil
 }
 
 func callExprName(callExpr *astTraversal.CallExpressionTraverser) string {
@@ -49,12 +49,24 @@ func parseRoute(s *astra.Service, baseRoute *astra.Route) error {
 
 	log.Debug().Msg("Parsing file")
 
-	_, err := traverser.Packages.Get(pkgNode)
+	pkg, err := traverser.Packages.Get(pkgNode)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to get package")
 		return err
 	}
 
+	// The package of the handler names and describes the route when it is tagged by its package
+	// The main package is loaded from a copy under a different name, so it is named by the handler instead
+	name := pkg.Name
+	if pkgPath == "main" {
+		name = pkgName
+	}
+	baseRoute.Package = pkg.PkgPath
+	s.AddPackage(pkg.PkgPath, astra.Package{
+		Name: name,
+		Doc:  pkgNode.Doc(),
+	})
+
 	for _, file := range pkgNode.Files {
 		if path.Base(file.FileName) == path.Base(baseRoute.File) {
 			log.Debug().Str("fileName", file.FileName).Msg("Found file")
@@ -122,7 +134,7 @@ func parseRoute(s *astra.Service, baseRoute *astra.Route) error {
 								return false
 							}
 
-							err = parseFunction(s, function, baseRoute, traverser.ActiveFile(), 0)
+							_, err = parseFunction(s, function, baseRoute, traverser.ActiveFile(), 0, callSite{})
 							if err != nil {
 								log.Error().Err(err).Msg("Failed to parse inline function")
 								return false
@@ -150,7 +162,7 @@ func parseRoute(s *astra.Service, baseRoute *astra.Route) error {
 			// And define the function name as the operation ID
 			baseRoute.OperationID = strcase.ToLowerCamel(funcName)
 
-			err = parseFunction(s, function, baseRoute, traverser.ActiveFile(), 0)
+			_, err = parseFunction(s, function, baseRoute, traverser.ActiveFile(), 0, callSite{})
 			if err != nil {
 				log.Error().Err(err).Msg("Failed to parse function")
 				return false
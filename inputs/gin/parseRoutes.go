@@ -14,6 +14,11 @@ import (
 func ParseRoutes() astra.ServiceFunction {
 	return func(s *astra.Service) error {
 		s.Log.Debug().Msg("Populating routes from gin routes")
+		// The router groups are only found when they are used to tag the routes, as every package has to be searched for them
+		if s.TagStrategy == astra.TagStrategyRouterGroup || s.TagStrategy == astra.TagStrategyFunc {
+			s.Log.Debug().Msg("Finding router groups")
+			findRouterGroups(s)
+		}
 		for _, route := range s.Routes {
 			s.Log.Debug().Str("path", route.Path).Str("method", route.Method).Msg("Populating route")
 
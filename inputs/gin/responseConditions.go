@@ -0,0 +1,133 @@
+package gin
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/ls6-events/astra/astTraversal"
+)
+
+// errorMatchers are the fully qualified functions that match an error against a target, with the index of the target argument.
+var errorMatchers = map[string]int{
+	"errors.Is": 1,
+	"errors.As": 1,
+}
+
+// responseCondition finds the condition that leads to a response being written at the node, within the function body.
+// It is the condition of the innermost if statement or switch case that contains the node, or empty if there is none.
+func responseCondition(traverser *astTraversal.BaseTraverser, body *ast.BlockStmt, node ast.Node) string {
+	var condition string
+	var switchTag ast.Expr
+	ast.Inspect(body, func(n ast.Node) bool {
+		if n == nil || !containsNode(n, node) {
+			return false
+		}
+
+		switch stmt := n.(type) {
+		case *ast.IfStmt:
+			if containsNode(stmt.Body, node) {
+				condition = describeCondition(traverser, stmt.Cond)
+			} else if stmt.Else != nil && containsNode(stmt.Else, node) {
+				condition = describeCondition(traverser, negateCondition(stmt.Cond))
+			}
+		case *ast.SwitchStmt:
+			switchTag = stmt.Tag
+		case *ast.TypeSwitchStmt:
+			switchTag = nil
+		case *ast.CaseClause:
+			// The default case has no condition of its own
+			if len(stmt.List) == 0 {
+				return true
+			}
+
+			cases := make([]string, 0, len(stmt.List))
+			for _, expr := range stmt.List {
+				if switchTag != nil {
+					expr = &ast.BinaryExpr{X: switchTag, Op: token.EQL, Y: expr}
+				}
+				cases = append(cases, describeCondition(traverser, expr))
+			}
+			condition = strings.Join(cases, " or ")
+		}
+
+		return true
+	})
+
+	return condition
+}
+
+// describeCondition describes a condition, preferring the message of the error it matches over its source.
+func describeCondition(traverser *astTraversal.BaseTraverser, cond ast.Expr) string {
+	switch expr := cond.(type) {
+	case *ast.ParenExpr:
+		return describeCondition(traverser, expr.X)
+	case *ast.CallExpr:
+		if funcName, ok := qualifiedFuncName(traverser, expr); ok {
+			if targetIndex, ok := errorMatchers[funcName]; ok && len(expr.Args) > targetIndex {
+				return describeError(traverser, expr.Args[targetIndex])
+			}
+		}
+	case *ast.BinaryExpr:
+		switch expr.Op {
+		case token.LAND:
+			return describeCondition(traverser, expr.X) + " and " + describeCondition(traverser, expr.Y)
+		case token.LOR:
+			return describeCondition(traverser, expr.X) + " or " + describeCondition(traverser, expr.Y)
+		case token.EQL:
+			if isErrorExpr(traverser, expr.X) && !isNilExpr(expr.Y) {
+				return describeError(traverser, expr.Y)
+			}
+		}
+	}
+
+	return types.ExprString(cond)
+}
+
+// describeError describes an error by its message, if it is created from one, or its source otherwise.
+func describeError(traverser *astTraversal.BaseTraverser, expr ast.Expr) string {
+	if message, err := traverser.Expression(expr).Value(); err == nil && message != "" {
+		return message
+	}
+
+	return types.ExprString(expr)
+}
+
+// negateCondition negates a condition, for the else branch of an if statement.
+func negateCondition(cond ast.Expr) ast.Expr {
+	switch expr := cond.(type) {
+	case *ast.BinaryExpr:
+		switch expr.Op {
+		case token.EQL:
+			return &ast.BinaryExpr{X: expr.X, Op: token.NEQ, Y: expr.Y}
+		case token.NEQ:
+			return &ast.BinaryExpr{X: expr.X, Op: token.EQL, Y: expr.Y}
+		}
+
+		return &ast.UnaryExpr{Op: token.NOT, X: &ast.ParenExpr{X: cond}}
+	case *ast.UnaryExpr:
+		if expr.Op == token.NOT {
+			return expr.X
+		}
+	}
+
+	return &ast.UnaryExpr{Op: token.NOT, X: cond}
+}
+
+// isErrorExpr checks whether the expression is of the error type.
+func isErrorExpr(traverser *astTraversal.BaseTraverser, expr ast.Expr) bool {
+	exprType, err := traverser.Expression(expr).Type()
+	return err == nil && exprType != nil && types.Identical(exprType, types.Universe.Lookup("error").Type())
+}
+
+// isNilExpr checks whether the expression is the nil identifier.
+func isNilExpr(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == "nil"
+}
+
+// containsNode checks whether the node is within the parent node.
+func containsNode(parent ast.Node, node ast.Node) bool {
+	return parent.Pos() <= node.Pos() && node.End() <= parent.End()
+}
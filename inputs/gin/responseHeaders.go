@@ -0,0 +1,133 @@
+package gin
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
+)
+
+// responseHeader is a response header that has been set within a function body.
+type responseHeader struct {
+	Param astra.Param
+	Pos   token.Pos
+	// Block is the innermost block the header was set in.
+	// The header precedes every response that is written after it within this block (including any nested blocks).
+	Block ast.Node
+}
+
+// responseHeaders tracks the response headers that are set within a function body.
+// This is used to attach the headers to the responses that are written after them, rather than every response of the route.
+type responseHeaders struct {
+	body *ast.BlockStmt
+	// inherited are the headers that were set by the caller of the function, which precede every response in the function.
+	inherited []astra.Param
+	set       []responseHeader
+}
+
+func newResponseHeaders(body *ast.BlockStmt, inherited []astra.Param) *responseHeaders {
+	return &responseHeaders{
+		body:      body,
+		inherited: inherited,
+	}
+}
+
+// Add records the headers that are set at the node.
+func (r *responseHeaders) Add(node ast.Node, params ...astra.Param) {
+	block := enclosingBlock(r.body, node)
+	for _, param := range params {
+		r.set = append(r.set, responseHeader{
+			Param: param,
+			Pos:   node.Pos(),
+			Block: block,
+		})
+	}
+}
+
+// Preceding returns the headers that have been set before a response is written at the node.
+func (r *responseHeaders) Preceding(node ast.Node) []astra.Param {
+	headers := astra.AddHeader(nil, r.inherited...)
+	for _, header := range r.set {
+		if header.Pos < node.Pos() && containsNode(header.Block, node) {
+			headers = astra.AddHeader(headers, header.Param)
+		}
+	}
+
+	return headers
+}
+
+// Unconditional returns the headers that are set in the top level block of the function.
+// These are the headers that the function sets for its caller, whichever way it returns.
+func (r *responseHeaders) Unconditional() []astra.Param {
+	var headers []astra.Param
+	for _, header := range r.set {
+		if header.Block == r.body {
+			headers = astra.AddHeader(headers, header.Param)
+		}
+	}
+
+	return headers
+}
+
+// enclosingBlock finds the innermost block (or case clause) within the body that contains the node.
+func enclosingBlock(body *ast.BlockStmt, node ast.Node) ast.Node {
+	var block ast.Node = body
+	ast.Inspect(body, func(n ast.Node) bool {
+		if n == nil || !containsNode(n, node) {
+			return false
+		}
+
+		switch n.(type) {
+		case *ast.BlockStmt, *ast.CaseClause, *ast.CommClause:
+			block = n
+		}
+
+		return true
+	})
+
+	return block
+}
+
+// responseHeaderParam creates the param for a response header, typed by the value it is set to.
+// It returns false if the header is deleted, by setting it to an empty string.
+func responseHeaderParam(traverser *astTraversal.BaseTraverser, name string, value ast.Expr) (astra.Param, bool) {
+	if basicLit, ok := value.(*ast.BasicLit); ok && basicLit.Kind == token.STRING && len(strings.Trim(basicLit.Value, "\"`")) == 0 {
+		return astra.Param{}, false
+	}
+
+	field, formatted := lookupValueFormat(traverser, value)
+	if !formatted {
+		field = astra.Field{
+			Type: "string",
+		}
+	}
+
+	return astra.Param{
+		Field: field,
+		Name:  name,
+	}, true
+}
+
+// isContextWriter checks whether the expression is the response writer of the context (i.e. c.Writer).
+func isContextWriter(ctxName string, expr ast.Expr) bool {
+	selectorExpr, ok := expr.(*ast.SelectorExpr)
+	if !ok || selectorExpr.Sel.Name != "Writer" {
+		return false
+	}
+
+	ident, ok := selectorExpr.X.(*ast.Ident)
+	return ok && ident.Name == ctxName
+}
+
+// isContextWriterHeader checks whether the expression is the header map of the response writer of the context (i.e. c.Writer.Header()).
+func isContextWriterHeader(ctxName string, expr ast.Expr) bool {
+	callExpr, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+
+	selectorExpr, ok := callExpr.Fun.(*ast.SelectorExpr)
+	return ok && selectorExpr.Sel.Name == "Header" && isContextWriter(ctxName, selectorExpr.X)
+}
@@ -0,0 +1,223 @@
+package gin
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
+	"github.com/ls6-events/astra/utils"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	// ginRouterGroupType is the type of a router group, which the engine embeds.
+	ginRouterGroupType = "RouterGroup"
+	// ginEngineType is the type of the engine, the root router group.
+	ginEngineType = "Engine"
+	// ginGroupFunc is the method that creates a router group from another.
+	ginGroupFunc = "Group"
+)
+
+// findRouterGroups finds the router group of every route, from the router groups created in the main package and the packages of the handlers.
+// Gin doesn't keep the router group of a route, so the groups are found from their Group calls instead (i.e. r.Group("/pets")).
+// A route is in the router group with the longest prefix of its path.
+func findRouterGroups(s *astra.Service) {
+	traverser := astTraversal.New(s.WorkDir).SetLog(&s.Log)
+	traverser.Packages.AddPathLoader(func(path string) (string, error) {
+		if path == "main" {
+			return s.GetMainPackageName()
+		}
+		return path, nil
+	})
+
+	pkgPaths := []string{"main"}
+	for _, route := range s.Routes {
+		pkgPath := utils.SplitHandlerPath(route.Handler).PackagePath()
+		if !slices.Contains(pkgPaths, pkgPath) {
+			pkgPaths = append(pkgPaths, pkgPath)
+		}
+	}
+
+	pkgs := make([]*packages.Package, 0, len(pkgPaths))
+	for _, pkgPath := range pkgPaths {
+		pkg, err := traverser.Packages.Get(traverser.Packages.AddPackage(pkgPath))
+		if err != nil {
+			s.Log.Debug().Err(err).Str("package", pkgPath).Msg("Failed to load package to find router groups")
+			continue
+		}
+		pkgs = append(pkgs, pkg)
+	}
+
+	prefixes := findRouterGroupPrefixes(pkgs)
+	for i, route := range s.Routes {
+		for _, prefix := range prefixes {
+			if isPathInRouterGroup(route.Path, prefix) && len(prefix) > len(s.Routes[i].Group) {
+				s.Routes[i].Group = prefix
+			}
+		}
+	}
+}
+
+// maxRouterGroupPasses is the most times the packages are passed over to follow the router groups.
+// Each pass follows the groups one more variable or function deep, so this only stops recursive functions creating groups forever.
+const maxRouterGroupPasses = 32
+
+// findRouterGroupPrefixes finds the prefixes of the router groups created in the packages.
+// A group created from another group is prefixed by it, if that group can be followed to its Group call.
+// Groups are followed through variables, and through the params of functions they are passed to (i.e. registerPetRoutes(api.Group("/pets"))).
+func findRouterGroupPrefixes(pkgs []*packages.Package) []string {
+	// The groups are found by the position of the variable or param they are assigned to, as the packages don't share their objects
+	// A param can be assigned a different group by every call of its function
+	groups := make(map[string][]string)
+
+	var prefixes []string
+	// Keep following the groups until no more are found, as they can be used before they are declared (i.e. in another file)
+	for changed, pass := true, 0; changed && pass < maxRouterGroupPasses; pass++ {
+		changed = false
+		prefixes = prefixes[:0]
+		for _, pkg := range pkgs {
+			if pkg.TypesInfo == nil {
+				continue
+			}
+
+			assignGroup := func(obj types.Object, groupPrefixes []string) {
+				if obj == nil {
+					return
+				}
+
+				key := pkg.Fset.Position(obj.Pos()).String()
+				for _, prefix := range groupPrefixes {
+					if !slices.Contains(groups[key], prefix) {
+						groups[key] = append(groups[key], prefix)
+						changed = true
+					}
+				}
+			}
+
+			for _, file := range pkg.Syntax {
+				ast.Inspect(file, func(n ast.Node) bool {
+					switch node := n.(type) {
+					case *ast.CallExpr:
+						if groupPrefixes := routerGroupPrefixes(pkg, groups, node); len(groupPrefixes) > 0 {
+							prefixes = append(prefixes, groupPrefixes...)
+							return true
+						}
+
+						// Groups passed into a function are the groups of its params
+						signature, ok := pkg.TypesInfo.TypeOf(node.Fun).(*types.Signature)
+						if !ok {
+							return true
+						}
+						for i, arg := range node.Args {
+							if i >= signature.Params().Len() {
+								break
+							}
+							assignGroup(signature.Params().At(i), routerGroupExprPrefixes(pkg, groups, arg))
+						}
+					case *ast.AssignStmt:
+						if len(node.Lhs) != len(node.Rhs) {
+							return true
+						}
+						for i, lhs := range node.Lhs {
+							if ident, ok := lhs.(*ast.Ident); ok {
+								assignGroup(pkg.TypesInfo.ObjectOf(ident), routerGroupExprPrefixes(pkg, groups, node.Rhs[i]))
+							}
+						}
+					case *ast.ValueSpec:
+						if len(node.Names) != len(node.Values) {
+							return true
+						}
+						for i, name := range node.Names {
+							assignGroup(pkg.TypesInfo.ObjectOf(name), routerGroupExprPrefixes(pkg, groups, node.Values[i]))
+						}
+					}
+
+					return true
+				})
+			}
+		}
+	}
+
+	slices.Sort(prefixes)
+	return slices.Compact(prefixes)
+}
+
+// routerGroupExprPrefixes finds the prefixes of the router groups an expression can evaluate to.
+// It returns none if the expression isn't a router group, or its prefix isn't known.
+func routerGroupExprPrefixes(pkg *packages.Package, groups map[string][]string, expr ast.Expr) []string {
+	switch node := expr.(type) {
+	case *ast.ParenExpr:
+		return routerGroupExprPrefixes(pkg, groups, node.X)
+	case *ast.CallExpr:
+		return routerGroupPrefixes(pkg, groups, node)
+	case *ast.Ident:
+		obj := pkg.TypesInfo.ObjectOf(node)
+		if obj == nil || !isRouterGroupType(obj.Type()) {
+			return nil
+		}
+
+		if groupPrefixes, ok := groups[pkg.Fset.Position(obj.Pos()).String()]; ok {
+			return groupPrefixes
+		}
+
+		// The engine is the root router group
+		if isGinType(obj.Type(), ginEngineType) {
+			return []string{"/"}
+		}
+	}
+
+	return nil
+}
+
+// routerGroupPrefixes finds the prefixes of the router groups created by a Group call (i.e. r.Group("/pets")).
+// It returns none if the call isn't a Group call with a constant path, or the prefix of the group it is called on isn't known.
+func routerGroupPrefixes(pkg *packages.Package, groups map[string][]string, call *ast.CallExpr) []string {
+	selectorExpr, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || selectorExpr.Sel.Name != ginGroupFunc || len(call.Args) == 0 {
+		return nil
+	}
+
+	relativePath := pkg.TypesInfo.Types[call.Args[0]].Value
+	if relativePath == nil || relativePath.Kind() != constant.String {
+		return nil
+	}
+
+	bases := routerGroupExprPrefixes(pkg, groups, selectorExpr.X)
+	groupPrefixes := make([]string, 0, len(bases))
+	for _, base := range bases {
+		groupPrefixes = append(groupPrefixes, path.Join(base, constant.StringVal(relativePath)))
+	}
+
+	return groupPrefixes
+}
+
+// isRouterGroupType checks whether the type is a router group or the engine (or a pointer to one).
+func isRouterGroupType(t types.Type) bool {
+	return isGinType(t, ginRouterGroupType) || isGinType(t, ginEngineType)
+}
+
+// isGinType checks whether the type is the named gin type (or a pointer to it).
+func isGinType(t types.Type, name string) bool {
+	if pointer, ok := t.(*types.Pointer); ok {
+		t = pointer.Elem()
+	}
+
+	named, ok := t.(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == GinPackagePath && named.Obj().Name() == name
+}
+
+// isPathInRouterGroup checks whether the path is within the router group with the prefix.
+// The root router group isn't a group that routes are grouped by.
+func isPathInRouterGroup(path string, prefix string) bool {
+	if prefix == "/" {
+		return false
+	}
+
+	return path == prefix || strings.HasPrefix(path, strings.TrimSuffix(prefix, "/")+"/")
+}
@@ -0,0 +1,166 @@
+package gin
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
+)
+
+// valueConversion describes a function that converts a raw string value (i.e. from c.Param or c.Query) into a typed value.
+// ArgIndex is the index of the argument that receives the string.
+type valueConversion struct {
+	ArgIndex int
+	Field    astra.Field
+}
+
+// valueConversions is a map of the fully qualified conversion functions that are recognised.
+var valueConversions = map[string]valueConversion{
+	"strconv.Atoi":       {ArgIndex: 0, Field: astra.Field{Type: "int"}},
+	"strconv.ParseInt":   {ArgIndex: 0, Field: astra.Field{Type: "int64"}},
+	"strconv.ParseUint":  {ArgIndex: 0, Field: astra.Field{Type: "uint64"}},
+	"strconv.ParseFloat": {ArgIndex: 0, Field: astra.Field{Type: "float64"}},
+	"strconv.ParseBool":  {ArgIndex: 0, Field: astra.Field{Type: "bool"}},
+	"time.Parse":         {ArgIndex: 1, Field: astra.Field{Type: "Time", Package: "time"}},
+
+	"github.com/google/uuid.Parse":     {ArgIndex: 0, Field: astra.Field{Type: "UUID", Package: "github.com/google/uuid"}},
+	"github.com/google/uuid.MustParse": {ArgIndex: 0, Field: astra.Field{Type: "UUID", Package: "github.com/google/uuid"}},
+}
+
+// valueFormats is a map of the fully qualified functions that format a typed value into a string (i.e. for a response header).
+var valueFormats = map[string]astra.Field{
+	"strconv.Itoa":        {Type: "int"},
+	"strconv.FormatInt":   {Type: "int64"},
+	"strconv.FormatUint":  {Type: "uint64"},
+	"strconv.FormatFloat": {Type: "float64"},
+	"strconv.FormatBool":  {Type: "bool"},
+}
+
+// sizedIntegerTypes maps the bitSize argument of strconv.ParseInt/ParseUint/ParseFloat to the resulting Go type.
+var sizedIntegerTypes = map[string]map[string]string{
+	"int64":   {"8": "int8", "16": "int16", "32": "int32", "64": "int64"},
+	"uint64":  {"8": "uint8", "16": "uint16", "32": "uint32", "64": "uint64"},
+	"float64": {"32": "float32", "64": "float64"},
+}
+
+// findValueConversions finds the call expressions in a function body whose string result is converted into another type.
+// The conversion can either wrap the call directly (strconv.Atoi(c.Param("id"))) or be applied to a variable the call was assigned to.
+// It returns a map of the converted call expressions to the field they are converted into.
+func findValueConversions(traverser *astTraversal.BaseTraverser, body *ast.BlockStmt) map[*ast.CallExpr]astra.Field {
+	conversions := make(map[*ast.CallExpr]astra.Field)
+	if traverser == nil || body == nil {
+		return conversions
+	}
+
+	assignments := make(map[*ast.Object]*ast.CallExpr)
+	convertedVariables := make(map[*ast.Object]astra.Field)
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.AssignStmt:
+			if len(node.Rhs) == 1 {
+				if callExpr, ok := node.Rhs[0].(*ast.CallExpr); ok {
+					if ident, ok := node.Lhs[0].(*ast.Ident); ok && ident.Obj != nil {
+						assignments[ident.Obj] = callExpr
+					}
+				}
+			}
+		case *ast.ValueSpec:
+			if len(node.Values) == 1 && len(node.Names) > 0 {
+				if callExpr, ok := node.Values[0].(*ast.CallExpr); ok && node.Names[0].Obj != nil {
+					assignments[node.Names[0].Obj] = callExpr
+				}
+			}
+		case *ast.CallExpr:
+			conversion, ok := lookupValueConversion(traverser, node)
+			if !ok || len(node.Args) <= conversion.ArgIndex {
+				return true
+			}
+
+			switch arg := node.Args[conversion.ArgIndex].(type) {
+			case *ast.CallExpr:
+				conversions[arg] = conversion.Field
+			case *ast.Ident:
+				if arg.Obj != nil {
+					convertedVariables[arg.Obj] = conversion.Field
+				}
+			}
+		}
+
+		return true
+	})
+
+	for obj, field := range convertedVariables {
+		if callExpr, ok := assignments[obj]; ok {
+			conversions[callExpr] = field
+		}
+	}
+
+	return conversions
+}
+
+// lookupValueConversion checks whether a call expression is one of the recognised conversion functions.
+func lookupValueConversion(traverser *astTraversal.BaseTraverser, node *ast.CallExpr) (valueConversion, bool) {
+	funcName, ok := qualifiedFuncName(traverser, node)
+	if !ok {
+		return valueConversion{}, false
+	}
+
+	conversion, ok := valueConversions[funcName]
+	if !ok {
+		return valueConversion{}, false
+	}
+
+	// The bit size of the numeric parsers narrows the resulting type, if it is a literal.
+	if sizes, ok := sizedIntegerTypes[conversion.Field.Type]; ok && len(node.Args) > 1 {
+		if bitSize, ok := node.Args[len(node.Args)-1].(*ast.BasicLit); ok && bitSize.Kind == token.INT {
+			if sizedType, ok := sizes[bitSize.Value]; ok {
+				conversion.Field.Type = sizedType
+			}
+		}
+	}
+
+	return conversion, true
+}
+
+// lookupValueFormat checks whether an expression is a call to one of the recognised format functions.
+// It returns the field of the value that is formatted.
+func lookupValueFormat(traverser *astTraversal.BaseTraverser, expr ast.Expr) (astra.Field, bool) {
+	node, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return astra.Field{}, false
+	}
+
+	funcName, ok := qualifiedFuncName(traverser, node)
+	if !ok {
+		return astra.Field{}, false
+	}
+
+	field, ok := valueFormats[funcName]
+	return field, ok
+}
+
+// qualifiedFuncName resolves the package path and name of the package level function that is called.
+func qualifiedFuncName(traverser *astTraversal.BaseTraverser, node *ast.CallExpr) (string, bool) {
+	if _, ok := node.Fun.(*ast.SelectorExpr); !ok {
+		return "", false
+	}
+
+	callExpr, err := traverser.CallExpression(node)
+	if err != nil {
+		return "", false
+	}
+
+	funcType, err := callExpr.Type()
+	if err != nil || funcType.Pkg() == nil {
+		return "", false
+	}
+
+	if signature, ok := funcType.Type().(*types.Signature); !ok || signature.Recv() != nil {
+		return "", false
+	}
+
+	return funcType.Pkg().Path() + "." + funcType.Name(), true
+}
@@ -10,8 +10,17 @@ type ServiceFunction func(service *Service) error
 type IOConfigurationKey string
 
 const (
-	IOConfigurationKeyFilePath      IOConfigurationKey = "filePath"
-	IOConfigurationKeyDirectoryPath IOConfigurationKey = "directoryPath"
+	IOConfigurationKeyFilePath           IOConfigurationKey = "filePath"
+	IOConfigurationKeyDirectoryPath      IOConfigurationKey = "directoryPath"
+	IOConfigurationKeyVersion            IOConfigurationKey = "version"
+	IOConfigurationKeyComponentThreshold IOConfigurationKey = "componentThreshold"
+	IOConfigurationKeySplitStrategy      IOConfigurationKey = "splitStrategy"
+	IOConfigurationKeyPackageName        IOConfigurationKey = "packageName"
+	IOConfigurationKeyOriginalTypes      IOConfigurationKey = "originalTypes"
+	IOConfigurationKeyFolderStrategy     IOConfigurationKey = "folderStrategy"
+	IOConfigurationKeyGroupStrategy      IOConfigurationKey = "groupStrategy"
+	IOConfigurationKeyTemplateFile       IOConfigurationKey = "templateFile"
+	IOConfigurationKeyFieldNumbers       IOConfigurationKey = "fieldNumbers"
 )
 
 type IOConfiguration map[IOConfigurationKey]any
@@ -29,7 +29,12 @@ func convertRoute(route astra.Route) string {
 	routeString := route.Path
 
 	for _, pathParams := range route.PathParams {
-		if azureType, ok := acceptedTypeMap[pathParams.Field.Type]; ok {
+		typeName := pathParams.Field.Type
+		if pathParams.Field.Package != "" {
+			typeName = pathParams.Field.Package + "." + typeName
+		}
+
+		if azureType, ok := acceptedTypeMap[typeName]; ok {
 			if azureType == "" {
 				return ""
 			}
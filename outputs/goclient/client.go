@@ -0,0 +1,158 @@
+package goclient
+
+import (
+	"strings"
+	"text/template"
+)
+
+// clientImports are the standard library packages that the runtime of the client imports.
+var clientImports = []string{"bytes", "context", "encoding", "encoding/json", "errors", "fmt", "io", "net/http", "net/url", "strings"}
+
+// clientNames are the names that the runtime of the client takes in its package.
+var clientNames = []string{"BasePath", "Client", "NewClient", "ResponseError"}
+
+// clientFields are the names of the fields of the client, which its methods can't be named.
+var clientFields = []string{"BaseURL", "HTTPClient", "Header"}
+
+// clientRuntime is the code of the client that sends the requests of the methods with an HTTP client, so the client only depends on the standard library.
+var clientRuntime = template.Must(template.New("client").Parse(`
+// BasePath is the base path of the routes of the API, which is added to the base URL of the client.
+const BasePath = {{.BasePath}}
+
+// Client is a client of the API, with a method for every route.
+type Client struct {
+	// BaseURL is the URL of the server that the requests are sent to (i.e. https://api.example.com).
+	BaseURL string
+	// HTTPClient is the HTTP client that sends the requests, which is http.DefaultClient if it is nil.
+	HTTPClient *http.Client
+	// Header is the header that is sent with every request (i.e. Authorization).
+	Header http.Header
+}
+
+// NewClient creates a client of the API at the base URL, which sends the requests with the HTTP client.
+func NewClient(baseURL string, httpClient *http.Client) *Client {
+	return &Client{
+		BaseURL:    baseURL,
+		HTTPClient: httpClient,
+		Header:     make(http.Header),
+	}
+}
+
+// ResponseError is the error of a response whose status code isn't successful, if the route doesn't have an error for it.
+type ResponseError struct {
+	// Method and Path are the method and path of the request.
+	Method string
+	Path   string
+	// StatusCode is the status code of the response.
+	StatusCode int
+	// Body is the body of the response.
+	Body []byte
+}
+
+// Error returns the method and path of the request, and the status of the response.
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("%s %s: %d %s", e.Method, e.Path, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// do sends a request to the path, which is relative to the base path, with the header of the client.
+func (c *Client) do(ctx context.Context, method string, path string, query url.Values, header http.Header, body io.Reader, contentType string) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(c.BaseURL, "/")+BasePath+path, body)
+	if err != nil {
+		return nil, err
+	}
+	if len(query) > 0 {
+		request.URL.RawQuery = query.Encode()
+	}
+
+	for name, values := range c.Header {
+		request.Header[name] = append([]string(nil), values...)
+	}
+	for name, values := range header {
+		request.Header[name] = values
+	}
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return httpClient.Do(request)
+}
+
+// newError creates the error of a response that the route doesn't have an error for.
+func newError(response *http.Response) error {
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+
+	return &ResponseError{
+		Method:     response.Request.Method,
+		Path:       response.Request.URL.Path,
+		StatusCode: response.StatusCode,
+		Body:       body,
+	}
+}
+
+// jsonBody encodes the body of a request as JSON.
+func jsonBody(body any) (io.Reader, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.NewReader(data), nil
+}
+
+// decodeJSON decodes the JSON body of a response, which can be empty.
+func decodeJSON(body io.Reader, v any) error {
+	err := json.NewDecoder(body).Decode(v)
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+
+	return err
+}
+
+// readString reads the text body of a response.
+func readString(body io.Reader) (string, error) {
+	data, err := io.ReadAll(body)
+	return string(data), err
+}
+
+// paramString writes the value of a path, query or header param, with its text encoding if it has one (i.e. time.Time).
+func paramString(value any) string {
+	if marshaler, ok := value.(encoding.TextMarshaler); ok {
+		text, err := marshaler.MarshalText()
+		if err == nil {
+			return string(text)
+		}
+	}
+
+	return fmt.Sprint(value)
+}
+`))
+
+// runtimeData is the data of the runtime of the client.
+type runtimeData struct {
+	BasePath string
+}
+
+// writeRuntime writes the runtime of the client, with the base path of the routes.
+func writeRuntime(b *strings.Builder, basePath string) {
+	// The template can't fail, as its data always has the fields it uses
+	_ = clientRuntime.Execute(b, runtimeData{BasePath: goString(strings.TrimSuffix(basePath, "/"))})
+}
+
+// standardPackageNames returns the names of the standard library packages that the client imports, which the other packages can't be imported as.
+func standardPackageNames() []string {
+	names := []string{"time"}
+	for _, importPath := range clientImports {
+		names = append(names, importPath[strings.LastIndex(importPath, "/")+1:])
+	}
+
+	return names
+}
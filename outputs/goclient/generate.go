@@ -0,0 +1,138 @@
+package goclient
+
+import (
+	"fmt"
+	"go/format"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/outputs/openapi"
+)
+
+const (
+	// tempOutputDir is the temporary directory in the .astra directory that the client is written to.
+	tempOutputDir = "goclient"
+	// clientFile is the file of the client and its methods.
+	clientFile = "client.go"
+	// typesFile is the file of the types of the components.
+	typesFile = "types.go"
+	// defaultPackageName is the name of the package of the client if the name of its directory can't be a package name.
+	defaultPackageName = "client"
+)
+
+// generatedHeader is the comment at the top of the generated files, so they aren't edited by hand.
+const generatedHeader = "// Code generated by astra. DO NOT EDIT.\n"
+
+// Generate generates the Go client output.
+// It writes a package to the directory with a Client that has a method for every operation, named by its operation ID, and the types of the components in types.go.
+// The components can be the original Go types imported from their packages instead (i.e. WithOriginalTypes(true)).
+func Generate(directoryPath string, options Options) astra.ServiceFunction {
+	return func(s *astra.Service) error {
+		s.Log.Info().Msg("Generating Go client output")
+		output, err := openapi.Build(s, openapi.NewOptions())
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to build OpenAPI schema")
+			return err
+		}
+
+		if options.PackageName == "" {
+			options.PackageName = packageName(path.Base(directoryPath), defaultPackageName)
+		}
+
+		files, err := clientFiles(output, openapi.BuildGoTypes(s), s.Config.BasePath, options)
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to format Go client")
+			return err
+		}
+
+		tempOutputDirectoryPath, err := s.SetupTempOutputDir(tempOutputDir)
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to create directory")
+			return err
+		}
+
+		for _, fileName := range sortedKeys(files) {
+			s.Log.Debug().Str("fileName", fileName).Msg("Writing Go client file")
+			err := os.WriteFile(path.Join(tempOutputDirectoryPath, fileName), files[fileName], 0644)
+			if err != nil {
+				s.Log.Error().Err(err).Str("fileName", fileName).Msg("Failed to write Go client file")
+				return err
+			}
+		}
+
+		err = s.MoveTempOutputDir(tempOutputDir, directoryPath)
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to move temporary output directory to final location")
+			return err
+		}
+
+		s.Log.Info().Msg("Generated Go client output")
+		return nil
+	}
+}
+
+// clientFiles writes the files of the package of the client, formatted by gofmt, by their names.
+// The types of the components are in types.go, which isn't written if they are all original types.
+func clientFiles(output openapi.OpenAPISchema, goTypes openapi.GoTypes, basePath string, options Options) (map[string][]byte, error) {
+	names := newUniqueNames(clientNames...)
+	types := newTypeWriter(output.Components.Schemas, goTypes, options.OriginalTypes, names)
+	files := make(map[string][]byte, 2)
+
+	var typesBody strings.Builder
+	typesImports := make(imports)
+	types.writeComponents(&typesBody, typesImports)
+	if typesBody.Len() > 0 {
+		contents, err := formatFile(options.PackageName, "", typesImports, typesBody.String())
+		if err != nil {
+			return nil, err
+		}
+		files[typesFile] = contents
+	}
+
+	var clientBody strings.Builder
+	writeRuntime(&clientBody, basePath)
+	w := &clientWriter{
+		types:    types,
+		names:    names,
+		methods:  newUniqueNames(clientFields...),
+		imports:  make(imports),
+		basePath: basePath,
+	}
+	for _, operation := range openapi.PathOperations(output) {
+		// The methods of the client are named after the operation IDs, so the operations without them are left out
+		if operation.OperationID == "" {
+			continue
+		}
+		w.writeOperation(&clientBody, operation)
+	}
+	for _, importPath := range clientImports {
+		w.imports[importPath] = ""
+	}
+
+	description := fmt.Sprintf("Package %s is a client of the API.", options.PackageName)
+	if output.Info.Title != "" {
+		description = fmt.Sprintf("Package %s is a client of the %s API.", options.PackageName, output.Info.Title)
+	}
+	contents, err := formatFile(options.PackageName, description, w.imports, clientBody.String())
+	if err != nil {
+		return nil, err
+	}
+	files[clientFile] = contents
+
+	return files, nil
+}
+
+// formatFile writes a file of the package of the client with its imports and declarations, formatted by gofmt.
+func formatFile(packageName string, description string, imp imports, declarations string) ([]byte, error) {
+	var b strings.Builder
+	b.WriteString(generatedHeader)
+	b.WriteString("\n")
+	writeDocComment(&b, "", description)
+	fmt.Fprintf(&b, "package %s\n", packageName)
+	imp.write(&b)
+	b.WriteString(declarations)
+
+	return format.Source([]byte(b.String()))
+}
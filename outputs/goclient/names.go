@@ -0,0 +1,82 @@
+package goclient
+
+import (
+	"fmt"
+	"go/token"
+	"regexp"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+)
+
+var (
+	// invalidIdentifierCharacters are the characters that can't be in a Go identifier.
+	invalidIdentifierCharacters = regexp.MustCompile(`[^A-Za-z0-9]+`)
+	// commonInitialisms are the words that are written in upper case in Go names (i.e. ID in PetID).
+	commonInitialisms = map[string]bool{
+		"API": true, "ASCII": true, "CPU": true, "CSS": true, "DNS": true, "EOF": true, "HTML": true, "HTTP": true, "HTTPS": true,
+		"ID": true, "IP": true, "JSON": true, "SQL": true, "TCP": true, "TLS": true, "TTL": true, "UDP": true, "UI": true,
+		"UID": true, "UUID": true, "URI": true, "URL": true, "UTF8": true, "XML": true,
+	}
+)
+
+// goName makes a name (i.e. pet_id, X-Request-ID or Pet_form) into an exported Go identifier (i.e. PetID, XRequestID or PetForm).
+// A name that starts with a digit has an X added to it, so it is still an identifier.
+func goName(name string) string {
+	var b strings.Builder
+	for _, word := range strings.Split(strcase.ToSnake(invalidIdentifierCharacters.ReplaceAllString(name, "_")), "_") {
+		if word == "" {
+			continue
+		}
+		if upper := strings.ToUpper(word); commonInitialisms[upper] {
+			b.WriteString(upper)
+			continue
+		}
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+
+	identifier := b.String()
+	if identifier == "" || (identifier[0] >= '0' && identifier[0] <= '9') {
+		identifier = "X" + identifier
+	}
+
+	return identifier
+}
+
+// packageName makes a name (i.e. the name of a directory) into a Go package name, which is lower case without any other characters.
+// A name that isn't left with a letter to start with is the fallback name.
+func packageName(name string, fallback string) string {
+	name = strings.ToLower(invalidIdentifierCharacters.ReplaceAllString(name, ""))
+	name = strings.TrimLeft(name, "0123456789")
+	if name == "" || token.IsKeyword(name) {
+		return fallback
+	}
+
+	return name
+}
+
+// uniqueNames are the names that are taken in a scope of the client, so that the names that are added don't replace each other.
+type uniqueNames struct {
+	taken map[string]bool
+}
+
+// newUniqueNames creates the names of a scope, with the names that are already taken in it.
+func newUniqueNames(taken ...string) *uniqueNames {
+	n := &uniqueNames{taken: make(map[string]bool, len(taken))}
+	for _, name := range taken {
+		n.taken[name] = true
+	}
+
+	return n
+}
+
+// unique takes a name that isn't already taken, adding a number to it if it is (i.e. GetPetParams2).
+func (n *uniqueNames) unique(name string) string {
+	uniqueName := name
+	for i := 2; n.taken[uniqueName]; i++ {
+		uniqueName = fmt.Sprintf("%s%d", name, i)
+	}
+	n.taken[uniqueName] = true
+
+	return uniqueName
+}
@@ -0,0 +1,40 @@
+package goclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoName(t *testing.T) {
+	t.Run("it makes names into exported identifiers", func(t *testing.T) {
+		require.Equal(t, "GetPet", goName("getPet"))
+		require.Equal(t, "PetForm", goName("Pet_form"))
+		require.Equal(t, "NotFound", goName("Not Found"))
+	})
+
+	t.Run("it writes common initialisms in upper case", func(t *testing.T) {
+		require.Equal(t, "PetID", goName("pet_id"))
+		require.Equal(t, "XRequestID", goName("X-Request-ID"))
+		require.Equal(t, "AvatarURL", goName("avatarUrl"))
+	})
+
+	t.Run("it starts names with a letter", func(t *testing.T) {
+		require.Equal(t, "X2Fa", goName("2fa"))
+		require.Equal(t, "X", goName("-"))
+	})
+}
+
+func TestPackageName(t *testing.T) {
+	require.Equal(t, "petclient", packageName("pet-client", "client"))
+	require.Equal(t, "goclient", packageName("32-go-client", "client"))
+	require.Equal(t, "client", packageName("123", "client"))
+	require.Equal(t, "client", packageName("go", "client"))
+}
+
+func TestUniqueNames(t *testing.T) {
+	names := newUniqueNames("Client")
+	require.Equal(t, "Client2", names.unique("Client"))
+	require.Equal(t, "Client3", names.unique("Client"))
+	require.Equal(t, "Pet", names.unique("Pet"))
+}
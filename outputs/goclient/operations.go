@@ -0,0 +1,379 @@
+package goclient
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ls6-events/astra/outputs/openapi"
+)
+
+// pathParamRegex matches the params in the path of an operation (i.e. {id}), and the catch-all params of wildcard routes (i.e. {filepath*}).
+var pathParamRegex = regexp.MustCompile(`\{([^}*]+)(\*?)\}`)
+
+// bodyKind is how a body is encoded, by its content type.
+type bodyKind int
+
+const (
+	bodyJSON   bodyKind = iota // JSON, decoded into its Go type.
+	bodyText                   // Text (i.e. text/plain), read as a string.
+	bodyBinary                 // Anything else (i.e. multipart/form-data), read as bytes or written from a reader.
+)
+
+// body is the body of a request or response, with its Go type and content type.
+type body struct {
+	Type        string
+	Kind        bodyKind
+	ContentType string
+}
+
+// contentBody returns the body of the preferred content type of a request or response, if it has content.
+func (w *clientWriter) contentBody(content map[string]openapi.MediaType) (body, bool) {
+	contentType, ok := openapi.PreferredContentType(content)
+	if !ok {
+		return body{}, false
+	}
+
+	switch {
+	case strings.Contains(contentType, "json"):
+		return body{Type: w.types.typeOf(content[contentType].Schema, nil, w.imports), Kind: bodyJSON, ContentType: contentType}, true
+	case strings.HasPrefix(contentType, "text/"):
+		return body{Type: "string", Kind: bodyText, ContentType: contentType}, true
+	}
+
+	return body{Type: "[]byte", Kind: bodyBinary, ContentType: contentType}, true
+}
+
+// param is a path, query or header param of an operation, with the name of its field in the params of the method.
+type param struct {
+	openapi.Parameter
+	Field string
+	Type  string
+}
+
+// clientWriter writes the methods of the client for the operations, with their params and errors.
+type clientWriter struct {
+	types    *typeWriter
+	names    *uniqueNames
+	methods  *uniqueNames
+	imports  imports
+	basePath string
+}
+
+// writeOperation writes the method of the client that sends the request of an operation, and the types of its params and errors.
+// The method returns the body of the successful response, or the error of the response if it has one (i.e. *GetPetNotFoundError).
+func (w *clientWriter) writeOperation(b *strings.Builder, operation openapi.PathOperation) {
+	method := w.methods.unique(goName(operation.OperationID))
+	route := operation.Method + " " + operation.Path
+
+	params := w.params(operation)
+	paramsType := ""
+	if len(params) > 0 {
+		paramsType = w.names.unique(method + "Params")
+		fmt.Fprintf(b, "\n// %s are the params of %s.\ntype %s struct {\n", paramsType, method, paramsType)
+		for _, p := range params {
+			writeDocComment(b, "\t", p.Description)
+			fmt.Fprintf(b, "\t%s %s\n", p.Field, p.Type)
+		}
+		b.WriteString("}\n")
+	}
+
+	requestBody, hasRequestBody := body{}, false
+	if operation.RequestBody != nil {
+		requestBody, hasRequestBody = w.contentBody(operation.RequestBody.Content)
+	}
+
+	result, hasResult := w.result(operation)
+	errorTypes := w.writeErrors(b, method, route, operation)
+
+	// The signature of the method, with its doc comment
+	description := fmt.Sprintf("%s sends the %s request (%s).", method, route, operation.OperationID)
+	if text := strings.TrimSpace(operation.Summary + "\n\n" + operation.Description); text != "" {
+		description += "\n\n" + text
+	}
+	if operation.Deprecated {
+		description += "\n\nDeprecated: the route is deprecated."
+	}
+	b.WriteString("\n")
+	writeDocComment(b, "", description)
+
+	arguments := []string{"ctx context.Context"}
+	if paramsType != "" {
+		arguments = append(arguments, "params "+paramsType)
+	}
+	if hasRequestBody {
+		switch {
+		case requestBody.Kind == bodyJSON:
+			arguments = append(arguments, "body "+requestBody.Type)
+		case requestBody.ContentType == "multipart/form-data":
+			// The content type of multipart form data has the boundary of its parts
+			arguments = append(arguments, "body io.Reader", "contentType string")
+		default:
+			arguments = append(arguments, "body io.Reader")
+		}
+	}
+
+	returnValues := "error"
+	zero := ""
+	if hasResult {
+		returnValues = "(" + result.Type + ", error)"
+		zero = "result, "
+	}
+	fmt.Fprintf(b, "func (c *Client) %s(%s) %s {\n", method, strings.Join(arguments, ", "), returnValues)
+
+	// The request, with its params and body
+	var request strings.Builder
+	if hasResult {
+		fmt.Fprintf(&request, "\tvar result %s\n", result.Type)
+	}
+	query := w.writeParams(&request, params, "query", "url.Values")
+	header := w.writeParams(&request, params, "header", "http.Header")
+
+	bodyArgument, contentTypeArgument := "nil", `""`
+	if hasRequestBody {
+		bodyArgument, contentTypeArgument = "body", goString(requestBody.ContentType)
+		switch {
+		case requestBody.Kind == bodyJSON:
+			bodyArgument = "requestBody"
+			fmt.Fprintf(&request, "\trequestBody, err := jsonBody(body)\n\tif err != nil {\n\t\treturn %serr\n\t}\n", zero)
+		case requestBody.ContentType == "multipart/form-data":
+			contentTypeArgument = "contentType"
+		}
+	}
+
+	if request.Len() > 0 {
+		b.WriteString(request.String() + "\n")
+	}
+	fmt.Fprintf(b, "\tresponse, err := c.do(ctx, %s, %s, %s, %s, %s, %s)\n", goString(operation.Method), w.pathExpression(operation.Path, params), query, header, bodyArgument, contentTypeArgument)
+	fmt.Fprintf(b, "\tif err != nil {\n\t\treturn %serr\n\t}\n\tdefer response.Body.Close()\n", zero)
+
+	// The responses, by their status codes
+	b.WriteString("\n\tswitch response.StatusCode {\n")
+	for _, status := range sortedKeys(operation.Responses) {
+		code, err := strconv.Atoi(status)
+		if err != nil {
+			continue
+		}
+		response := operation.Responses[status]
+		responseBody, hasResponseBody := w.contentBody(response.Content)
+
+		fmt.Fprintf(b, "\tcase %d:\n", code)
+		if errorType, ok := errorTypes[status]; ok {
+			writeErrorResponse(b, errorType, responseBody, hasResponseBody, zero, "")
+			continue
+		}
+		if hasResult && hasResponseBody && responseBody.Type == result.Type {
+			writeDecode(b, "result", responseBody.Kind)
+			b.WriteString("\t\treturn result, err\n")
+			continue
+		}
+		fmt.Fprintf(b, "\t\treturn %snil\n", zero)
+	}
+	b.WriteString("\t}\n")
+
+	if errorType, ok := errorTypes["default"]; ok {
+		responseBody, hasResponseBody := w.contentBody(operation.Responses["default"].Content)
+		b.WriteString("\n")
+		writeErrorResponse(b, errorType, responseBody, hasResponseBody, zero, "StatusCode: response.StatusCode")
+	} else {
+		fmt.Fprintf(b, "\n\treturn %snewError(response)\n", zero)
+	}
+	b.WriteString("}\n")
+}
+
+// params returns the path, query and header params of an operation, with the names and types of their fields.
+// The params that aren't required are pointers, unless they can already be nil.
+func (w *clientWriter) params(operation openapi.PathOperation) []param {
+	fieldNames := newUniqueNames()
+	var params []param
+	for _, parameter := range operation.Parameters {
+		switch parameter.In {
+		case "path", "query", "header":
+		default:
+			continue
+		}
+
+		t := w.types.typeOf(parameter.Schema, nil, w.imports)
+		if !parameter.Required && parameter.In != "path" {
+			t = pointerTo(t)
+		}
+		params = append(params, param{
+			Parameter: parameter,
+			Field:     fieldNames.unique(goName(parameter.Name)),
+			Type:      t,
+		})
+	}
+
+	return params
+}
+
+// writeParams writes the query or header params of a request to a variable, and returns the variable.
+// It returns nil if the request doesn't have any params there.
+func (w *clientWriter) writeParams(b *strings.Builder, params []param, in string, valuesType string) string {
+	var section []param
+	for _, p := range params {
+		if p.In == in {
+			section = append(section, p)
+		}
+	}
+	if len(section) == 0 {
+		return "nil"
+	}
+
+	fmt.Fprintf(b, "\t%s := make(%s)\n", in, valuesType)
+	for _, p := range section {
+		field := "params." + p.Field
+		name := goString(p.Name)
+		switch {
+		case strings.HasPrefix(p.Type, "*"):
+			fmt.Fprintf(b, "\tif %s != nil {\n\t\t%s.Add(%s, paramString(*%s))\n\t}\n", field, in, name, field)
+		case strings.HasPrefix(p.Type, "[]"):
+			fmt.Fprintf(b, "\tfor _, value := range %s {\n\t\t%s.Add(%s, paramString(value))\n\t}\n", field, in, name)
+		case strings.HasPrefix(p.Type, "map["):
+			// Maps are in the deep object style (i.e. filter[name]=value)
+			fmt.Fprintf(b, "\tfor key, value := range %s {\n\t\t%s.Add(%s+\"[\"+key+\"]\", paramString(value))\n\t}\n", field, in, name)
+		case p.Type == anyType:
+			fmt.Fprintf(b, "\tif %s != nil {\n\t\t%s.Add(%s, paramString(%s))\n\t}\n", field, in, name, field)
+		default:
+			fmt.Fprintf(b, "\t%s.Add(%s, paramString(%s))\n", in, name, field)
+		}
+	}
+
+	return in
+}
+
+// pathExpression writes the path of an operation relative to the base path, with its params escaped (i.e. "/pets/" + url.PathEscape(paramString(params.ID))).
+// The slashes of catch-all params (i.e. {filepath*}) aren't escaped, as they match the rest of the path.
+func (w *clientWriter) pathExpression(path string, params []param) string {
+	path = openapi.TrimBasePath(path, w.basePath)
+
+	fields := make(map[string]string, len(params))
+	for _, p := range params {
+		if p.In == "path" {
+			fields[p.Name] = "params." + p.Field
+		}
+	}
+
+	var parts []string
+	last := 0
+	for _, match := range pathParamRegex.FindAllStringSubmatchIndex(path, -1) {
+		field, ok := fields[path[match[2]:match[3]]]
+		if !ok {
+			continue
+		}
+		if match[0] > last {
+			parts = append(parts, goString(path[last:match[0]]))
+		}
+		if match[4] < match[5] {
+			// The value of a catch-all param is a path itself, so its slashes aren't escaped
+			parts = append(parts, "strings.ReplaceAll(url.PathEscape(paramString("+field+")), \"%2F\", \"/\")")
+		} else {
+			parts = append(parts, "url.PathEscape(paramString("+field+"))")
+		}
+		last = match[1]
+	}
+	if last < len(path) || len(parts) == 0 {
+		parts = append(parts, goString(path[last:]))
+	}
+
+	return strings.Join(parts, " + ")
+}
+
+// result returns the body of the successful response of an operation with the lowest status code, which the method returns.
+func (w *clientWriter) result(operation openapi.PathOperation) (body, bool) {
+	for _, status := range sortedKeys(operation.Responses) {
+		if isSuccessful(status) {
+			if result, ok := w.contentBody(operation.Responses[status].Content); ok {
+				return result, true
+			}
+		}
+	}
+
+	return body{}, false
+}
+
+// writeErrors writes the error types of the responses of an operation whose status codes aren't successful, and returns them by their status codes.
+// The default response is an error of any other status code.
+func (w *clientWriter) writeErrors(b *strings.Builder, method string, route string, operation openapi.PathOperation) map[string]string {
+	errorTypes := make(map[string]string)
+	for _, status := range sortedKeys(operation.Responses) {
+		code, err := strconv.Atoi(status)
+		if isSuccessful(status) || (err != nil && status != "default") {
+			continue
+		}
+
+		response := operation.Responses[status]
+		responseBody, hasResponseBody := w.contentBody(response.Content)
+
+		var errorType, when, message string
+		if status == "default" {
+			errorType = w.names.unique(method + "DefaultError")
+			when = "any other status code"
+			message = fmt.Sprintf("fmt.Sprintf(%s, e.StatusCode, http.StatusText(e.StatusCode))", goString(route+": %d %s"))
+		} else {
+			// Statuses that are errors already (i.e. Internal Server Error) aren't named errors twice
+			errorType = w.names.unique(method + strings.TrimSuffix(statusName(code), "Error") + "Error")
+			when = fmt.Sprintf("%d %s", code, http.StatusText(code))
+			message = goString(strings.TrimSpace(fmt.Sprintf("%s: %d %s", route, code, http.StatusText(code))))
+		}
+		errorTypes[status] = errorType
+
+		b.WriteString("\n")
+		writeDocComment(b, "", typeDescription(errorType, fmt.Sprintf("%s is the error of %s when the response is %s.", errorType, method, when), response.Description))
+		fmt.Fprintf(b, "type %s struct {\n", errorType)
+		if status == "default" {
+			b.WriteString("\t// StatusCode is the status code of the response.\n\tStatusCode int\n")
+		}
+		if hasResponseBody {
+			fmt.Fprintf(b, "\t// Body is the body of the response.\n\tBody %s\n", responseBody.Type)
+		}
+		b.WriteString("}\n")
+
+		fmt.Fprintf(b, "\n// Error returns the method and path of the request, and the status of the response.\nfunc (e *%s) Error() string {\n\treturn %s\n}\n", errorType, message)
+	}
+
+	return errorTypes
+}
+
+// writeErrorResponse writes the code of a method that returns the error of a response, with its body decoded.
+func writeErrorResponse(b *strings.Builder, errorType string, responseBody body, hasResponseBody bool, zero string, fields string) {
+	fmt.Fprintf(b, "\t\tresponseError := &%s{%s}\n", errorType, fields)
+	if hasResponseBody {
+		writeDecode(b, "responseError.Body", responseBody.Kind)
+		fmt.Fprintf(b, "\t\tif err != nil {\n\t\t\treturn %serr\n\t\t}\n", zero)
+	}
+	fmt.Fprintf(b, "\t\treturn %sresponseError\n", zero)
+}
+
+// writeDecode writes the code of a method that decodes the body of a response into a variable, by how it is encoded.
+func writeDecode(b *strings.Builder, variable string, kind bodyKind) {
+	switch kind {
+	case bodyJSON:
+		fmt.Fprintf(b, "\t\terr = decodeJSON(response.Body, &%s)\n", variable)
+	case bodyText:
+		fmt.Fprintf(b, "\t\t%s, err = readString(response.Body)\n", variable)
+	default:
+		fmt.Fprintf(b, "\t\t%s, err = io.ReadAll(response.Body)\n", variable)
+	}
+}
+
+// isSuccessful returns whether the status code of a response is successful (i.e. 200 or 2XX).
+func isSuccessful(status string) bool {
+	return len(status) == 3 && status[0] == '2'
+}
+
+// statusName makes the status text of a status code into a Go name (i.e. NotFound), or Status with the code if it doesn't have one.
+func statusName(code int) string {
+	if text := http.StatusText(code); text != "" {
+		return goName(text)
+	}
+
+	return "Status" + strconv.Itoa(code)
+}
+
+// goString writes a string as a Go string literal.
+func goString(s string) string {
+	return strconv.Quote(s)
+}
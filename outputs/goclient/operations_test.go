@@ -0,0 +1,112 @@
+package goclient
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra/outputs/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientFiles(t *testing.T) {
+	output := openapi.OpenAPISchema{
+		Info: openapi.Info{Title: "Petstore"},
+		Paths: openapi.Paths{
+			"/api/pets/{id}": {
+				Get: &openapi.Operation{
+					OperationID: "getPet",
+					Parameters: []openapi.Parameter{
+						{Name: "id", In: "path", Required: true, Schema: openapi.Schema{Type: "integer"}},
+						{Name: "X-Request-ID", In: "header", Schema: openapi.Schema{Type: "string"}},
+						{Name: "tags", In: "query", Schema: openapi.Schema{Type: "array", Items: &openapi.Schema{Type: "string"}}},
+					},
+					Responses: map[string]openapi.Response{
+						"200": {Content: map[string]openapi.MediaType{"application/json": {Schema: openapi.Schema{Ref: "#/components/schemas/Pet"}}}},
+						"404": {Content: map[string]openapi.MediaType{"application/json": {Schema: openapi.Schema{Ref: "#/components/schemas/Error"}}}},
+						"500": {Content: map[string]openapi.MediaType{"text/plain": {Schema: openapi.Schema{Type: "string"}}}},
+					},
+				},
+				Delete: &openapi.Operation{
+					OperationID: "deletePet",
+					Parameters: []openapi.Parameter{
+						{Name: "id", In: "path", Required: true, Schema: openapi.Schema{Type: "integer"}},
+					},
+					Responses: map[string]openapi.Response{
+						"204":     {},
+						"default": {Content: map[string]openapi.MediaType{"application/json": {Schema: openapi.Schema{Ref: "#/components/schemas/Error"}}}},
+					},
+				},
+			},
+			"/api/pets": {
+				Post: &openapi.Operation{
+					OperationID: "createPet",
+					RequestBody: &openapi.RequestBody{
+						Content: map[string]openapi.MediaType{"application/json": {Schema: openapi.Schema{Ref: "#/components/schemas/Pet"}}},
+					},
+					Responses: map[string]openapi.Response{
+						"201": {Content: map[string]openapi.MediaType{"application/json": {Schema: openapi.Schema{Ref: "#/components/schemas/Pet"}}}},
+					},
+				},
+				Put: &openapi.Operation{
+					OperationID: "uploadPet",
+					RequestBody: &openapi.RequestBody{
+						Content: map[string]openapi.MediaType{"multipart/form-data": {Schema: openapi.Schema{Type: "object"}}},
+					},
+				},
+			},
+		},
+		Components: openapi.Components{
+			Schemas: map[string]openapi.Schema{
+				"Pet":   {Type: "object", Properties: map[string]openapi.Schema{"name": {Type: "string"}}},
+				"Error": {Type: "object", Properties: map[string]openapi.Schema{"message": {Type: "string"}}},
+			},
+		},
+	}
+
+	files, err := clientFiles(output, nil, "/api/", Options{PackageName: "petstore"})
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	client := string(files[clientFile])
+
+	t.Run("Runtime", func(t *testing.T) {
+		require.Contains(t, client, "// Code generated by astra. DO NOT EDIT.\n\n// Package petstore is a client of the Petstore API.\npackage petstore\n")
+		require.Contains(t, client, "const BasePath = \"/api\"\n")
+		require.Contains(t, client, "type Client struct {\n")
+	})
+
+	t.Run("Params", func(t *testing.T) {
+		require.Contains(t, client, "type GetPetParams struct {\n\tID         int\n\tXRequestID *string\n\tTags       []string\n}\n")
+		require.Contains(t, client, "\tfor _, value := range params.Tags {\n\t\tquery.Add(\"tags\", paramString(value))\n\t}\n")
+		require.Contains(t, client, "\tif params.XRequestID != nil {\n\t\theader.Add(\"X-Request-ID\", paramString(*params.XRequestID))\n\t}\n")
+		require.Contains(t, client, "c.do(ctx, \"GET\", \"/pets/\"+url.PathEscape(paramString(params.ID)), query, header, nil, \"\")")
+	})
+
+	t.Run("Methods", func(t *testing.T) {
+		require.Contains(t, client, "func (c *Client) GetPet(ctx context.Context, params GetPetParams) (Pet, error) {\n")
+		require.Contains(t, client, "func (c *Client) CreatePet(ctx context.Context, body Pet) (Pet, error) {\n")
+		require.Contains(t, client, "func (c *Client) UploadPet(ctx context.Context, body io.Reader, contentType string) error {\n")
+		require.Contains(t, client, "func (c *Client) DeletePet(ctx context.Context, params DeletePetParams) error {\n")
+		require.Contains(t, client, "\tcase 200:\n\t\terr = decodeJSON(response.Body, &result)\n\t\treturn result, err\n")
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		require.Contains(t, client, "type GetPetNotFoundError struct {\n\t// Body is the body of the response.\n\tBody Error\n}\n")
+		require.Contains(t, client, "type GetPetInternalServerError struct {\n\t// Body is the body of the response.\n\tBody string\n}\n")
+		require.Contains(t, client, "\t\tresponseError.Body, err = readString(response.Body)\n")
+		require.Contains(t, client, "\tresponseError := &DeletePetDefaultError{StatusCode: response.StatusCode}\n")
+		require.Contains(t, client, "\treturn result, newError(response)\n")
+	})
+}
+
+func TestPathExpression(t *testing.T) {
+	w := &clientWriter{basePath: "/api"}
+	params := []param{
+		{Parameter: openapi.Parameter{Name: "id", In: "path"}, Field: "ID"},
+		{Parameter: openapi.Parameter{Name: "photo_id", In: "path"}, Field: "PhotoID"},
+	}
+
+	require.Equal(t, `"/pets"`, w.pathExpression("/api/pets", nil))
+	require.Equal(t, `"/"`, w.pathExpression("/api/", nil))
+	require.Equal(t, `"/pets/" + url.PathEscape(paramString(params.ID)) + "/photos/" + url.PathEscape(paramString(params.PhotoID))`, w.pathExpression("/api/pets/{id}/photos/{photo_id}", params))
+	require.Equal(t, `"/pets/{name}"`, w.pathExpression("/api/pets/{name}", params))
+	require.Equal(t, `"/pets/" + url.PathEscape(paramString(params.ID)) + "/files" + strings.ReplaceAll(url.PathEscape(paramString(params.PhotoID)), "%2F", "/")`, w.pathExpression("/api/pets/{id}/files{photo_id*}", params))
+}
@@ -0,0 +1,38 @@
+package goclient
+
+// Options are the options of the Go client output.
+type Options struct {
+	// PackageName is the name of the package of the client, which is the name of its directory by default.
+	PackageName string
+	// OriginalTypes is whether the client imports the original Go types of the components from their packages, instead of generating its own.
+	// The packages must be importable by the code that uses the client, so they can't be main or internal packages of another module.
+	OriginalTypes bool
+}
+
+// Option is an option of the Go client output.
+type Option func(*Options)
+
+// NewOptions creates the options of the Go client output, with the defaults for those that aren't set.
+// The default package name depends on the directory of the output, so it is set when the client is generated.
+func NewOptions(options ...Option) Options {
+	var o Options
+	for _, option := range options {
+		option(&o)
+	}
+
+	return o
+}
+
+// WithPackageName is an option to pick the name of the package of the client (i.e. petstore).
+func WithPackageName(packageName string) Option {
+	return func(o *Options) {
+		o.PackageName = packageName
+	}
+}
+
+// WithOriginalTypes is an option to import the original Go types of the components from their packages, instead of generating the types of the client.
+func WithOriginalTypes(originalTypes bool) Option {
+	return func(o *Options) {
+		o.OriginalTypes = originalTypes
+	}
+}
@@ -0,0 +1,17 @@
+package goclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOptions(t *testing.T) {
+	t.Run("it leaves the package name to the directory by default", func(t *testing.T) {
+		require.Equal(t, Options{}, NewOptions())
+	})
+
+	t.Run("it applies the options", func(t *testing.T) {
+		require.Equal(t, Options{PackageName: "petstore", OriginalTypes: true}, NewOptions(WithPackageName("petstore"), WithOriginalTypes(true)))
+	})
+}
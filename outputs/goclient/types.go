@@ -0,0 +1,388 @@
+package goclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/build"
+	"go/token"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ls6-events/astra/outputs/openapi"
+)
+
+// schemaRefPrefix is the prefix of the references to the schemas of the components.
+const schemaRefPrefix = "#/components/schemas/"
+
+// anyType is the type of a schema that could be anything.
+const anyType = "any"
+
+// localNames are the names of the variables of the methods of the client, which the imported packages can't be named.
+var localNames = []string{"body", "contentType", "ctx", "err", "header", "params", "query", "requestBody", "response", "responseError", "result"}
+
+// imports are the packages that a file imports by their paths, with the names they are imported as.
+// The standard library packages are imported by their own names, so their names can be empty.
+type imports map[string]string
+
+// write writes the imports of a file, with the standard library packages first.
+func (i imports) write(b *strings.Builder) {
+	if len(i) == 0 {
+		return
+	}
+
+	var standard, other []string
+	for importPath := range i {
+		if isDomainPackage(importPath) {
+			other = append(other, importPath)
+		} else {
+			standard = append(standard, importPath)
+		}
+	}
+	sort.Strings(standard)
+	sort.Strings(other)
+
+	b.WriteString("\nimport (\n")
+	for _, importPath := range standard {
+		fmt.Fprintf(b, "\t%s%s\n", importName(importPath, i[importPath]), strconv.Quote(importPath))
+	}
+	if len(standard) > 0 && len(other) > 0 {
+		b.WriteString("\n")
+	}
+	for _, importPath := range other {
+		fmt.Fprintf(b, "\t%s%s\n", importName(importPath, i[importPath]), strconv.Quote(importPath))
+	}
+	b.WriteString(")\n")
+}
+
+// importName writes the name that a package is imported as before its path, if it isn't imported by its own name.
+func importName(importPath string, name string) string {
+	if name == "" || (!isDomainPackage(importPath) && name == path.Base(importPath)) {
+		return ""
+	}
+
+	return name + " "
+}
+
+// componentType is the Go type of a component, which is in a package if it is the original type of the component.
+type componentType struct {
+	Name    string
+	Package string
+}
+
+// typeWriter writes the Go types of the schemas of the API, and the types of its components.
+type typeWriter struct {
+	components map[string]openapi.Schema
+	// goTypes are the original Go types of the components, by their names in the specification.
+	goTypes openapi.GoTypes
+	// types are the Go types of the components, by their names in the specification.
+	types map[string]componentType
+	// packageNames are the names that the packages of the original types are imported as, by their paths.
+	packageNames map[string]string
+	// names are the names that are taken in the package of the client.
+	names *uniqueNames
+}
+
+// newTypeWriter names the Go types of the components, taking their names in the package of the client.
+// The components are the original types in their packages if they are picked and can be imported, or types of the client otherwise.
+func newTypeWriter(components map[string]openapi.Schema, goTypes openapi.GoTypes, originalTypes bool, names *uniqueNames) *typeWriter {
+	w := &typeWriter{
+		components:   components,
+		goTypes:      goTypes,
+		types:        make(map[string]componentType, len(components)),
+		packageNames: make(map[string]string),
+		names:        names,
+	}
+
+	packageNames := newUniqueNames(append(standardPackageNames(), localNames...)...)
+	for _, name := range sortedKeys(components) {
+		goType := goTypes[name]
+		// The types of the standard library (i.e. time.Time) are always imported, as they are importable by any package
+		if isImportable(goType) && isStandardPackage(goType.Package) {
+			w.packageNames[goType.Package] = path.Base(goType.Package)
+			packageNames.unique(path.Base(goType.Package))
+			w.types[name] = componentType{Name: goType.Name, Package: goType.Package}
+		}
+	}
+	for _, name := range sortedKeys(components) {
+		goType := goTypes[name]
+		if _, ok := w.types[name]; ok {
+			continue
+		}
+		if originalTypes && isImportable(goType) {
+			if _, ok := w.packageNames[goType.Package]; !ok {
+				w.packageNames[goType.Package] = packageNames.unique(packageName(path.Base(goType.Package), "pkg"))
+			}
+			w.types[name] = componentType{Name: goType.Name, Package: goType.Package}
+			continue
+		}
+
+		w.types[name] = componentType{Name: names.unique(goName(name))}
+	}
+
+	return w
+}
+
+// isImportable returns whether the original Go type of a component can be imported from its package.
+// Types of the main package, or that aren't exported (i.e. generic types), are generated instead.
+func isImportable(goType openapi.GoType) bool {
+	return goType.Package != "" && goType.Package != "main" && token.IsIdentifier(goType.Name) && token.IsExported(goType.Name)
+}
+
+// isStandardPackage returns whether a package is in the standard library (i.e. time).
+func isStandardPackage(importPath string) bool {
+	pkg, err := build.Default.Import(importPath, "", build.FindOnly)
+	return err == nil && pkg.Goroot
+}
+
+// isDomainPackage returns whether the first element of the path of a package is a domain (i.e. github.com), which standard library packages don't have.
+func isDomainPackage(importPath string) bool {
+	return strings.Contains(strings.Split(importPath, "/")[0], ".")
+}
+
+// componentTypeOf writes the Go type of a component, adding the import of its package if it is the original type.
+func (w *typeWriter) componentTypeOf(name string, imp imports) string {
+	t, ok := w.types[name]
+	if !ok {
+		return anyType
+	}
+	if t.Package == "" {
+		return t.Name
+	}
+
+	imp[t.Package] = w.packageNames[t.Package]
+	return w.packageNames[t.Package] + "." + t.Name
+}
+
+// typeOf writes the Go type of a schema, which is a pointer if it is nullable and can't already be nil.
+// The fields are the struct fields of the properties of the schema, if it is an object.
+func (w *typeWriter) typeOf(schema openapi.Schema, fields openapi.Fields, imp imports) string {
+	t := w.nonNullableTypeOf(schema, fields, imp)
+	if schema.Nullable {
+		return pointerTo(t)
+	}
+
+	return t
+}
+
+// nonNullableTypeOf writes the Go type of a schema, ignoring whether it is nullable.
+// Unions can't be Go types, so they are any, and enums are their underlying types unless they are components.
+func (w *typeWriter) nonNullableTypeOf(schema openapi.Schema, fields openapi.Fields, imp imports) string {
+	switch {
+	case schema.Ref != "":
+		return w.componentTypeOf(strings.TrimPrefix(schema.Ref, schemaRefPrefix), imp)
+	case len(schema.AllOf) == 1:
+		return w.typeOf(schema.AllOf[0], fields, imp)
+	case len(schema.AllOf) > 0:
+		return w.structOf(schema, fields, "", imp)
+	case len(schema.OneOf) > 0, len(schema.AnyOf) > 0:
+		return anyType
+	}
+
+	switch schema.Type {
+	case "string":
+		switch schema.Format {
+		case "binary":
+			return "[]byte"
+		case "date-time":
+			imp["time"] = ""
+			return "time.Time"
+		}
+		return "string"
+	case "integer":
+		switch schema.Format {
+		case "int32", "int64":
+			return schema.Format
+		}
+		return "int"
+	case "number":
+		if schema.Format == "float" {
+			return "float32"
+		}
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "array":
+		if schema.Items == nil {
+			return "[]" + anyType
+		}
+		return "[]" + w.typeOf(*schema.Items, nil, imp)
+	case "object":
+		if len(schema.Properties) > 0 {
+			return w.structOf(schema, fields, "", imp)
+		}
+		if schema.AdditionalProperties != nil {
+			return "map[string]" + w.typeOf(*schema.AdditionalProperties, nil, imp)
+		}
+		return "map[string]" + anyType
+	}
+
+	return anyType
+}
+
+// structOf writes the struct of an object schema, with the fields indented from the prefix.
+func (w *typeWriter) structOf(schema openapi.Schema, fields openapi.Fields, prefix string, imp imports) string {
+	var b strings.Builder
+	b.WriteString("struct {\n")
+	w.writeFields(&b, schema, fields, prefix+"\t", imp)
+	b.WriteString(prefix + "}")
+
+	return b.String()
+}
+
+// writeFields writes the fields of the struct of an object schema, with the components it is composed of (i.e. allOf) embedded first.
+// The fields are sorted by their names, and fields whose struct fields are omitted when they are empty (i.e. omitempty) are omitted by the client too.
+func (w *typeWriter) writeFields(b *strings.Builder, schema openapi.Schema, fields openapi.Fields, prefix string, imp imports) {
+	parts := schema.AllOf
+	if len(parts) == 0 {
+		parts = []openapi.Schema{schema}
+	}
+
+	fieldNames := newUniqueNames()
+	var objects []openapi.Schema
+	for _, part := range parts {
+		name := strings.TrimPrefix(part.Ref, schemaRefPrefix)
+		if _, ok := w.types[name]; !ok || part.Ref == "" {
+			objects = append(objects, part)
+			continue
+		}
+
+		// The name of an embedded field is the name of its type, without its package
+		embedded := w.componentTypeOf(name, imp)
+		fieldNames.unique(embedded[strings.LastIndex(embedded, ".")+1:])
+		fmt.Fprintf(b, "%s%s\n", prefix, embedded)
+	}
+
+	for _, object := range objects {
+		for _, name := range sortedKeys(object.Properties) {
+			property := object.Properties[name]
+			tag := name
+			if fields[name].OmitEmpty {
+				tag += ",omitempty"
+			}
+
+			writeDocComment(b, prefix, property.Description)
+			fmt.Fprintf(b, "%s%s %s `json:%s`\n", prefix, fieldNames.unique(goName(name)), w.typeOf(property, fields[name].Fields, imp), strconv.Quote(tag))
+		}
+	}
+}
+
+// writeComponents writes the declarations of the types of the components that aren't original types, sorted by their names.
+func (w *typeWriter) writeComponents(b *strings.Builder, imp imports) {
+	for _, name := range sortedKeys(w.components) {
+		t := w.types[name]
+		if t.Package != "" {
+			continue
+		}
+
+		b.WriteString("\n")
+		w.writeComponent(b, name, t.Name, imp)
+	}
+}
+
+// writeComponent writes the declaration of the type of a component.
+// Objects are structs, enums are types with a constant for every value, and anything else is an alias of its Go type, so it keeps the methods of the type.
+func (w *typeWriter) writeComponent(b *strings.Builder, name string, typeName string, imp imports) {
+	schema := w.components[name]
+	fields := w.goTypes[name].Fields
+	writeDocComment(b, "", typeDescription(typeName, fmt.Sprintf("%s is the %s schema of the API.", typeName, name), schema.Description))
+
+	switch {
+	case !schema.Nullable && (len(schema.AllOf) > 1 || (schema.Type == "object" && len(schema.Properties) > 0)):
+		fmt.Fprintf(b, "type %s %s\n", typeName, w.structOf(schema, fields, "", imp))
+	case len(schema.Enum) > 0:
+		underlyingType := w.nonNullableTypeOf(openapi.Schema{Type: schema.Type, Format: schema.Format}, nil, imp)
+		fmt.Fprintf(b, "type %s %s\n", typeName, underlyingType)
+		w.writeEnumValues(b, typeName, underlyingType, schema)
+	default:
+		fmt.Fprintf(b, "type %s = %s\n", typeName, w.typeOf(schema, fields, imp))
+	}
+}
+
+// writeEnumValues writes a constant for every value of an enum, named by the names of the Go constants (i.e. x-enum-varnames) or by their values.
+// The values that can't be constants of the type of the enum are left out.
+func (w *typeWriter) writeEnumValues(b *strings.Builder, typeName string, underlyingType string, schema openapi.Schema) {
+	var constants []string
+	for i, value := range schema.Enum {
+		literal, ok := enumLiteral(value, underlyingType)
+		if !ok {
+			continue
+		}
+
+		name := typeName + goName(fmt.Sprint(value))
+		if i < len(schema.XEnumVarNames) && token.IsIdentifier(schema.XEnumVarNames[i]) && token.IsExported(schema.XEnumVarNames[i]) {
+			name = schema.XEnumVarNames[i]
+		}
+		constants = append(constants, fmt.Sprintf("\t%s %s = %s\n", w.names.unique(name), typeName, literal))
+	}
+	if len(constants) == 0 {
+		return
+	}
+
+	fmt.Fprintf(b, "\n// The values of %s.\nconst (\n%s)\n", typeName, strings.Join(constants, ""))
+}
+
+// enumLiteral writes the value of an enum as a Go literal, if it can be a constant of the underlying type of the enum.
+func enumLiteral(value any, underlyingType string) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return strconv.Quote(v), underlyingType == "string"
+	case float64, int, int64:
+		literal, err := json.Marshal(v)
+		if err != nil || (strings.ContainsAny(string(literal), ".eE") && strings.HasPrefix(underlyingType, "int")) {
+			return "", false
+		}
+		return string(literal), strings.HasPrefix(underlyingType, "int") || strings.HasPrefix(underlyingType, "float")
+	case bool:
+		return strconv.FormatBool(v), underlyingType == "bool"
+	}
+
+	return "", false
+}
+
+// pointerTo writes a pointer to a type, unless the type can already be nil.
+func pointerTo(t string) string {
+	if t == anyType || strings.HasPrefix(t, "*") || strings.HasPrefix(t, "[]") || strings.HasPrefix(t, "map[") {
+		return t
+	}
+
+	return "*" + t
+}
+
+// typeDescription writes the description of a type, which starts with its name as Go doc comments do.
+// A description that doesn't start with the name follows the fallback sentence.
+func typeDescription(name string, fallback string, description string) string {
+	description = strings.TrimSpace(description)
+	switch {
+	case description == "":
+		return fallback
+	case strings.HasPrefix(description, name+" "):
+		return description
+	}
+
+	return fallback + "\n\n" + description
+}
+
+// writeDocComment writes a description as a Go comment, if there is one.
+func writeDocComment(b *strings.Builder, prefix string, description string) {
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return
+	}
+
+	for _, line := range strings.Split(description, "\n") {
+		fmt.Fprintf(b, "%s//%s\n", prefix, strings.TrimRight(" "+line, " "))
+	}
+}
+
+// sortedKeys returns the keys of a map in order, so the output is the same every time.
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,117 @@
+package goclient
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ls6-events/astra/outputs/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypeOf(t *testing.T) {
+	w := newTypeWriter(map[string]openapi.Schema{"Pet": {Type: "object"}}, nil, false, newUniqueNames())
+
+	t.Run("it maps the types of schemas to Go types", func(t *testing.T) {
+		imp := make(imports)
+		require.Equal(t, "string", w.typeOf(openapi.Schema{Type: "string"}, nil, imp))
+		require.Equal(t, "[]byte", w.typeOf(openapi.Schema{Type: "string", Format: "binary"}, nil, imp))
+		require.Equal(t, "int64", w.typeOf(openapi.Schema{Type: "integer", Format: "int64"}, nil, imp))
+		require.Equal(t, "float32", w.typeOf(openapi.Schema{Type: "number", Format: "float"}, nil, imp))
+		require.Equal(t, "[]Pet", w.typeOf(openapi.Schema{Type: "array", Items: &openapi.Schema{Ref: "#/components/schemas/Pet"}}, nil, imp))
+		require.Equal(t, "map[string]bool", w.typeOf(openapi.Schema{Type: "object", AdditionalProperties: &openapi.Schema{Type: "boolean"}}, nil, imp))
+		require.Equal(t, "any", w.typeOf(openapi.Schema{OneOf: []openapi.Schema{{Type: "string"}, {Type: "integer"}}}, nil, imp))
+		require.Empty(t, imp)
+	})
+
+	t.Run("it imports the packages of the types", func(t *testing.T) {
+		imp := make(imports)
+		require.Equal(t, "*time.Time", w.typeOf(openapi.Schema{Type: "string", Format: "date-time", Nullable: true}, nil, imp))
+		require.Equal(t, imports{"time": ""}, imp)
+	})
+
+	t.Run("it only makes pointers of types that can't be nil", func(t *testing.T) {
+		imp := make(imports)
+		require.Equal(t, "*Pet", w.typeOf(openapi.Schema{Ref: "#/components/schemas/Pet", Nullable: true}, nil, imp))
+		require.Equal(t, "[]string", w.typeOf(openapi.Schema{Type: "array", Items: &openapi.Schema{Type: "string"}, Nullable: true}, nil, imp))
+	})
+}
+
+func TestWriteComponents(t *testing.T) {
+	components := map[string]openapi.Schema{
+		"Animal": {Type: "object", Properties: map[string]openapi.Schema{"legs": {Type: "integer"}}},
+		"Pet": {
+			Description: "Pet is a pet in the store.",
+			AllOf: []openapi.Schema{
+				{Ref: "#/components/schemas/Animal"},
+				{Type: "object", Properties: map[string]openapi.Schema{
+					"id":  {Type: "integer"},
+					"tag": {Type: "string", Description: "The tag of the pet."},
+				}},
+			},
+		},
+		"PetStatus": {Type: "string", Enum: []any{"available", "sold"}, XEnumVarNames: []string{"StatusAvailable"}},
+		"Client":    {Type: "string"},
+		"Timestamp": {Type: "string", Format: "date-time"},
+	}
+	goTypes := openapi.GoTypes{
+		"Pet":       {Fields: openapi.Fields{"tag": {OmitEmpty: true}}},
+		"Timestamp": {Name: "Time", Package: "time"},
+	}
+
+	var b strings.Builder
+	w := newTypeWriter(components, goTypes, false, newUniqueNames(clientNames...))
+	w.writeComponents(&b, make(imports))
+	contents := b.String()
+
+	t.Run("it writes structs with embedded components", func(t *testing.T) {
+		require.Contains(t, contents, "// Pet is a pet in the store.\ntype Pet struct {\n\tAnimal\n\tID int `json:\"id\"`\n\t// The tag of the pet.\n\tTag string `json:\"tag,omitempty\"`\n}\n")
+	})
+
+	t.Run("it writes enums with constants for their values", func(t *testing.T) {
+		require.Contains(t, contents, "type PetStatus string\n\n// The values of PetStatus.\nconst (\n\tStatusAvailable PetStatus = \"available\"\n\tPetStatusSold PetStatus = \"sold\"\n)\n")
+	})
+
+	t.Run("it renames components that the client takes the names of", func(t *testing.T) {
+		require.Contains(t, contents, "// Client2 is the Client schema of the API.\ntype Client2 = string\n")
+	})
+
+	t.Run("it imports the types of the standard library", func(t *testing.T) {
+		require.NotContains(t, contents, "Timestamp")
+
+		imp := make(imports)
+		require.Equal(t, "time.Time", w.typeOf(openapi.Schema{Ref: "#/components/schemas/Timestamp"}, nil, imp))
+		require.Equal(t, imports{"time": "time"}, imp)
+	})
+}
+
+func TestOriginalTypes(t *testing.T) {
+	components := map[string]openapi.Schema{
+		"Pet":      {Type: "object"},
+		"Pet_form": {Type: "object"},
+		"Response": {Type: "object"},
+		"Context":  {Type: "object"},
+	}
+	goTypes := openapi.GoTypes{
+		"Pet":      {Name: "Pet", Package: "github.com/ls6-events/petstore/pets"},
+		"Pet_form": {Name: "Pet", Package: "github.com/ls6-events/petstore/pets"},
+		"Response": {Name: "Response", Package: "main"},
+		"Context":  {Name: "Context", Package: "github.com/ls6-events/petstore/context"},
+	}
+	w := newTypeWriter(components, goTypes, true, newUniqueNames(clientNames...))
+
+	t.Run("it imports the original types from their packages", func(t *testing.T) {
+		imp := make(imports)
+		require.Equal(t, "pets.Pet", w.typeOf(openapi.Schema{Ref: "#/components/schemas/Pet_form"}, nil, imp))
+		require.Equal(t, imports{"github.com/ls6-events/petstore/pets": "pets"}, imp)
+	})
+
+	t.Run("it doesn't import the packages as the names the client uses", func(t *testing.T) {
+		require.Equal(t, "context2.Context", w.typeOf(openapi.Schema{Ref: "#/components/schemas/Context"}, nil, make(imports)))
+	})
+
+	t.Run("it writes the types that can't be imported", func(t *testing.T) {
+		var b strings.Builder
+		w.writeComponents(&b, make(imports))
+		require.Equal(t, "\n// Response is the Response schema of the API.\ntype Response = map[string]any\n", b.String())
+	})
+}
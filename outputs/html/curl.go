@@ -0,0 +1,97 @@
+package html
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/ls6-events/astra/outputs/openapi"
+)
+
+// curl writes an example curl command of a route, which can be copied from the page.
+// The params in its path and its headers are examples of their schemas, as are its required query params and its body, so it can be sent once they are filled in.
+func (w *pageWriter) curl(routeOperation routeOperation) string {
+	spec := routeOperation.Operation
+
+	requestPath := routeOperation.Path
+	var query []string
+	var headers []string
+	for _, parameter := range spec.Parameters {
+		value := openapi.ExampleString(openapi.Example(parameter.Schema, w.components))
+		switch parameter.In {
+		case "path":
+			requestPath = strings.NewReplacer("{"+parameter.Name+"}", url.PathEscape(value), "{"+parameter.Name+"*}", url.PathEscape(value)).Replace(requestPath)
+		case "query":
+			if parameter.Required {
+				query = append(query, url.QueryEscape(parameter.Name)+"="+url.QueryEscape(value))
+			}
+		case "header":
+			headers = append(headers, parameter.Name+": "+value)
+		}
+	}
+
+	requestURL := w.baseURL + requestPath
+	if len(query) > 0 {
+		requestURL += "?" + strings.Join(query, "&")
+	}
+
+	lines := []string{fmt.Sprintf("curl -X %s %s", routeOperation.Route.Method, shellQuote(requestURL))}
+	for _, header := range headers {
+		lines = append(lines, "-H "+shellQuote(header))
+	}
+	if spec.RequestBody != nil {
+		if contentType, ok := bodyContentType(routeOperation.Route, spec.RequestBody.Content); ok {
+			lines = append(lines, w.curlBody(contentType, spec.RequestBody.Content[contentType].Schema)...)
+		}
+	}
+
+	return strings.Join(lines, " \\\n  ")
+}
+
+// curlBody writes the options of the body of a curl command, by its content type.
+// Forms have a field for every property of their schemas, which is uploaded from a file of its name if it is binary, and curl sets their content types.
+func (w *pageWriter) curlBody(contentType string, schema openapi.Schema) []string {
+	switch contentType {
+	case "multipart/form-data":
+		properties := openapi.FormProperties(schema, w.components)
+		options := make([]string, 0, len(properties))
+		for _, name := range sortedNames(properties) {
+			property := properties[name]
+			if openapi.IsBinary(property) {
+				options = append(options, "-F "+shellQuote(name+"=@./"+name))
+				continue
+			}
+			options = append(options, "-F "+shellQuote(name+"="+openapi.ExampleString(openapi.Example(property, w.components))))
+		}
+		return options
+	case "application/x-www-form-urlencoded":
+		properties := openapi.FormProperties(schema, w.components)
+		options := make([]string, 0, len(properties))
+		for _, name := range sortedNames(properties) {
+			options = append(options, "--data-urlencode "+shellQuote(name+"="+openapi.ExampleString(openapi.Example(properties[name], w.components))))
+		}
+		return options
+	default:
+		return []string{
+			"-H " + shellQuote("Content-Type: "+contentType),
+			"--data-raw " + shellQuote(w.example(contentType, schema)),
+		}
+	}
+}
+
+// sortedNames returns the names of the properties, sorted.
+func sortedNames(properties map[string]openapi.Schema) []string {
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// shellQuote quotes a value for a POSIX shell, in single quotes so nothing in it is expanded.
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
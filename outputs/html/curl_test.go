@@ -0,0 +1,70 @@
+package html
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/outputs/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurl(t *testing.T) {
+	w := &pageWriter{
+		baseURL: "http://localhost:8000/api",
+		components: map[string]openapi.Schema{
+			"Pet": {Type: "object", Properties: map[string]openapi.Schema{
+				"name": {Type: "string", Example: "Rex's ball"},
+			}},
+			"Photo": {Type: "object", Properties: map[string]openapi.Schema{
+				"caption": {Type: "string"},
+				"photo":   {Type: "string", Format: "binary"},
+			}},
+		},
+	}
+
+	t.Run("it fills in the params of the route", func(t *testing.T) {
+		curl := w.curl(routeOperation{
+			Route: astra.Route{Method: "GET"},
+			Operation: &openapi.Operation{
+				Parameters: []openapi.Parameter{
+					{Name: "id", In: "path", Required: true, Schema: openapi.Schema{Type: "integer"}},
+					{Name: "limit", In: "query", Required: true, Schema: openapi.Schema{Type: "integer", Minimum: 1}},
+					{Name: "fields", In: "query", Schema: openapi.Schema{Type: "string"}},
+					{Name: "X-Request-ID", In: "header", Schema: openapi.Schema{Type: "string", Format: "uuid"}},
+				},
+			},
+			Path: "/pets/{id}",
+		})
+
+		require.Equal(t, "curl -X GET 'http://localhost:8000/api/pets/0?limit=1' \\\n  -H 'X-Request-ID: 00000000-0000-0000-0000-000000000000'", curl)
+	})
+
+	t.Run("it quotes a JSON body", func(t *testing.T) {
+		curl := w.curl(routeOperation{
+			Route: astra.Route{Method: "POST"},
+			Operation: &openapi.Operation{
+				RequestBody: &openapi.RequestBody{Content: map[string]openapi.MediaType{
+					"application/json": {Schema: openapi.Schema{Ref: "#/components/schemas/Pet"}},
+				}},
+			},
+			Path: "/pets",
+		})
+
+		require.Equal(t, "curl -X POST 'http://localhost:8000/api/pets' \\\n  -H 'Content-Type: application/json' \\\n  --data-raw '{\n  \"name\": \"Rex'\\''s ball\"\n}'", curl)
+	})
+
+	t.Run("it uploads the files of a multipart form", func(t *testing.T) {
+		curl := w.curl(routeOperation{
+			Route: astra.Route{Method: "POST", Body: []astra.BodyParam{{ContentType: "multipart/form-data"}}},
+			Operation: &openapi.Operation{
+				RequestBody: &openapi.RequestBody{Content: map[string]openapi.MediaType{
+					"application/json":    {Schema: openapi.Schema{Ref: "#/components/schemas/Photo"}},
+					"multipart/form-data": {Schema: openapi.Schema{Ref: "#/components/schemas/Photo"}},
+				}},
+			},
+			Path: "/photos",
+		})
+
+		require.Equal(t, "curl -X POST 'http://localhost:8000/api/photos' \\\n  -F 'caption=string' \\\n  -F 'photo=@./photo'", curl)
+	})
+}
@@ -0,0 +1,57 @@
+package html
+
+import (
+	_ "embed"
+	"html/template"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/outputs/openapi"
+)
+
+//go:embed page.html.tmpl
+var pageHTML string
+
+// pageTemplate is the template of the page, whose styles and scripts are inline so it can be viewed offline.
+var pageTemplate = template.Must(template.New("page").Funcs(template.FuncMap{
+	"lower": strings.ToLower,
+}).Parse(pageHTML))
+
+// Generate generates the HTML output.
+// It writes a single self-contained HTML file, with a sidebar linking to a section for every route and component, collapsible schemas with examples, and an example curl command of every route that can be copied.
+// The styles and scripts of the page are inline and it doesn't load anything else, so it can be viewed offline (i.e. in air-gapped environments).
+func Generate(filePath string) astra.ServiceFunction {
+	return func(s *astra.Service) error {
+		s.Log.Info().Msg("Generating HTML output")
+		output, err := openapi.Build(s, openapi.NewOptions())
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to build OpenAPI schema")
+			return err
+		}
+
+		if !strings.HasSuffix(filePath, ".html") {
+			s.Log.Debug().Str("filePath", filePath).Msg("Adding .html suffix to file path")
+			filePath += ".html"
+		}
+
+		var b strings.Builder
+		err = pageTemplate.Execute(&b, newPage(s.Routes, output, openapi.BuildGoTypes(s), s.Config.BasePath))
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to execute HTML template")
+			return err
+		}
+
+		s.Log.Debug().Str("filePath", filePath).Msg("Writing HTML output to file")
+		filePath = path.Join(s.WorkDir, filePath)
+		err = os.WriteFile(filePath, []byte(b.String()), 0644)
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to write HTML output to file")
+			return err
+		}
+
+		s.Log.Info().Msg("Generated HTML output")
+		return nil
+	}
+}
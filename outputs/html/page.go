@@ -0,0 +1,499 @@
+package html
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/outputs/openapi"
+)
+
+const (
+	// schemaRefPrefix is the prefix of the references to the components in the OpenAPI specification.
+	schemaRefPrefix = "#/components/schemas/"
+	// defaultTitle is the title of the page if the config has no title.
+	defaultTitle = "API Reference"
+	// untaggedGroup is the name of the group of the operations without tags in the sidebar.
+	untaggedGroup = "Other"
+)
+
+// idCharacters are the characters that are removed from the names of the operations and models to make the IDs of their sections.
+var idCharacters = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// methodOrder is the order of the operations of a path, by their methods.
+var methodOrder = map[string]int{
+	http.MethodGet:     0,
+	http.MethodPut:     1,
+	http.MethodPost:    2,
+	http.MethodDelete:  3,
+	http.MethodOptions: 4,
+	http.MethodHead:    5,
+	http.MethodPatch:   6,
+	http.MethodTrace:   7,
+}
+
+// page is the data of the template of the HTML page.
+type page struct {
+	// Title, Description and Version are the info of the API from the config.
+	Title       string
+	Description string
+	Version     string
+	// BaseURL is the URL of the first server, which the paths of the operations are relative to.
+	BaseURL string
+	// Tags are the first tags of the operations in the order of the specification, with the untagged operations in the last tag.
+	Tags []tag
+	// Models are the components, sorted by their names.
+	Models []model
+}
+
+// tag is a tag of the operations, with the operations whose first tag it is, sorted by their paths and methods.
+type tag struct {
+	Name        string
+	Description string
+	Operations  []operation
+}
+
+// operation is an operation of a route.
+type operation struct {
+	// ID is the ID of its section, which the sidebar links to.
+	ID          string
+	Title       string
+	Method      string
+	Path        string
+	Description string
+	Deprecated  bool
+	Parameters  []parameter
+	// RequestBody is the body of the request, which is nil if the operation has none.
+	RequestBody *body
+	// Responses are the responses of the operation, sorted by their status codes.
+	Responses []response
+	// Curl is an example curl command of the route, with examples of its required params and body.
+	Curl string
+}
+
+// parameter is a path, query, header or cookie parameter of an operation.
+type parameter struct {
+	Name        string
+	In          string
+	Type        template.HTML
+	Required    bool
+	Description string
+}
+
+// body is the body of a request or response, with the fields of its schema if it is an object and an example of it.
+type body struct {
+	ContentType string
+	Type        template.HTML
+	Description string
+	Fields      []field
+	Example     string
+}
+
+// response is a response of an operation, by its status code.
+type response struct {
+	StatusCode  string
+	Description string
+	// Body is the body of the response, which is nil if it has none.
+	Body *body
+}
+
+// model is a component, with its fields if it is an object and its values if it is an enum.
+type model struct {
+	Name        string
+	ID          string
+	Description string
+	Type        template.HTML
+	// Embeds are the types of the models it embeds, whose fields it also has.
+	Embeds  []template.HTML
+	Fields  []field
+	Values  []string
+	Example string
+}
+
+// field is a field of a model or body, with the fields of its type if it is an inline object.
+// Its description is the doc comment of the struct field, and it is required by its validation tags (i.e. binding:"required").
+type field struct {
+	Name        string
+	Type        template.HTML
+	Required    bool
+	Description string
+	Fields      []field
+}
+
+// routeOperation is a route with its operation in the OpenAPI specification and its path relative to the base URL.
+type routeOperation struct {
+	Route     astra.Route
+	Operation *openapi.Operation
+	Path      string
+}
+
+// pageWriter creates the page, with the IDs of the sections of its models to link their types to.
+type pageWriter struct {
+	baseURL    string
+	components map[string]openapi.Schema
+	goTypes    openapi.GoTypes
+	modelIDs   map[string]string
+	ids        map[string]bool
+}
+
+// newPage creates the data of the template of the HTML page from the routes, their OpenAPI specification and the Go types of its components.
+func newPage(routes []astra.Route, output openapi.OpenAPISchema, goTypes openapi.GoTypes, basePath string) page {
+	baseURL, basePath := openapi.ServerURL(output.Servers, basePath)
+
+	p := page{
+		Title:       output.Info.Title,
+		Description: output.Info.Description,
+		Version:     output.Info.Version,
+		BaseURL:     baseURL,
+	}
+	if p.Title == "" {
+		p.Title = defaultTitle
+	}
+
+	w := &pageWriter{
+		baseURL:    baseURL,
+		components: output.Components.Schemas,
+		goTypes:    goTypes,
+		modelIDs:   make(map[string]string, len(output.Components.Schemas)),
+		ids:        make(map[string]bool),
+	}
+
+	names := make([]string, 0, len(output.Components.Schemas))
+	for name := range output.Components.Schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		w.modelIDs[name] = w.id("model", name)
+	}
+
+	var operations []routeOperation
+	for _, route := range routes {
+		operation := openapi.RouteOperation(output, route)
+		if operation == nil {
+			continue
+		}
+		operations = append(operations, routeOperation{
+			Route:     route,
+			Operation: operation.Operation,
+			Path:      openapi.TrimBasePath(operation.Path, basePath),
+		})
+	}
+	sort.SliceStable(operations, func(i, j int) bool {
+		if operations[i].Path != operations[j].Path {
+			return operations[i].Path < operations[j].Path
+		}
+		return methodOrder[operations[i].Route.Method] < methodOrder[operations[j].Route.Method]
+	})
+
+	p.Tags = w.tags(output.Tags, operations)
+	for _, name := range names {
+		p.Models = append(p.Models, w.model(name, output.Components.Schemas[name]))
+	}
+
+	return p
+}
+
+// tags groups the operations by their first tags, in the order of the tags of the specification, with the untagged operations last.
+// Every operation is only in one tag, so its section is only on the page once.
+func (w *pageWriter) tags(specificationTags []openapi.Tag, operations []routeOperation) []tag {
+	var groups []tag
+	indexes := make(map[string]int)
+	addTag := func(name string, description string) int {
+		if i, ok := indexes[name]; ok {
+			return i
+		}
+		indexes[name] = len(groups)
+		groups = append(groups, tag{Name: name, Description: description})
+		return len(groups) - 1
+	}
+
+	for _, specificationTag := range specificationTags {
+		addTag(specificationTag.Name, specificationTag.Description)
+	}
+
+	var untagged []operation
+	for _, routeOperation := range operations {
+		o := w.operation(routeOperation)
+		if len(routeOperation.Operation.Tags) == 0 {
+			untagged = append(untagged, o)
+			continue
+		}
+		i := addTag(routeOperation.Operation.Tags[0], "")
+		groups[i].Operations = append(groups[i].Operations, o)
+	}
+	if len(untagged) > 0 {
+		groups = append(groups, tag{Name: untaggedGroup, Operations: untagged})
+	}
+
+	// Tags that no operation has aren't in the sidebar
+	filtered := groups[:0]
+	for _, group := range groups {
+		if len(group.Operations) > 0 {
+			filtered = append(filtered, group)
+		}
+	}
+
+	return filtered
+}
+
+// operation creates an operation, with its parameters, bodies and curl command, whose types link to the models.
+func (w *pageWriter) operation(routeOperation routeOperation) operation {
+	spec := routeOperation.Operation
+
+	title := spec.Summary
+	if title == "" {
+		title = spec.OperationID
+	}
+	if title == "" {
+		title = routeOperation.Route.Method + " " + routeOperation.Path
+	}
+
+	idName := spec.OperationID
+	if idName == "" {
+		idName = routeOperation.Route.Method + " " + routeOperation.Path
+	}
+
+	o := operation{
+		ID:          w.id("operation", idName),
+		Title:       title,
+		Method:      routeOperation.Route.Method,
+		Path:        routeOperation.Path,
+		Description: spec.Description,
+		Deprecated:  spec.Deprecated,
+		Curl:        w.curl(routeOperation),
+	}
+
+	for _, specParameter := range spec.Parameters {
+		o.Parameters = append(o.Parameters, parameter{
+			Name:        specParameter.Name,
+			In:          specParameter.In,
+			Type:        w.typeName(specParameter.Schema),
+			Required:    specParameter.Required,
+			Description: specParameter.Description,
+		})
+	}
+
+	if spec.RequestBody != nil {
+		if contentType, ok := bodyContentType(routeOperation.Route, spec.RequestBody.Content); ok {
+			o.RequestBody = w.body(contentType, spec.RequestBody.Content[contentType].Schema)
+			o.RequestBody.Description = spec.RequestBody.Description
+		}
+	}
+
+	statusCodes := make([]string, 0, len(spec.Responses))
+	for statusCode := range spec.Responses {
+		statusCodes = append(statusCodes, statusCode)
+	}
+	sort.Strings(statusCodes)
+	for _, statusCode := range statusCodes {
+		specResponse := spec.Responses[statusCode]
+		r := response{
+			StatusCode:  statusCode,
+			Description: specResponse.Description,
+		}
+		if contentType, ok := openapi.PreferredContentType(specResponse.Content); ok {
+			r.Body = w.body(contentType, specResponse.Content[contentType].Schema)
+		}
+		o.Responses = append(o.Responses, r)
+	}
+
+	return o
+}
+
+// body creates a body of a content type, with the fields of its schema, or of its items if it is an array, and an example of it.
+func (w *pageWriter) body(contentType string, schema openapi.Schema) *body {
+	fieldsSchema := schema
+	if fieldsSchema.Type == "array" && fieldsSchema.Items != nil {
+		fieldsSchema = *fieldsSchema.Items
+	}
+
+	return &body{
+		ContentType: contentType,
+		Type:        w.typeName(schema),
+		Fields:      w.fields(w.resolve(fieldsSchema)),
+		Example:     w.example(contentType, schema),
+	}
+}
+
+// model creates the model of a component.
+func (w *pageWriter) model(name string, schema openapi.Schema) model {
+	// The values of an enum and the models it embeds are listed on their own, rather than in its type
+	typeSchema := schema
+	typeSchema.Enum = nil
+	if len(schema.AllOf) > 0 {
+		typeSchema.AllOf = nil
+		typeSchema.Type = "object"
+	}
+
+	m := model{
+		Name:        name,
+		ID:          w.modelIDs[name],
+		Description: schema.Description,
+		Type:        w.typeName(typeSchema),
+		Fields:      w.fields(schema, w.goTypes[name].Fields),
+		Example:     w.example("application/json", openapi.Schema{Ref: schemaRefPrefix + name}),
+	}
+
+	for _, embedded := range schema.AllOf {
+		if embedded.Ref != "" {
+			m.Embeds = append(m.Embeds, w.typeName(embedded))
+		}
+	}
+
+	for _, value := range schema.Enum {
+		m.Values = append(m.Values, fmt.Sprint(value))
+	}
+
+	return m
+}
+
+// fields returns the fields of an object schema, including those of the schemas it embeds inline, sorted by their names.
+// The fields of inline objects have their own fields, so they can be expanded, whereas those of models link to them.
+// The struct fields of its properties describe them by their doc comments and validation tags.
+func (w *pageWriter) fields(schema openapi.Schema, structFields openapi.Fields) []field {
+	properties := make(map[string]openapi.Schema, len(schema.Properties))
+	for _, embedded := range schema.AllOf {
+		if embedded.Ref != "" {
+			continue
+		}
+		for name, property := range embedded.Properties {
+			properties[name] = property
+		}
+	}
+	for name, property := range schema.Properties {
+		properties[name] = property
+	}
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fields := make([]field, 0, len(names))
+	for _, name := range names {
+		property := properties[name]
+		description := structFields[name].Doc
+		if description == "" {
+			description = property.Description
+		}
+
+		f := field{
+			Name:        name,
+			Type:        w.typeName(property),
+			Required:    structFields[name].IsRequired,
+			Description: description,
+		}
+		if property.Ref == "" && len(property.Properties) > 0 {
+			f.Fields = w.fields(property, structFields[name].Fields)
+		}
+		fields = append(fields, f)
+	}
+
+	return fields
+}
+
+// resolve returns the schema of the component that the schema references, with the struct fields of its properties, if it is a reference.
+func (w *pageWriter) resolve(schema openapi.Schema) (openapi.Schema, openapi.Fields) {
+	name := strings.TrimPrefix(schema.Ref, schemaRefPrefix)
+	if component, ok := w.components[name]; ok && schema.Ref != "" {
+		return component, w.goTypes[name].Fields
+	}
+
+	return schema, nil
+}
+
+// typeName writes the type of a schema as HTML, with links to the models it references (i.e. array of <a href="#model-Pet">Pet</a>).
+// The format, values and nullability of the type are in parentheses after it (i.e. string (date-time, nullable)).
+func (w *pageWriter) typeName(schema openapi.Schema) template.HTML {
+	var name template.HTML
+	switch {
+	case schema.Ref != "":
+		componentName := strings.TrimPrefix(schema.Ref, schemaRefPrefix)
+		name = template.HTML(template.HTMLEscapeString(componentName))
+		if id, ok := w.modelIDs[componentName]; ok {
+			name = template.HTML(fmt.Sprintf(`<a href="#%s">%s</a>`, id, name))
+		}
+	case len(schema.AllOf) == 1:
+		name = w.typeName(schema.AllOf[0])
+	case len(schema.OneOf) > 0 || len(schema.AnyOf) > 0:
+		types := make([]string, 0, len(schema.OneOf)+len(schema.AnyOf))
+		for _, alternative := range append(schema.OneOf, schema.AnyOf...) {
+			types = append(types, string(w.typeName(alternative)))
+		}
+		name = template.HTML(strings.Join(types, " or "))
+	case schema.Type == "array" && schema.Items != nil:
+		name = "array of " + w.typeName(*schema.Items)
+	case schema.Type == "object" && schema.AdditionalProperties != nil:
+		name = "map of " + w.typeName(*schema.AdditionalProperties)
+	case schema.Type == "":
+		name = "any"
+	default:
+		name = template.HTML(template.HTMLEscapeString(schema.Type))
+	}
+
+	var qualifiers []string
+	if schema.Format != "" {
+		qualifiers = append(qualifiers, template.HTMLEscapeString(schema.Format))
+	}
+	if len(schema.Enum) > 0 {
+		values := make([]string, 0, len(schema.Enum))
+		for _, value := range schema.Enum {
+			values = append(values, "<code>"+template.HTMLEscapeString(fmt.Sprint(value))+"</code>")
+		}
+		qualifiers = append(qualifiers, "one of "+strings.Join(values, ", "))
+	}
+	if schema.Nullable {
+		qualifiers = append(qualifiers, "nullable")
+	}
+	if len(qualifiers) > 0 {
+		name += template.HTML(` <span class="qualifiers">(` + strings.Join(qualifiers, ", ") + ")</span>")
+	}
+
+	return name
+}
+
+// example writes an example of a body of a content type, synthesised from its schema.
+func (w *pageWriter) example(contentType string, schema openapi.Schema) string {
+	example := openapi.Example(schema, w.components)
+	if !strings.HasSuffix(contentType, "json") {
+		return openapi.ExampleString(example)
+	}
+
+	value, err := json.MarshalIndent(example, "", "  ")
+	if err != nil {
+		return ""
+	}
+
+	return string(value)
+}
+
+// id takes the ID of the section of an operation or model, which is suffixed by a number if another section has its ID (i.e. model-Pet-2).
+func (w *pageWriter) id(prefix string, name string) string {
+	id := prefix + "-" + strings.Trim(idCharacters.ReplaceAllString(name, "-"), "-")
+
+	unique := id
+	for i := 2; w.ids[unique]; i++ {
+		unique = fmt.Sprintf("%s-%d", id, i)
+	}
+	w.ids[unique] = true
+
+	return unique
+}
+
+// bodyContentType returns the content type of the body of a request, which is that of the body params of its route if it can be.
+func bodyContentType(route astra.Route, content map[string]openapi.MediaType) (string, bool) {
+	for _, bodyParam := range route.Body {
+		if _, ok := content[bodyParam.ContentType]; ok {
+			return bodyParam.ContentType, true
+		}
+	}
+
+	return openapi.PreferredContentType(content)
+}
@@ -0,0 +1,155 @@
+package html
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/outputs/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+var testRoutes = []astra.Route{
+	{Method: "GET", Path: "/api/pets/:id"},
+	{Method: "POST", Path: "/api/pets", Body: []astra.BodyParam{{ContentType: "application/json"}}},
+	{Method: "GET", Path: "/api/health"},
+	{Method: "GET", Path: "/api/hidden"},
+}
+
+var testOutput = openapi.OpenAPISchema{
+	Info:    openapi.Info{Title: "Petstore", Version: "1.0.0"},
+	Servers: []openapi.Server{{URL: "http://localhost:8000/api"}},
+	Tags:    []openapi.Tag{{Name: "pets", Description: "The pets of the store."}},
+	Paths: openapi.Paths{
+		"/api/pets": {
+			Post: &openapi.Operation{
+				Tags:        []string{"pets"},
+				Summary:     "Create pet",
+				OperationID: "createPet",
+				RequestBody: &openapi.RequestBody{
+					Content: map[string]openapi.MediaType{
+						"application/json": {Schema: openapi.Schema{Ref: "#/components/schemas/Pet"}},
+					},
+				},
+				Responses: openapi.Responses{
+					"201": {Description: "Created", Content: map[string]openapi.MediaType{
+						"application/json": {Schema: openapi.Schema{Ref: "#/components/schemas/Pet"}},
+					}},
+				},
+			},
+		},
+		"/api/pets/{id}": {
+			Get: &openapi.Operation{
+				Tags:        []string{"pets"},
+				Summary:     "Get pet",
+				OperationID: "getPet",
+				Description: "getPet gets a pet by its ID.",
+				Parameters: []openapi.Parameter{
+					{Name: "id", In: "path", Required: true, Schema: openapi.Schema{Type: "integer", Format: "int32"}},
+				},
+				Responses: openapi.Responses{
+					"404": {Description: "Not Found"},
+					"200": {Description: "OK", Content: map[string]openapi.MediaType{
+						"application/json": {Schema: openapi.Schema{Ref: "#/components/schemas/Pet"}},
+					}},
+				},
+			},
+		},
+		"/api/health": {
+			Get: &openapi.Operation{OperationID: "health", Deprecated: true},
+		},
+	},
+	Components: openapi.Components{
+		Schemas: map[string]openapi.Schema{
+			"Pet": {
+				Type:        "object",
+				Description: "Pet is a pet in the store.",
+				Properties: map[string]openapi.Schema{
+					"name":   {Type: "string"},
+					"status": {Ref: "#/components/schemas/Status"},
+					"owner": {Type: "object", Properties: map[string]openapi.Schema{
+						"email": {Type: "string", Format: "email"},
+					}},
+				},
+			},
+			"Status": {Type: "string", Enum: []any{"available", "sold"}},
+		},
+	},
+}
+
+var testGoTypes = openapi.GoTypes{
+	"Pet": {Name: "Pet", Package: "main", Fields: openapi.Fields{
+		"name": {Doc: "Name is the name of the pet.", IsRequired: true},
+	}},
+}
+
+func TestNewPage(t *testing.T) {
+	p := newPage(testRoutes, testOutput, testGoTypes, "/api")
+
+	t.Run("it has the info of the API", func(t *testing.T) {
+		require.Equal(t, "Petstore", p.Title)
+		require.Equal(t, "1.0.0", p.Version)
+		require.Equal(t, "http://localhost:8000/api", p.BaseURL)
+	})
+
+	t.Run("it groups the operations of the routes by their first tags", func(t *testing.T) {
+		require.Len(t, p.Tags, 2)
+		require.Equal(t, "pets", p.Tags[0].Name)
+		require.Equal(t, "The pets of the store.", p.Tags[0].Description)
+		require.Len(t, p.Tags[0].Operations, 2)
+		require.Equal(t, "operation-createPet", p.Tags[0].Operations[0].ID)
+		require.Equal(t, "operation-getPet", p.Tags[0].Operations[1].ID)
+		require.Equal(t, "Other", p.Tags[1].Name)
+		require.Equal(t, "health", p.Tags[1].Operations[0].Title)
+		require.True(t, p.Tags[1].Operations[0].Deprecated)
+	})
+
+	t.Run("it links the types of the operations to the models", func(t *testing.T) {
+		getPet := p.Tags[0].Operations[1]
+		require.Equal(t, "/pets/{id}", getPet.Path)
+		require.Equal(t, []parameter{{Name: "id", In: "path", Type: `integer <span class="qualifiers">(int32)</span>`, Required: true}}, getPet.Parameters)
+		require.Len(t, getPet.Responses, 2)
+		require.Equal(t, "200", getPet.Responses[0].StatusCode)
+		require.Equal(t, `<a href="#model-Pet">Pet</a>`, string(getPet.Responses[0].Body.Type))
+		require.Len(t, getPet.Responses[0].Body.Fields, 3)
+		require.Nil(t, getPet.Responses[1].Body)
+	})
+
+	t.Run("it lists the fields of the models with their doc comments", func(t *testing.T) {
+		require.Len(t, p.Models, 2)
+		pet := p.Models[0]
+		require.Equal(t, "model-Pet", pet.ID)
+		require.Equal(t, []field{
+			{Name: "name", Type: "string", Required: true, Description: "Name is the name of the pet."},
+			{Name: "owner", Type: "object", Fields: []field{
+				{Name: "email", Type: `string <span class="qualifiers">(email)</span>`},
+			}},
+			{Name: "status", Type: `<a href="#model-Status">Status</a>`},
+		}, pet.Fields)
+		require.Equal(t, "{\n  \"name\": \"string\",\n  \"owner\": {\n    \"email\": \"user@example.com\"\n  },\n  \"status\": \"available\"\n}", pet.Example)
+		require.Equal(t, []string{"available", "sold"}, p.Models[1].Values)
+	})
+}
+
+func TestPageTemplate(t *testing.T) {
+	var b strings.Builder
+	require.NoError(t, pageTemplate.Execute(&b, newPage(testRoutes, testOutput, testGoTypes, "/api")))
+	page := b.String()
+
+	t.Run("it links the sidebar to the sections", func(t *testing.T) {
+		require.Contains(t, page, `<li><a href="#operation-getPet"><span class="method get">GET</span>Get pet</a></li>`)
+		require.Contains(t, page, `<section id="operation-getPet">`)
+		require.Contains(t, page, `<li><a href="#model-Pet">Pet</a></li>`)
+		require.Contains(t, page, `<details class="model" id="model-Pet" open>`)
+	})
+
+	t.Run("it escapes the curl commands", func(t *testing.T) {
+		require.Contains(t, page, "<code>curl -X POST &#39;http://localhost:8000/api/pets&#39; \\\n  -H &#39;Content-Type: application/json&#39;")
+	})
+
+	t.Run("it doesn't load anything else", func(t *testing.T) {
+		require.NotContains(t, page, "<script src")
+		require.NotContains(t, page, "<link")
+		require.NotContains(t, page, "https://")
+	})
+}
@@ -0,0 +1,59 @@
+package httpfile
+
+import (
+	"os"
+	"path"
+	"sort"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/outputs/openapi"
+)
+
+// tempOutputDir is the temporary directory in the .astra directory that the files are written to.
+const tempOutputDir = "httpfile"
+
+// Generate generates the HTTP request files output.
+// It writes a .http file to the directory for every tag or package of the routes, which can be sent by the HTTP clients of JetBrains IDEs and VS Code (REST Client).
+// The requests of the routes use variables at the top of their files as placeholders for their base URL and params, and their bodies are examples synthesised from their schemas.
+func Generate(directoryPath string, options Options) astra.ServiceFunction {
+	return func(s *astra.Service) error {
+		s.Log.Info().Msg("Generating HTTP request files output")
+		output, err := openapi.Build(s, openapi.NewOptions())
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to build OpenAPI schema")
+			return err
+		}
+
+		files := requestFiles(s.Routes, s.Packages, output, s.Config.BasePath, options)
+
+		tempOutputDirectoryPath, err := s.SetupTempOutputDir(tempOutputDir)
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to create directory")
+			return err
+		}
+
+		fileNames := make([]string, 0, len(files))
+		for fileName := range files {
+			fileNames = append(fileNames, fileName)
+		}
+		sort.Strings(fileNames)
+
+		for _, fileName := range fileNames {
+			s.Log.Debug().Str("fileName", fileName).Msg("Writing HTTP request file")
+			err := os.WriteFile(path.Join(tempOutputDirectoryPath, fileName), []byte(files[fileName]), 0644)
+			if err != nil {
+				s.Log.Error().Err(err).Str("fileName", fileName).Msg("Failed to write HTTP request file")
+				return err
+			}
+		}
+
+		err = s.MoveTempOutputDir(tempOutputDir, directoryPath)
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to move temporary output directory to final location")
+			return err
+		}
+
+		s.Log.Info().Msg("Generated HTTP request files output")
+		return nil
+	}
+}
@@ -0,0 +1,43 @@
+package httpfile
+
+// GroupStrategy is how the requests are grouped into files.
+type GroupStrategy string
+
+const (
+	// GroupByTag writes a file for every tag of the routes, and a default.http file for the untagged routes.
+	GroupByTag GroupStrategy = "tag"
+	// GroupByPackage writes a file for every Go package of the handlers of the routes (i.e. pets.http).
+	GroupByPackage GroupStrategy = "package"
+)
+
+// Options are the options of the HTTP request files output.
+type Options struct {
+	// GroupStrategy is how the requests are grouped into files, which is GroupByTag by default.
+	GroupStrategy GroupStrategy
+}
+
+// Option is an option of the HTTP request files output.
+type Option func(*Options)
+
+// NewOptions creates the options of the HTTP request files output, with the defaults for those that aren't set.
+func NewOptions(options ...Option) Options {
+	o := Options{
+		GroupStrategy: GroupByTag,
+	}
+	for _, option := range options {
+		option(&o)
+	}
+
+	if o.GroupStrategy == "" {
+		o.GroupStrategy = GroupByTag
+	}
+
+	return o
+}
+
+// WithGroupStrategy is an option to pick how the requests are grouped into files (i.e. GroupByPackage).
+func WithGroupStrategy(groupStrategy GroupStrategy) Option {
+	return func(o *Options) {
+		o.GroupStrategy = groupStrategy
+	}
+}
@@ -0,0 +1,21 @@
+package httpfile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOptions(t *testing.T) {
+	t.Run("it groups the requests by their tags by default", func(t *testing.T) {
+		require.Equal(t, Options{GroupStrategy: GroupByTag}, NewOptions())
+	})
+
+	t.Run("it applies the options", func(t *testing.T) {
+		require.Equal(t, Options{GroupStrategy: GroupByPackage}, NewOptions(WithGroupStrategy(GroupByPackage)))
+	})
+
+	t.Run("it keeps the default for an empty group strategy", func(t *testing.T) {
+		require.Equal(t, Options{GroupStrategy: GroupByTag}, NewOptions(WithGroupStrategy("")))
+	})
+}
@@ -0,0 +1,317 @@
+package httpfile
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/outputs/openapi"
+)
+
+const (
+	// baseURLVariable is the variable of the files that the URLs of the requests start with.
+	baseURLVariable = "baseUrl"
+	// defaultGroup is the name of the file of the routes that aren't in a group (i.e. untagged routes).
+	defaultGroup = "default"
+	// multipartBoundary is the boundary between the parts of the multipart bodies of the requests.
+	multipartBoundary = "boundary"
+)
+
+// generatedHeader is the comment at the top of the generated files, so they aren't edited by hand.
+const generatedHeader = "# Code generated by astra. DO NOT EDIT.\n"
+
+var (
+	// invalidFileNameCharacters are the characters that can't be in the names of the files.
+	invalidFileNameCharacters = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+	// invalidVariableCharacters are the characters that can't be in the names of the variables (i.e. the hyphens of headers).
+	invalidVariableCharacters = regexp.MustCompile(`[^A-Za-z0-9_]+`)
+)
+
+// methodOrder is the order of the requests of a path, by their methods.
+var methodOrder = map[string]int{
+	http.MethodGet:     0,
+	http.MethodPut:     1,
+	http.MethodPost:    2,
+	http.MethodDelete:  3,
+	http.MethodOptions: 4,
+	http.MethodHead:    5,
+	http.MethodPatch:   6,
+	http.MethodTrace:   7,
+}
+
+// routeRequest is a route with its operation in the OpenAPI specification and its path relative to the base URL.
+type routeRequest struct {
+	Route     astra.Route
+	Operation *openapi.Operation
+	Path      string
+}
+
+// requestFiles writes the HTTP request files of the routes, by their file names.
+// The routes are grouped into files by their tags or packages, and sorted by their paths and methods in every file.
+func requestFiles(routes []astra.Route, packages map[string]astra.Package, output openapi.OpenAPISchema, basePath string, options Options) map[string]string {
+	baseURL, basePath := openapi.ServerURL(output.Servers, basePath)
+
+	groups := make(map[string][]routeRequest)
+	for _, route := range routes {
+		operation := openapi.RouteOperation(output, route)
+		if operation == nil {
+			continue
+		}
+
+		group := routeGroup(route, packages, options.GroupStrategy)
+		groups[group] = append(groups[group], routeRequest{
+			Route:     route,
+			Operation: operation.Operation,
+			Path:      openapi.TrimBasePath(operation.Path, basePath),
+		})
+	}
+
+	groupNames := make([]string, 0, len(groups))
+	for group := range groups {
+		groupNames = append(groupNames, group)
+	}
+	sort.Strings(groupNames)
+
+	files := make(map[string]string, len(groups))
+	fileNames := make(map[string]bool, len(groups))
+	for _, group := range groupNames {
+		requests := groups[group]
+		sort.SliceStable(requests, func(i, j int) bool {
+			if requests[i].Path != requests[j].Path {
+				return requests[i].Path < requests[j].Path
+			}
+			return methodOrder[requests[i].Route.Method] < methodOrder[requests[j].Route.Method]
+		})
+
+		w := newFileWriter(baseURL, output.Components.Schemas)
+		for _, request := range requests {
+			w.writeRequest(request)
+		}
+
+		files[uniqueFileName(fileNames, group)+".http"] = w.String()
+	}
+
+	return files
+}
+
+// routeGroup returns the name of the group of the route, which is its first tag or the name of its package.
+func routeGroup(route astra.Route, packages map[string]astra.Package, strategy GroupStrategy) string {
+	switch strategy {
+	case GroupByPackage:
+		if pkg, ok := packages[route.Package]; ok && pkg.Name != "" {
+			return pkg.Name
+		}
+		if route.Package != "" {
+			return path.Base(route.Package)
+		}
+	default:
+		if len(route.Tags) > 0 {
+			return route.Tags[0]
+		}
+	}
+
+	return defaultGroup
+}
+
+// uniqueFileName returns the name of the file of the group, which is unique among the names that are taken.
+func uniqueFileName(taken map[string]bool, group string) string {
+	name := strings.Trim(invalidFileNameCharacters.ReplaceAllString(group, "-"), "-")
+	if name == "" {
+		name = defaultGroup
+	}
+
+	unique := name
+	for i := 2; taken[strings.ToLower(unique)]; i++ {
+		unique = fmt.Sprintf("%s-%d", name, i)
+	}
+	taken[strings.ToLower(unique)] = true
+
+	return unique
+}
+
+// fileWriter writes an HTTP request file, with the variables of the placeholders of its requests at its top.
+type fileWriter struct {
+	components    map[string]openapi.Schema
+	variables     []string
+	variableValue map[string]string
+	requests      strings.Builder
+}
+
+// newFileWriter creates the writer of a file whose requests start with the base URL.
+func newFileWriter(baseURL string, components map[string]openapi.Schema) *fileWriter {
+	w := &fileWriter{
+		components:    components,
+		variableValue: make(map[string]string),
+	}
+	w.variable(baseURLVariable, baseURL)
+
+	return w
+}
+
+// variable adds a variable to the file, if it isn't already in it, and returns its placeholder (i.e. {{id}}).
+// The variables are shared by the requests of the file, so a variable has the example of the first param it is for.
+func (w *fileWriter) variable(name string, value string) string {
+	name = invalidVariableCharacters.ReplaceAllString(name, "_")
+	if _, ok := w.variableValue[name]; !ok {
+		w.variables = append(w.variables, name)
+		w.variableValue[name] = value
+	}
+
+	return "{{" + name + "}}"
+}
+
+// writeRequest writes the request of a route, named by its summary and operation ID, with the doc comment of the route as comments.
+// The path, query and header params are placeholders of the variables of the file, with their examples as the values of the variables.
+// The optional query params aren't sent, so they are listed in a comment instead.
+func (w *fileWriter) writeRequest(request routeRequest) {
+	operation := request.Operation
+
+	title := operation.Summary
+	if title == "" {
+		title = request.Route.Method + " " + request.Path
+	}
+	fmt.Fprintf(&w.requests, "\n### %s\n", title)
+	if doc := strings.TrimSpace(request.Route.Doc); doc != "" {
+		for _, line := range strings.Split(doc, "\n") {
+			w.requests.WriteString(strings.TrimRight("# "+line, " ") + "\n")
+		}
+	}
+	if operation.OperationID != "" {
+		fmt.Fprintf(&w.requests, "# @name %s\n", operation.OperationID)
+	}
+
+	requestPath := request.Path
+	var query, optionalQuery, headers []string
+	for _, parameter := range operation.Parameters {
+		placeholder := w.variable(parameter.Name, openapi.ExampleString(openapi.Example(parameter.Schema, w.components)))
+		switch parameter.In {
+		case "path":
+			requestPath = strings.NewReplacer("{"+parameter.Name+"}", placeholder, "{"+parameter.Name+"*}", placeholder).Replace(requestPath)
+		case "query":
+			if parameter.Required {
+				query = append(query, parameter.Name+"="+placeholder)
+			} else {
+				optionalQuery = append(optionalQuery, parameter.Name+"="+placeholder)
+			}
+		case "header":
+			headers = append(headers, parameter.Name+": "+placeholder)
+		}
+	}
+
+	if len(optionalQuery) > 0 {
+		fmt.Fprintf(&w.requests, "# Optional query params: %s\n", strings.Join(optionalQuery, "&"))
+	}
+
+	requestURL := "{{" + baseURLVariable + "}}" + requestPath
+	if len(query) > 0 {
+		requestURL += "?" + strings.Join(query, "&")
+	}
+	fmt.Fprintf(&w.requests, "%s %s\n", request.Route.Method, requestURL)
+
+	contentType, body, ok := w.body(request)
+	if ok {
+		headers = append(headers, "Content-Type: "+contentType)
+	}
+	for _, header := range headers {
+		w.requests.WriteString(header + "\n")
+	}
+	if ok {
+		w.requests.WriteString("\n" + body + "\n")
+	}
+}
+
+// body returns the content type and example of the body of a request, by the content type of the body params of its route.
+// JSON bodies are synthesised from their schemas, and forms have a field for every property of their schemas.
+func (w *fileWriter) body(request routeRequest) (string, string, bool) {
+	if request.Operation.RequestBody == nil || len(request.Operation.RequestBody.Content) == 0 {
+		return "", "", false
+	}
+	content := request.Operation.RequestBody.Content
+
+	contentType := ""
+	for _, bodyParam := range request.Route.Body {
+		if _, ok := content[bodyParam.ContentType]; ok {
+			contentType = bodyParam.ContentType
+			break
+		}
+	}
+	if contentType == "" {
+		// The body params of a form are in a multipart form instead if any of them is a file
+		contentTypes := make([]string, 0, len(content))
+		for contentType := range content {
+			contentTypes = append(contentTypes, contentType)
+		}
+		sort.Strings(contentTypes)
+		contentType = contentTypes[0]
+	}
+
+	schema := content[contentType].Schema
+	switch {
+	case contentType == "multipart/form-data":
+		return contentType + "; boundary=" + multipartBoundary, w.multipartBody(schema), true
+	case contentType == "application/x-www-form-urlencoded":
+		return contentType, w.urlEncodedBody(schema), true
+	case strings.HasSuffix(contentType, "json"):
+		example, err := json.MarshalIndent(openapi.Example(schema, w.components), "", "  ")
+		if err != nil {
+			return contentType, "", true
+		}
+		return contentType, string(example), true
+	default:
+		return contentType, openapi.ExampleString(openapi.Example(schema, w.components)), true
+	}
+}
+
+// urlEncodedBody writes the example of a URL-encoded form, with a field for every property of its schema.
+func (w *fileWriter) urlEncodedBody(schema openapi.Schema) string {
+	values := make(url.Values)
+	for name, property := range openapi.FormProperties(schema, w.components) {
+		values.Set(name, openapi.ExampleString(openapi.Example(property, w.components)))
+	}
+
+	// Encode sorts the fields by their names
+	return values.Encode()
+}
+
+// multipartBody writes the example of a multipart form, with a part for every property of its schema, which is a file if it is binary.
+func (w *fileWriter) multipartBody(schema openapi.Schema) string {
+	properties := openapi.FormProperties(schema, w.components)
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		property := properties[name]
+		fmt.Fprintf(&b, "--%s\n", multipartBoundary)
+		if openapi.IsBinary(property) {
+			fmt.Fprintf(&b, "Content-Disposition: form-data; name=%q; filename=%q\n\n< ./%s\n", name, name, name)
+			continue
+		}
+		fmt.Fprintf(&b, "Content-Disposition: form-data; name=%q\n\n%s\n", name, openapi.ExampleString(openapi.Example(property, w.components)))
+	}
+	fmt.Fprintf(&b, "--%s--", multipartBoundary)
+
+	return b.String()
+}
+
+// String writes the file, with the variables before the requests.
+func (w *fileWriter) String() string {
+	var b strings.Builder
+	b.WriteString(generatedHeader)
+	b.WriteString("\n")
+	for _, name := range w.variables {
+		fmt.Fprintf(&b, "@%s = %s\n", name, w.variableValue[name])
+	}
+	b.WriteString(w.requests.String())
+
+	return b.String()
+}
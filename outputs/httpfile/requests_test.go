@@ -0,0 +1,173 @@
+package httpfile
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/outputs/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestFiles(t *testing.T) {
+	routes := []astra.Route{
+		{
+			Method:  "POST",
+			Path:    "/api/pets",
+			Package: "example.com/petstore/pets",
+			Tags:    []string{"pets"},
+			Body:    []astra.BodyParam{{ContentType: "application/json"}},
+		},
+		{
+			Method:  "GET",
+			Path:    "/api/pets/:id",
+			Package: "example.com/petstore/pets",
+			Tags:    []string{"pets"},
+			Doc:     "getPet returns a pet.\nIt is 404 if it doesn't exist.",
+		},
+		{
+			Method:  "POST",
+			Path:    "/api/owners/:id/photo",
+			Package: "example.com/petstore/owners",
+			Body:    []astra.BodyParam{{Name: "photo", ContentType: "application/x-www-form-urlencoded"}, {Name: "caption", ContentType: "application/x-www-form-urlencoded"}},
+		},
+		{
+			Method: "GET",
+			Path:   "/api/hidden",
+		},
+	}
+
+	output := openapi.OpenAPISchema{
+		Servers: []openapi.Server{{URL: "http://localhost:8000/api"}},
+		Paths: openapi.Paths{
+			"/api/pets": {
+				Post: &openapi.Operation{
+					Summary:     "Create pet",
+					OperationID: "createPet",
+					RequestBody: &openapi.RequestBody{
+						Content: map[string]openapi.MediaType{
+							"application/json": {Schema: openapi.Schema{Ref: "#/components/schemas/Pet"}},
+						},
+					},
+				},
+			},
+			"/api/pets/{id}": {
+				Get: &openapi.Operation{
+					Summary:     "Get pet",
+					OperationID: "getPet",
+					Parameters: []openapi.Parameter{
+						{Name: "id", In: "path", Required: true, Schema: openapi.Schema{Type: "integer"}},
+						{Name: "verbose", In: "query", Required: true, Schema: openapi.Schema{Type: "boolean"}},
+						{Name: "fields", In: "query", Schema: openapi.Schema{Type: "string"}},
+						{Name: "X-Request-ID", In: "header", Schema: openapi.Schema{Type: "string", Format: "uuid"}},
+					},
+				},
+			},
+			"/api/owners/{id}/photo": {
+				Post: &openapi.Operation{
+					OperationID: "uploadPhoto",
+					Parameters: []openapi.Parameter{
+						{Name: "id", In: "path", Required: true, Schema: openapi.Schema{Type: "string"}},
+					},
+					RequestBody: &openapi.RequestBody{
+						Content: map[string]openapi.MediaType{
+							"multipart/form-data": {Schema: openapi.Schema{
+								Type: "object",
+								Properties: map[string]openapi.Schema{
+									"photo":   {Type: "string", Format: "binary"},
+									"caption": {Type: "string"},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+		Components: openapi.Components{
+			Schemas: map[string]openapi.Schema{
+				"Pet": {
+					Type: "object",
+					Properties: map[string]openapi.Schema{
+						"name":   {Type: "string"},
+						"status": {Type: "string", Enum: []any{"available", "sold"}},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("it writes a file for every tag", func(t *testing.T) {
+		files := requestFiles(routes, nil, output, "/api", NewOptions())
+
+		require.Len(t, files, 2)
+		require.Equal(t, `# Code generated by astra. DO NOT EDIT.
+
+@baseUrl = http://localhost:8000/api
+@id = 0
+@verbose = false
+@fields = string
+@X_Request_ID = 00000000-0000-0000-0000-000000000000
+
+### Create pet
+# @name createPet
+POST {{baseUrl}}/pets
+Content-Type: application/json
+
+{
+  "name": "string",
+  "status": "available"
+}
+
+### Get pet
+# getPet returns a pet.
+# It is 404 if it doesn't exist.
+# @name getPet
+# Optional query params: fields={{fields}}
+GET {{baseUrl}}/pets/{{id}}?verbose={{verbose}}
+X-Request-ID: {{X_Request_ID}}
+`, files["pets.http"])
+	})
+
+	t.Run("it writes the files of multipart forms", func(t *testing.T) {
+		files := requestFiles(routes, nil, output, "/api", NewOptions())
+
+		require.Equal(t, `# Code generated by astra. DO NOT EDIT.
+
+@baseUrl = http://localhost:8000/api
+@id = string
+
+### POST /owners/{id}/photo
+# @name uploadPhoto
+POST {{baseUrl}}/owners/{{id}}/photo
+Content-Type: multipart/form-data; boundary=boundary
+
+--boundary
+Content-Disposition: form-data; name="caption"
+
+string
+--boundary
+Content-Disposition: form-data; name="photo"; filename="photo"
+
+< ./photo
+--boundary--
+`, files["default.http"])
+	})
+
+	t.Run("it writes a file for every package", func(t *testing.T) {
+		files := requestFiles(routes, map[string]astra.Package{
+			"example.com/petstore/pets": {Name: "pets"},
+		}, output, "/api", NewOptions(WithGroupStrategy(GroupByPackage)))
+
+		require.Len(t, files, 2)
+		require.Contains(t, files, "pets.http")
+		require.Contains(t, files, "owners.http")
+	})
+}
+
+func TestUniqueFileName(t *testing.T) {
+	taken := make(map[string]bool)
+
+	require.Equal(t, "pets", uniqueFileName(taken, "pets"))
+	require.Equal(t, "Pets-2", uniqueFileName(taken, "Pets"))
+	require.Equal(t, "pet-store", uniqueFileName(taken, "pet store"))
+	require.Equal(t, "default", uniqueFileName(taken, "/"))
+}
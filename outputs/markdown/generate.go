@@ -0,0 +1,89 @@
+package markdown
+
+import (
+	_ "embed"
+	"os"
+	"path"
+	"strings"
+	"text/template"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/outputs/openapi"
+)
+
+// DefaultTemplate is the text/template that the reference is written with, unless it is overridden by WithTemplateFile.
+// It can be copied as the start of a template that is overridden.
+//
+//go:embed reference.md.tmpl
+var DefaultTemplate string
+
+// Funcs are the functions that the templates of the reference can use, in addition to those of text/template.
+var Funcs = template.FuncMap{
+	// cell escapes text for a cell of a table, whose pipes would end the cell and whose lines would end the row
+	"cell": func(text string) string {
+		return oneLine(strings.ReplaceAll(text, "|", `\|`))
+	},
+	// oneLine joins the lines of text (i.e. a doc comment) with spaces
+	"oneLine": oneLine,
+	// join joins the elements with a separator (i.e. the models a model embeds)
+	"join": strings.Join,
+}
+
+// Generate generates the Markdown reference output.
+// It writes a Markdown file with a table of contents by tag, a section for every operation with its parameters, request body and responses, and a section for every model with its fields.
+// The file is written with DefaultTemplate, which can be overridden by a text/template file (i.e. WithTemplateFile("docs/reference.md.tmpl")) that is executed with a Reference.
+func Generate(filePath string, options Options) astra.ServiceFunction {
+	return func(s *astra.Service) error {
+		s.Log.Info().Msg("Generating Markdown reference output")
+		output, err := openapi.Build(s, openapi.NewOptions())
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to build OpenAPI schema")
+			return err
+		}
+
+		text := DefaultTemplate
+		if options.TemplateFile != "" {
+			s.Log.Debug().Str("templateFile", options.TemplateFile).Msg("Reading Markdown reference template")
+			templateFile, err := os.ReadFile(path.Join(s.WorkDir, options.TemplateFile))
+			if err != nil {
+				s.Log.Error().Err(err).Str("templateFile", options.TemplateFile).Msg("Failed to read Markdown reference template")
+				return err
+			}
+			text = string(templateFile)
+		}
+
+		tmpl, err := template.New("reference").Funcs(Funcs).Parse(text)
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to parse Markdown reference template")
+			return err
+		}
+
+		if !strings.HasSuffix(filePath, ".md") {
+			s.Log.Debug().Str("filePath", filePath).Msg("Adding .md suffix to file path")
+			filePath += ".md"
+		}
+
+		var b strings.Builder
+		err = tmpl.Execute(&b, newReference(output, openapi.BuildGoTypes(s), s.Config.BasePath))
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to execute Markdown reference template")
+			return err
+		}
+
+		s.Log.Debug().Str("filePath", filePath).Msg("Writing Markdown reference output to file")
+		filePath = path.Join(s.WorkDir, filePath)
+		err = os.WriteFile(filePath, []byte(b.String()), 0644)
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to write Markdown reference output to file")
+			return err
+		}
+
+		s.Log.Info().Msg("Generated Markdown reference output")
+		return nil
+	}
+}
+
+// oneLine joins the lines of text with spaces.
+func oneLine(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,28 @@
+package markdown
+
+// Options are the options of the Markdown reference output.
+type Options struct {
+	// TemplateFile is the path of a text/template file that the reference is written with instead of DefaultTemplate, relative to the working directory.
+	TemplateFile string
+}
+
+// Option is an option of the Markdown reference output.
+type Option func(*Options)
+
+// NewOptions creates the options of the Markdown reference output.
+func NewOptions(options ...Option) Options {
+	var o Options
+	for _, option := range options {
+		option(&o)
+	}
+
+	return o
+}
+
+// WithTemplateFile is an option to write the reference with a text/template file instead of DefaultTemplate (i.e. docs/reference.md.tmpl).
+// The template is executed with a Reference, and can use the functions of Funcs.
+func WithTemplateFile(templateFile string) Option {
+	return func(o *Options) {
+		o.TemplateFile = templateFile
+	}
+}
@@ -0,0 +1,17 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOptions(t *testing.T) {
+	t.Run("it writes the reference with the default template by default", func(t *testing.T) {
+		require.Equal(t, Options{}, NewOptions())
+	})
+
+	t.Run("it applies the options", func(t *testing.T) {
+		require.Equal(t, Options{TemplateFile: "docs/reference.md.tmpl"}, NewOptions(WithTemplateFile("docs/reference.md.tmpl")))
+	})
+}
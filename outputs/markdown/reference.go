@@ -0,0 +1,438 @@
+package markdown
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ls6-events/astra/outputs/openapi"
+)
+
+const (
+	// schemaRefPrefix is the prefix of the references to the components in the OpenAPI specification.
+	schemaRefPrefix = "#/components/schemas/"
+	// defaultTitle is the title of the reference if the config has no title.
+	defaultTitle = "API Reference"
+	// untaggedGroup is the name of the group of the operations without tags in the table of contents.
+	untaggedGroup = "Other"
+)
+
+// headings are the headings of the default template that aren't operations or models, so their anchors are taken before them.
+var headings = []string{"Contents", "Operations", "Models"}
+
+// anchorCharacters are the characters that are removed from headings to make their anchors, as GitHub and most wikis do.
+var anchorCharacters = regexp.MustCompile(`[^\p{L}\p{N}\s_-]+`)
+
+// Reference is the data of the template of the Markdown reference.
+type Reference struct {
+	// Title, Description and Version are the info of the API from the config.
+	Title       string
+	Description string
+	Version     string
+	// BaseURL is the URL of the first server, which the paths of the operations are relative to.
+	BaseURL string
+	// Tags are the tags of the operations in the order of the specification, for the table of contents.
+	// The operations without tags are in the last tag, named Other.
+	Tags []Tag
+	// Operations are all the operations, sorted by their paths and methods.
+	Operations []Operation
+	// Models are the components, sorted by their names.
+	Models []Model
+}
+
+// Tag is a tag of the operations, with the operations that have it.
+type Tag struct {
+	Name        string
+	Description string
+	Operations  []Operation
+}
+
+// Operation is an operation of a route.
+type Operation struct {
+	// Title is the summary of the operation, or its operation ID if it has none, and Anchor is the anchor of its heading.
+	Title       string
+	Anchor      string
+	OperationID string
+	Method      string
+	Path        string
+	Description string
+	Deprecated  bool
+	Tags        []string
+	Parameters  []Parameter
+	// RequestBody is the body of the request, which is nil if the operation has none.
+	RequestBody *Body
+	// Responses are the responses of the operation, sorted by their status codes.
+	Responses []Response
+}
+
+// Parameter is a path, query, header or cookie parameter of an operation.
+type Parameter struct {
+	Name        string
+	In          string
+	Type        string
+	Required    bool
+	Description string
+}
+
+// Body is the body of a request, with the fields of its schema if it is an object.
+type Body struct {
+	ContentType string
+	Type        string
+	Description string
+	Fields      []Field
+}
+
+// Response is a response of an operation, by its status code.
+type Response struct {
+	StatusCode  string
+	Description string
+	ContentType string
+	Type        string
+}
+
+// Model is a component, with its fields if it is an object and its values if it is an enum.
+type Model struct {
+	Name        string
+	Anchor      string
+	Description string
+	Type        string
+	// Embeds are the types of the models it embeds, whose fields it also has.
+	Embeds []string
+	Fields []Field
+	Values []string
+}
+
+// Field is a field of a model or body.
+// Its description is the doc comment of the struct field, and it is required by its validation tags (i.e. binding:"required").
+type Field struct {
+	Name        string
+	Type        string
+	Required    bool
+	Description string
+}
+
+// referenceWriter creates the reference, with the anchors of the headings of its models to link their types to.
+type referenceWriter struct {
+	components   map[string]openapi.Schema
+	goTypes      openapi.GoTypes
+	modelAnchors map[string]string
+}
+
+// newReference creates the data of the template of the Markdown reference from the OpenAPI specification and the Go types of its components.
+func newReference(output openapi.OpenAPISchema, goTypes openapi.GoTypes, basePath string) Reference {
+	title := output.Info.Title
+	if title == "" {
+		title = defaultTitle
+	}
+
+	anchors := newAnchors(append([]string{title}, headings...)...)
+	w := &referenceWriter{
+		components:   output.Components.Schemas,
+		goTypes:      goTypes,
+		modelAnchors: make(map[string]string, len(output.Components.Schemas)),
+	}
+
+	baseURL, basePath := openapi.ServerURL(output.Servers, basePath)
+	reference := Reference{
+		Title:       title,
+		Description: output.Info.Description,
+		Version:     output.Info.Version,
+		BaseURL:     baseURL,
+	}
+
+	// The anchors are taken in the order of the headings of the default template, so they are the same as those of its headings
+	operations := openapi.PathOperations(output)
+	operationAnchors := make([]string, len(operations))
+	for i, operation := range operations {
+		operationAnchors[i] = anchors.anchor(operationTitle(operation, openapi.TrimBasePath(operation.Path, basePath)))
+	}
+
+	names := make([]string, 0, len(output.Components.Schemas))
+	for name := range output.Components.Schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		w.modelAnchors[name] = anchors.anchor(name)
+	}
+
+	for i, operation := range operations {
+		reference.Operations = append(reference.Operations, w.operation(operation, openapi.TrimBasePath(operation.Path, basePath), operationAnchors[i]))
+	}
+	for _, name := range names {
+		reference.Models = append(reference.Models, w.model(name, output.Components.Schemas[name]))
+	}
+
+	reference.Tags = tags(output.Tags, reference.Operations)
+
+	return reference
+}
+
+// tags groups the operations by their tags, in the order of the tags of the specification, with the untagged operations last.
+func tags(specificationTags []openapi.Tag, operations []Operation) []Tag {
+	var groups []Tag
+	indexes := make(map[string]int)
+	addTag := func(name string, description string) int {
+		if i, ok := indexes[name]; ok {
+			return i
+		}
+		indexes[name] = len(groups)
+		groups = append(groups, Tag{Name: name, Description: description})
+		return len(groups) - 1
+	}
+
+	for _, tag := range specificationTags {
+		addTag(tag.Name, tag.Description)
+	}
+
+	var untagged []Operation
+	for _, operation := range operations {
+		if len(operation.Tags) == 0 {
+			untagged = append(untagged, operation)
+			continue
+		}
+		for _, tag := range operation.Tags {
+			i := addTag(tag, "")
+			groups[i].Operations = append(groups[i].Operations, operation)
+		}
+	}
+	if len(untagged) > 0 {
+		groups = append(groups, Tag{Name: untaggedGroup, Operations: untagged})
+	}
+
+	// Tags that no operation has aren't in the table of contents
+	filtered := groups[:0]
+	for _, group := range groups {
+		if len(group.Operations) > 0 {
+			filtered = append(filtered, group)
+		}
+	}
+
+	return filtered
+}
+
+// operationTitle returns the title of an operation, which is its summary or operation ID.
+func operationTitle(operation openapi.PathOperation, path string) string {
+	if operation.Summary != "" {
+		return operation.Summary
+	}
+	if operation.OperationID != "" {
+		return operation.OperationID
+	}
+
+	return operation.Method + " " + path
+}
+
+// operation creates an operation, with its parameters, body and responses, whose types link to the models.
+func (w *referenceWriter) operation(operation openapi.PathOperation, path string, anchor string) Operation {
+	o := Operation{
+		Title:       operationTitle(operation, path),
+		Anchor:      anchor,
+		OperationID: operation.OperationID,
+		Method:      operation.Method,
+		Path:        path,
+		Description: operation.Description,
+		Deprecated:  operation.Deprecated,
+		Tags:        operation.Tags,
+	}
+
+	for _, parameter := range operation.Parameters {
+		o.Parameters = append(o.Parameters, Parameter{
+			Name:        parameter.Name,
+			In:          parameter.In,
+			Type:        w.typeName(parameter.Schema),
+			Required:    parameter.Required,
+			Description: parameter.Description,
+		})
+	}
+
+	if operation.RequestBody != nil {
+		if contentType, ok := openapi.PreferredContentType(operation.RequestBody.Content); ok {
+			schema := operation.RequestBody.Content[contentType].Schema
+			o.RequestBody = &Body{
+				ContentType: contentType,
+				Type:        w.typeName(schema),
+				Description: operation.RequestBody.Description,
+				Fields:      w.fields(w.resolve(schema)),
+			}
+		}
+	}
+
+	statusCodes := make([]string, 0, len(operation.Responses))
+	for statusCode := range operation.Responses {
+		statusCodes = append(statusCodes, statusCode)
+	}
+	sort.Strings(statusCodes)
+	for _, statusCode := range statusCodes {
+		response := operation.Responses[statusCode]
+		r := Response{
+			StatusCode:  statusCode,
+			Description: response.Description,
+		}
+		if contentType, ok := openapi.PreferredContentType(response.Content); ok {
+			r.ContentType = contentType
+			r.Type = w.typeName(response.Content[contentType].Schema)
+		}
+		o.Responses = append(o.Responses, r)
+	}
+
+	return o
+}
+
+// model creates the model of a component.
+func (w *referenceWriter) model(name string, schema openapi.Schema) Model {
+	// The values of an enum and the models it embeds are listed on their own, rather than in its type
+	typeSchema := schema
+	typeSchema.Enum = nil
+	if len(schema.AllOf) > 0 {
+		typeSchema.AllOf = nil
+		typeSchema.Type = "object"
+	}
+
+	m := Model{
+		Name:        name,
+		Anchor:      w.modelAnchors[name],
+		Description: schema.Description,
+		Type:        w.typeName(typeSchema),
+		Fields:      w.fields(schema, w.goTypes[name].Fields),
+	}
+
+	for _, embedded := range schema.AllOf {
+		if embedded.Ref != "" {
+			m.Embeds = append(m.Embeds, w.typeName(embedded))
+		}
+	}
+
+	for _, value := range schema.Enum {
+		m.Values = append(m.Values, fmt.Sprint(value))
+	}
+
+	return m
+}
+
+// fields returns the fields of an object schema, including those of the schemas it embeds inline, sorted by their names.
+// The struct fields of its properties describe them by their doc comments and validation tags.
+func (w *referenceWriter) fields(schema openapi.Schema, structFields openapi.Fields) []Field {
+	properties := make(map[string]openapi.Schema, len(schema.Properties))
+	for _, embedded := range schema.AllOf {
+		if embedded.Ref != "" {
+			continue
+		}
+		for name, property := range embedded.Properties {
+			properties[name] = property
+		}
+	}
+	for name, property := range schema.Properties {
+		properties[name] = property
+	}
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fields := make([]Field, 0, len(names))
+	for _, name := range names {
+		property := properties[name]
+		description := structFields[name].Doc
+		if description == "" {
+			description = property.Description
+		}
+
+		fields = append(fields, Field{
+			Name:        name,
+			Type:        w.typeName(property),
+			Required:    structFields[name].IsRequired,
+			Description: description,
+		})
+	}
+
+	return fields
+}
+
+// resolve returns the schema of the component that the schema references, with the struct fields of its properties, if it is a reference.
+func (w *referenceWriter) resolve(schema openapi.Schema) (openapi.Schema, openapi.Fields) {
+	name := strings.TrimPrefix(schema.Ref, schemaRefPrefix)
+	if component, ok := w.components[name]; ok && schema.Ref != "" {
+		return component, w.goTypes[name].Fields
+	}
+
+	return schema, nil
+}
+
+// typeName writes the type of a schema, with links to the models it references (i.e. array of [Pet](#pet)).
+// The format, values and nullability of the type are in parentheses after it (i.e. string (date-time, nullable)).
+func (w *referenceWriter) typeName(schema openapi.Schema) string {
+	var name string
+	switch {
+	case schema.Ref != "":
+		componentName := strings.TrimPrefix(schema.Ref, schemaRefPrefix)
+		name = componentName
+		if anchor, ok := w.modelAnchors[componentName]; ok {
+			name = fmt.Sprintf("[%s](#%s)", componentName, anchor)
+		}
+	case len(schema.AllOf) == 1:
+		name = w.typeName(schema.AllOf[0])
+	case len(schema.OneOf) > 0 || len(schema.AnyOf) > 0:
+		types := make([]string, 0, len(schema.OneOf)+len(schema.AnyOf))
+		for _, alternative := range append(schema.OneOf, schema.AnyOf...) {
+			types = append(types, w.typeName(alternative))
+		}
+		name = strings.Join(types, " or ")
+	case schema.Type == "array" && schema.Items != nil:
+		name = "array of " + w.typeName(*schema.Items)
+	case schema.Type == "object" && schema.AdditionalProperties != nil:
+		name = "map of " + w.typeName(*schema.AdditionalProperties)
+	case schema.Type == "":
+		name = "any"
+	default:
+		name = schema.Type
+	}
+
+	var qualifiers []string
+	if schema.Format != "" {
+		qualifiers = append(qualifiers, schema.Format)
+	}
+	if len(schema.Enum) > 0 {
+		values := make([]string, 0, len(schema.Enum))
+		for _, value := range schema.Enum {
+			values = append(values, fmt.Sprintf("`%v`", value))
+		}
+		qualifiers = append(qualifiers, "one of "+strings.Join(values, ", "))
+	}
+	if schema.Nullable {
+		qualifiers = append(qualifiers, "nullable")
+	}
+	if len(qualifiers) > 0 {
+		name += " (" + strings.Join(qualifiers, ", ") + ")"
+	}
+
+	return name
+}
+
+// anchors takes the anchors of the headings of the reference, which are unique in the order that they are taken.
+type anchors map[string]int
+
+// newAnchors creates the anchors, with those of the headings that are taken.
+func newAnchors(headings ...string) *anchors {
+	a := make(anchors)
+	for _, heading := range headings {
+		a.anchor(heading)
+	}
+
+	return &a
+}
+
+// anchor takes the anchor of a heading, which is suffixed by a number if another heading has its anchor (i.e. pet-1).
+func (a anchors) anchor(heading string) string {
+	anchor := strings.ReplaceAll(anchorCharacters.ReplaceAllString(strings.ToLower(strings.TrimSpace(heading)), ""), " ", "-")
+	count, ok := a[anchor]
+	a[anchor] = count + 1
+	if !ok {
+		return anchor
+	}
+
+	return fmt.Sprintf("%s-%d", anchor, count)
+}
@@ -0,0 +1,144 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/ls6-events/astra/outputs/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+var testOutput = openapi.OpenAPISchema{
+	Info:    openapi.Info{Title: "Petstore", Version: "1.0.0"},
+	Servers: []openapi.Server{{URL: "http://localhost:8000/api"}},
+	Tags:    []openapi.Tag{{Name: "pets", Description: "The pets of the store."}},
+	Paths: openapi.Paths{
+		"/api/pets/{id}": {
+			Get: &openapi.Operation{
+				Tags:        []string{"pets"},
+				Summary:     "Get pet",
+				OperationID: "getPet",
+				Description: "getPet gets a pet by its ID.",
+				Parameters: []openapi.Parameter{
+					{Name: "id", In: "path", Required: true, Schema: openapi.Schema{Type: "integer", Format: "int32"}},
+				},
+				Responses: openapi.Responses{
+					"404": {Description: "Not Found"},
+					"200": {Description: "OK", Content: map[string]openapi.MediaType{
+						"application/json": {Schema: openapi.Schema{Ref: "#/components/schemas/Pet"}},
+					}},
+				},
+			},
+		},
+		"/api/health": {
+			Get: &openapi.Operation{OperationID: "health", Deprecated: true},
+		},
+	},
+	Components: openapi.Components{
+		Schemas: map[string]openapi.Schema{
+			"Pet": {
+				Type:        "object",
+				Description: "Pet is a pet in the store.",
+				Properties: map[string]openapi.Schema{
+					"name":   {Type: "string"},
+					"status": {Ref: "#/components/schemas/Status"},
+					"tags":   {Type: "array", Items: &openapi.Schema{Type: "string"}, Nullable: true},
+				},
+			},
+			"Status": {Type: "string", Enum: []any{"available", "sold"}},
+		},
+	},
+}
+
+var testGoTypes = openapi.GoTypes{
+	"Pet": {Name: "Pet", Package: "main", Fields: openapi.Fields{
+		"name": {Doc: "Name is the name of the pet.", IsRequired: true},
+	}},
+}
+
+func TestNewReference(t *testing.T) {
+	reference := newReference(testOutput, testGoTypes, "/api")
+
+	t.Run("it has the info of the API", func(t *testing.T) {
+		require.Equal(t, "Petstore", reference.Title)
+		require.Equal(t, "1.0.0", reference.Version)
+		require.Equal(t, "http://localhost:8000/api", reference.BaseURL)
+	})
+
+	t.Run("it groups the operations by their tags", func(t *testing.T) {
+		require.Len(t, reference.Tags, 2)
+		require.Equal(t, "pets", reference.Tags[0].Name)
+		require.Equal(t, "The pets of the store.", reference.Tags[0].Description)
+		require.Equal(t, "Get pet", reference.Tags[0].Operations[0].Title)
+		require.Equal(t, "Other", reference.Tags[1].Name)
+		require.Equal(t, "health", reference.Tags[1].Operations[0].Title)
+	})
+
+	t.Run("it links the types of the operations to the models", func(t *testing.T) {
+		getPet := reference.Operations[1]
+		require.Equal(t, "get-pet", getPet.Anchor)
+		require.Equal(t, "/pets/{id}", getPet.Path)
+		require.Equal(t, []Parameter{{Name: "id", In: "path", Type: "integer (int32)", Required: true}}, getPet.Parameters)
+		require.Equal(t, []Response{
+			{StatusCode: "200", Description: "OK", ContentType: "application/json", Type: "[Pet](#pet)"},
+			{StatusCode: "404", Description: "Not Found"},
+		}, getPet.Responses)
+	})
+
+	t.Run("it lists the fields of the models with their doc comments", func(t *testing.T) {
+		require.Equal(t, Model{
+			Name:        "Pet",
+			Anchor:      "pet",
+			Description: "Pet is a pet in the store.",
+			Type:        "object",
+			Fields: []Field{
+				{Name: "name", Type: "string", Required: true, Description: "Name is the name of the pet."},
+				{Name: "status", Type: "[Status](#status)"},
+				{Name: "tags", Type: "array of string (nullable)"},
+			},
+		}, reference.Models[0])
+		require.Equal(t, Model{
+			Name:   "Status",
+			Anchor: "status",
+			Type:   "string",
+			Fields: []Field{},
+			Values: []string{"available", "sold"},
+		}, reference.Models[1])
+	})
+}
+
+func TestAnchors(t *testing.T) {
+	anchors := newAnchors("Contents")
+
+	require.Equal(t, "contents-1", anchors.anchor("Contents"))
+	require.Equal(t, "get-a-pet", anchors.anchor("Get a pet!"))
+	require.Equal(t, "get-a-pet-1", anchors.anchor("Get a pet"))
+	require.Equal(t, "petstorepet", anchors.anchor("petstore.Pet"))
+}
+
+func TestDefaultTemplate(t *testing.T) {
+	tmpl, err := template.New("reference").Funcs(Funcs).Parse(DefaultTemplate)
+	require.NoError(t, err)
+
+	var b strings.Builder
+	require.NoError(t, tmpl.Execute(&b, newReference(testOutput, testGoTypes, "/api")))
+
+	require.Equal(t, "# Petstore\n\nVersion 1.0.0\n\nBase URL: `http://localhost:8000/api`\n\n"+
+		"## Contents\n\n- **pets**: The pets of the store.\n  - [Get pet](#get-pet)\n- **Other**\n  - [health](#health)\n- [Models](#models)\n\n"+
+		"## Operations\n\n"+
+		"### health\n\n`GET /health`\n\n**Deprecated**\n\n"+
+		"### Get pet\n\n`GET /pets/{id}`\n\ngetPet gets a pet by its ID.\n\n"+
+		"**Parameters**\n\n| Name | In | Type | Required | Description |\n| --- | --- | --- | --- | --- |\n| `id` | path | integer (int32) | Yes |  |\n\n"+
+		"**Responses**\n\n| Status | Description | Content type | Type |\n| --- | --- | --- | --- |\n| 200 | OK | `application/json` | [Pet](#pet) |\n| 404 | Not Found |  |  |\n\n"+
+		"## Models\n\n"+
+		"### Pet\n\nPet is a pet in the store.\n\nType: object\n\n"+
+		"| Field | Type | Required | Description |\n| --- | --- | --- | --- |\n| `name` | string | Yes | Name is the name of the pet. |\n| `status` | [Status](#status) | No |  |\n| `tags` | array of string (nullable) | No |  |\n\n"+
+		"### Status\n\nType: string\n\nValues: `available`, `sold`\n", b.String())
+}
+
+func TestFuncs(t *testing.T) {
+	cell := Funcs["cell"].(func(string) string)
+
+	require.Equal(t, `a \| b c`, cell("a | b\nc"))
+}
@@ -193,6 +193,7 @@ func componentToSchema(service *astra.Service, component astra.Field, bindingTyp
 
 				fieldSchema, fieldBound := componentToSchema(service, field, bindingType)
 				if fieldBound {
+					fieldSchema = applyFieldSchemaProperties(fieldSchema, field)
 					schema.Properties[fieldBinding.Name] = fieldSchema
 				}
 			}
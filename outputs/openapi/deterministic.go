@@ -0,0 +1,74 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// yamlIndent is the indentation of the YAML files, which is the canonical two spaces.
+const yamlIndent = 2
+
+// marshalYAML marshals the OpenAPI specification (or part of it) to YAML in the canonical style, with two space indentation.
+func marshalYAML(v any) ([]byte, error) {
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(yamlIndent)
+
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// marshalJSON marshals the OpenAPI specification to indented JSON, ending with a new line like the YAML files.
+func marshalJSON(v any) ([]byte, error) {
+	file, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(file, '\n'), nil
+}
+
+// sortOutput sorts the lists of the OpenAPI specification that would otherwise be in the order they were found in, so it is the same every time it is generated.
+// The maps (i.e. paths, properties and responses) are sorted by their keys when they are marshalled.
+// The enums and tags keep the order they are declared in, as it is meaningful and doesn't change between runs.
+func sortOutput(output *OpenAPISchema) {
+	for _, paths := range []Paths{output.Paths, output.Webhooks} {
+		for _, pathItem := range paths {
+			sortParameters(pathItem.Parameters)
+		}
+	}
+
+	for _, operation := range sortedOperations(output) {
+		sortParameters(operation.Parameters)
+		for _, security := range operation.Security {
+			for _, scopes := range security {
+				sort.Strings(scopes)
+			}
+		}
+	}
+
+	mapSchemas(output, func(schema Schema) Schema {
+		sort.Strings(schema.Required)
+		return schema
+	})
+}
+
+// sortParameters sorts parameters by their names, and then by where they are (i.e. an id in the path before an id in the query).
+func sortParameters(parameters []Parameter) {
+	sort.SliceStable(parameters, func(i, j int) bool {
+		if parameters[i].Name != parameters[j].Name {
+			return parameters[i].Name < parameters[j].Name
+		}
+
+		return parameters[i].In < parameters[j].In
+	})
+}
@@ -0,0 +1,76 @@
+package openapi
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestMarshalYAML(t *testing.T) {
+	file, err := marshalYAML(map[string]any{
+		"paths": map[string]any{
+			"/pets": map[string]any{"get": map[string]any{"operationId": "getPets"}},
+		},
+		"openapi": "3.0.0",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "openapi: 3.0.0\npaths:\n  /pets:\n    get:\n      operationId: getPets\n", string(file))
+}
+
+func TestMarshalJSON(t *testing.T) {
+	file, err := marshalJSON(map[string]any{"openapi": "3.0.0", "info": map[string]any{"title": "Pets"}})
+	require.NoError(t, err)
+	require.Equal(t, "{\n  \"info\": {\n    \"title\": \"Pets\"\n  },\n  \"openapi\": \"3.0.0\"\n}\n", string(file))
+}
+
+func TestSortOutput(t *testing.T) {
+	output := &OpenAPISchema{
+		Paths: Paths{
+			"/pets/{id}": {
+				Get: &Operation{
+					Parameters: []Parameter{
+						{Name: "limit", In: "query"},
+						{Name: "id", In: "query"},
+						{Name: "id", In: "path"},
+					},
+					Security: []Security{{"oauth2": {"write", "read"}}},
+					Responses: Responses{
+						"200": {
+							Content: map[string]MediaType{
+								"application/json": {Schema: Schema{Type: "object", Required: []string{"name", "id"}}},
+							},
+						},
+					},
+				},
+			},
+		},
+		Components: Components{
+			Schemas: map[string]Schema{
+				"Pet": {
+					Type:     "object",
+					Required: []string{"tag", "name"},
+					Properties: map[string]Schema{
+						"owner": {Type: "object", Required: []string{"name", "email"}},
+					},
+					Enum: []any{"dog", "cat"},
+				},
+			},
+		},
+	}
+
+	sortOutput(output)
+
+	operation := output.Paths["/pets/{id}"].Get
+	require.Equal(t, []Parameter{
+		{Name: "id", In: "path"},
+		{Name: "id", In: "query"},
+		{Name: "limit", In: "query"},
+	}, operation.Parameters)
+	require.Equal(t, []string{"read", "write"}, operation.Security[0]["oauth2"])
+	require.Equal(t, []string{"id", "name"}, operation.Responses["200"].Content["application/json"].Schema.Required)
+
+	pet := output.Components.Schemas["Pet"]
+	require.Equal(t, []string{"name", "tag"}, pet.Required)
+	require.Equal(t, []string{"email", "name"}, pet.Properties["owner"].Required)
+	// Enums keep the order they are declared in
+	require.Equal(t, []any{"dog", "cat"}, pet.Enum)
+}
@@ -0,0 +1,180 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// formatExamples are the placeholders of the string formats, so the examples are valid for them.
+var formatExamples = map[string]string{
+	"date-time": "2006-01-02T15:04:05Z",
+	"date":      "2006-01-02",
+	"time":      "15:04:05",
+	"duration":  "1h",
+	"email":     "user@example.com",
+	"hostname":  "example.com",
+	"ipv4":      "192.0.2.1",
+	"ipv6":      "2001:db8::1",
+	"uri":       "https://example.com",
+	"url":       "https://example.com",
+	"uuid":      "00000000-0000-0000-0000-000000000000",
+	"byte":      "",
+	"binary":    "",
+}
+
+// Example synthesises an example of the schema (i.e. the body of a request), resolving its references to the components.
+// It is the example of the schema if it has one, the first value of an enum, or a placeholder of its type and format.
+// References to the components whose examples are being synthesised are null, so recursive components end.
+func Example(schema Schema, components map[string]Schema) any {
+	return example(schema, components, make(map[string]bool))
+}
+
+// example synthesises an example of the schema, with the components whose examples are being synthesised.
+func example(schema Schema, components map[string]Schema, resolving map[string]bool) any {
+	if schema.Example != nil {
+		return schema.Example
+	}
+
+	if schema.Ref != "" {
+		name := strings.TrimPrefix(schema.Ref, schemaRefPrefix)
+		component, ok := components[name]
+		if !ok || resolving[name] {
+			return nil
+		}
+
+		resolving[name] = true
+		defer delete(resolving, name)
+
+		return example(component, components, resolving)
+	}
+
+	if len(schema.Enum) > 0 {
+		return schema.Enum[0]
+	}
+
+	if len(schema.AllOf) > 0 {
+		return allOfExample(schema, components, resolving)
+	}
+	if len(schema.OneOf) > 0 {
+		return example(schema.OneOf[0], components, resolving)
+	}
+	if len(schema.AnyOf) > 0 {
+		return example(schema.AnyOf[0], components, resolving)
+	}
+
+	switch schema.Type {
+	case "string":
+		if placeholder, ok := formatExamples[schema.Format]; ok {
+			return placeholder
+		}
+		return "string"
+	case "integer":
+		return int64(schema.Minimum)
+	case "number":
+		return schema.Minimum
+	case "boolean":
+		return false
+	case "array":
+		if schema.Items == nil {
+			return []any{}
+		}
+		return []any{example(*schema.Items, components, resolving)}
+	case "object", "":
+		return objectExample(schema, components, resolving)
+	}
+
+	return nil
+}
+
+// objectExample synthesises an example of an object schema, with every property and a key of its additional properties.
+func objectExample(schema Schema, components map[string]Schema, resolving map[string]bool) any {
+	if schema.Type == "" && len(schema.Properties) == 0 && schema.AdditionalProperties == nil {
+		// A schema without a type or properties is any value
+		return nil
+	}
+
+	object := make(map[string]any, len(schema.Properties)+1)
+	for name, property := range schema.Properties {
+		object[name] = example(property, components, resolving)
+	}
+	if schema.AdditionalProperties != nil {
+		object["key"] = example(*schema.AdditionalProperties, components, resolving)
+	}
+
+	return object
+}
+
+// allOfExample synthesises an example of a schema that embeds others, with the properties of all of them.
+func allOfExample(schema Schema, components map[string]Schema, resolving map[string]bool) any {
+	object := make(map[string]any)
+	for _, embedded := range append(schema.AllOf, Schema{Properties: schema.Properties}) {
+		embeddedObject, ok := example(embedded, components, resolving).(map[string]any)
+		if !ok {
+			continue
+		}
+		for name, value := range embeddedObject {
+			object[name] = value
+		}
+	}
+
+	return object
+}
+
+// ExampleString writes an example as the value of a param or form field.
+// The example of an array is that of its items, as the param is repeated for every item.
+func ExampleString(example any) string {
+	if items, ok := example.([]any); ok {
+		if len(items) == 0 {
+			return ""
+		}
+		example = items[0]
+	}
+
+	switch example := example.(type) {
+	case nil:
+		return ""
+	case string:
+		return example
+	case map[string]any:
+		value, err := json.Marshal(example)
+		if err != nil {
+			return ""
+		}
+		return string(value)
+	default:
+		return fmt.Sprint(example)
+	}
+}
+
+// FormProperties returns the properties of the schema of a form, resolving its reference and the schemas it embeds.
+func FormProperties(schema Schema, components map[string]Schema) map[string]Schema {
+	if schema.Ref != "" {
+		component, ok := components[strings.TrimPrefix(schema.Ref, schemaRefPrefix)]
+		if !ok {
+			return nil
+		}
+		schema = component
+	}
+
+	properties := make(map[string]Schema, len(schema.Properties))
+	for _, embedded := range schema.AllOf {
+		for name, property := range FormProperties(embedded, components) {
+			properties[name] = property
+		}
+	}
+	for name, property := range schema.Properties {
+		properties[name] = property
+	}
+
+	return properties
+}
+
+// IsBinary returns whether the schema is a file in a form (i.e. *multipart.FileHeader), or the files of a multi file part.
+func IsBinary(schema Schema) bool {
+	if schema.Items != nil {
+		schema = *schema.Items
+	}
+
+	return schema.Type == "string" && schema.Format == "binary"
+}
@@ -0,0 +1,92 @@
+package openapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExample(t *testing.T) {
+	components := map[string]Schema{
+		"Status": {Type: "string", Enum: []any{"available", "sold"}},
+		"Pet": {
+			Type: "object",
+			Properties: map[string]Schema{
+				"id":       {Type: "integer", Format: "int32"},
+				"name":     {Type: "string"},
+				"status":   {Ref: "#/components/schemas/Status"},
+				"bornAt":   {Type: "string", Format: "date-time"},
+				"tags":     {Type: "array", Items: &Schema{Type: "string"}},
+				"vaccined": {Type: "boolean"},
+				"parent":   {Ref: "#/components/schemas/Pet", Nullable: true},
+			},
+		},
+	}
+
+	t.Run("it synthesises the properties of an object from their schemas", func(t *testing.T) {
+		require.Equal(t, map[string]any{
+			"id":       int64(0),
+			"name":     "string",
+			"status":   "available",
+			"bornAt":   "2006-01-02T15:04:05Z",
+			"tags":     []any{"string"},
+			"vaccined": false,
+			"parent":   nil,
+		}, Example(Schema{Ref: "#/components/schemas/Pet"}, components))
+	})
+
+	t.Run("it uses the example of the schema", func(t *testing.T) {
+		require.Equal(t, "Rex", Example(Schema{Type: "string", Example: "Rex"}, components))
+	})
+
+	t.Run("it uses placeholders of the formats", func(t *testing.T) {
+		require.Equal(t, "user@example.com", Example(Schema{Type: "string", Format: "email"}, components))
+		require.Equal(t, "00000000-0000-0000-0000-000000000000", Example(Schema{Type: "string", Format: "uuid"}, components))
+		require.Equal(t, "2006-01-02", Example(Schema{Type: "string", Format: "date"}, components))
+	})
+
+	t.Run("it merges the properties of the embedded schemas", func(t *testing.T) {
+		require.Equal(t, map[string]any{"status": "available", "owner": "string"}, Example(Schema{
+			AllOf: []Schema{
+				{Type: "object", Properties: map[string]Schema{"status": {Ref: "#/components/schemas/Status"}}},
+			},
+			Properties: map[string]Schema{"owner": {Type: "string"}},
+		}, components))
+	})
+
+	t.Run("it synthesises a key of the additional properties", func(t *testing.T) {
+		require.Equal(t, map[string]any{"key": 0.0}, Example(Schema{Type: "object", AdditionalProperties: &Schema{Type: "number"}}, components))
+	})
+}
+
+func TestExampleString(t *testing.T) {
+	require.Equal(t, "Rex", ExampleString("Rex"))
+	require.Equal(t, "3", ExampleString(int64(3)))
+	require.Equal(t, "string", ExampleString([]any{"string"}))
+	require.Equal(t, "", ExampleString([]any{}))
+	require.Equal(t, "", ExampleString(nil))
+	require.Equal(t, `{"name":"Rex"}`, ExampleString(map[string]any{"name": "Rex"}))
+}
+
+func TestFormProperties(t *testing.T) {
+	components := map[string]Schema{
+		"Upload": {
+			Type:       "object",
+			AllOf:      []Schema{{Properties: map[string]Schema{"caption": {Type: "string"}}}},
+			Properties: map[string]Schema{"photo": {Type: "string", Format: "binary"}},
+		},
+	}
+
+	require.Equal(t, map[string]Schema{
+		"caption": {Type: "string"},
+		"photo":   {Type: "string", Format: "binary"},
+	}, FormProperties(Schema{Ref: "#/components/schemas/Upload"}, components))
+	require.Nil(t, FormProperties(Schema{Ref: "#/components/schemas/Missing"}, components))
+}
+
+func TestIsBinary(t *testing.T) {
+	require.True(t, IsBinary(Schema{Type: "string", Format: "binary"}))
+	require.True(t, IsBinary(Schema{Type: "array", Items: &Schema{Type: "string", Format: "binary"}}))
+	require.False(t, IsBinary(Schema{Type: "string"}))
+	require.False(t, IsBinary(Schema{Type: "array", Items: &Schema{Type: "string"}}))
+}
@@ -0,0 +1,94 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"maps"
+
+	"github.com/ls6-events/astra"
+)
+
+// The extensions of a component with its Go type and package, added by astra.WithGoTypeExtensions.
+const (
+	goTypeExtension    = "x-go-type"
+	goPackageExtension = "x-go-package"
+)
+
+// marshalJSONWithExtensions marshals a value to a JSON object, with the vendor extensions inlined into it.
+// The extensions are written after the other fields, sorted by their names.
+func marshalJSONWithExtensions(v any, extensions map[string]any) ([]byte, error) {
+	object, err := json.Marshal(v)
+	if err != nil || len(extensions) == 0 {
+		return object, err
+	}
+
+	var buffer bytes.Buffer
+	buffer.Write(object[:len(object)-1])
+	for i, name := range sortedKeys(extensions) {
+		value, err := json.Marshal(extensions[name])
+		if err != nil {
+			return nil, err
+		}
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if i > 0 || len(object) > 2 {
+			buffer.WriteByte(',')
+		}
+		buffer.Write(key)
+		buffer.WriteByte(':')
+		buffer.Write(value)
+	}
+	buffer.WriteByte('}')
+
+	return buffer.Bytes(), nil
+}
+
+// MarshalJSON writes the info with its vendor extensions.
+func (i Info) MarshalJSON() ([]byte, error) {
+	// The alias doesn't have the methods of the info, so it doesn't call MarshalJSON again
+	type info Info
+	return marshalJSONWithExtensions(info(i), i.Extensions)
+}
+
+// MarshalJSON writes the operation with its vendor extensions.
+func (o Operation) MarshalJSON() ([]byte, error) {
+	type operation Operation
+	return marshalJSONWithExtensions(operation(o), o.Extensions)
+}
+
+// mergeExtensions adds the vendor extensions to the existing ones, replacing any with the same names.
+func mergeExtensions(extensions map[string]any, add map[string]any) map[string]any {
+	if len(add) == 0 {
+		return extensions
+	}
+
+	merged := make(map[string]any, len(extensions)+len(add))
+	maps.Copy(merged, extensions)
+	maps.Copy(merged, add)
+
+	return merged
+}
+
+// withParameterExtensions moves the vendor extensions of the schema of a parameter to the parameter.
+// The schema of a parameter spread from a bound struct is the schema of its struct field, so its extensions describe the parameter.
+func withParameterExtensions(parameter Parameter) Parameter {
+	parameter.Extensions = mergeExtensions(parameter.Extensions, parameter.Schema.Extensions)
+	parameter.Schema.Extensions = nil
+
+	return parameter
+}
+
+// goTypeExtensions returns the extensions of a component with its Go type and package.
+func goTypeExtensions(component astra.Field) map[string]any {
+	extensions := map[string]any{
+		goTypeExtension: component.Name,
+	}
+	if component.Package != "" {
+		extensions[goPackageExtension] = component.Package
+	}
+
+	return extensions
+}
@@ -0,0 +1,96 @@
+package openapi
+
+import (
+	"encoding/json"
+	"github.com/ls6-events/astra"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+func TestMarshalJSONWithExtensions(t *testing.T) {
+	t.Run("Without extensions", func(t *testing.T) {
+		data, err := marshalJSONWithExtensions(Tag{Name: "pets"}, nil)
+		require.NoError(t, err)
+		require.Equal(t, `{"name":"pets"}`, string(data))
+	})
+
+	t.Run("With extensions", func(t *testing.T) {
+		data, err := marshalJSONWithExtensions(Tag{Name: "pets"}, map[string]any{"x-ratelimit": 100, "x-internal": true})
+		require.NoError(t, err)
+		require.Equal(t, `{"name":"pets","x-internal":true,"x-ratelimit":100}`, string(data))
+	})
+
+	t.Run("Empty object", func(t *testing.T) {
+		data, err := marshalJSONWithExtensions(Tag{}, map[string]any{"x-internal": true})
+		require.NoError(t, err)
+		require.Equal(t, `{"x-internal":true}`, string(data))
+	})
+}
+
+func TestExtensions_MarshalJSON(t *testing.T) {
+	extensions := map[string]any{"x-internal": true}
+
+	testCases := []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{name: "Info", value: Info{Title: "Petstore", Version: "1.0.0", Extensions: extensions}, expected: `{"title": "Petstore", "version": "1.0.0", "contact": {}, "license": {"name": ""}, "x-internal": true}`},
+		{name: "Operation", value: Operation{OperationID: "getPets", Extensions: extensions}, expected: `{"operationId": "getPets", "x-internal": true}`},
+		{name: "Parameter", value: Parameter{Name: "limit", In: "query", Schema: Schema{Type: "integer"}, Extensions: extensions}, expected: `{"name": "limit", "in": "query", "schema": {"type": "integer"}, "x-internal": true}`},
+		{name: "Schema", value: Schema{Type: "string", Extensions: extensions}, expected: `{"type": "string", "x-internal": true}`},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			data, err := json.Marshal(testCase.value)
+			require.NoError(t, err)
+			require.JSONEq(t, testCase.expected, string(data))
+		})
+	}
+}
+
+func TestExtensions_MarshalYAML(t *testing.T) {
+	extensions := map[string]any{"x-internal": true}
+
+	testCases := []struct {
+		name     string
+		value    any
+		expected map[string]any
+	}{
+		{name: "Operation", value: Operation{OperationID: "getPets", Extensions: extensions}, expected: map[string]any{"operationId": "getPets", "x-internal": true}},
+		{name: "Parameter", value: Parameter{Name: "limit", In: "query", Schema: Schema{Type: "integer"}, Extensions: extensions}, expected: map[string]any{"name": "limit", "in": "query", "schema": map[string]any{"type": "integer"}, "x-internal": true}},
+		{name: "Schema", value: Schema{Type: "string", Extensions: extensions}, expected: map[string]any{"type": "string", "x-internal": true}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			data, err := yaml.Marshal(testCase.value)
+			require.NoError(t, err)
+
+			var decoded map[string]any
+			err = yaml.Unmarshal(data, &decoded)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, decoded)
+		})
+	}
+}
+
+func TestWithParameterExtensions(t *testing.T) {
+	parameter := withParameterExtensions(Parameter{
+		Name:   "limit",
+		Schema: Schema{Type: "integer", Extensions: map[string]any{"x-ratelimit": 100}},
+	})
+
+	require.Equal(t, map[string]any{"x-ratelimit": 100}, parameter.Extensions)
+	require.Nil(t, parameter.Schema.Extensions)
+}
+
+func TestGoTypeExtensions(t *testing.T) {
+	require.Equal(t, map[string]any{
+		"x-go-type":    "Pet",
+		"x-go-package": "github.com/ls6-events/astra/tests/petstore",
+	}, goTypeExtensions(astra.Field{Name: "Pet", Package: "github.com/ls6-events/astra/tests/petstore"}))
+	require.Equal(t, map[string]any{"x-go-type": "Pet"}, goTypeExtensions(astra.Field{Name: "Pet"}))
+}
@@ -1,13 +1,11 @@
 package openapi
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
 	"path"
 	"reflect"
-	"sort"
 	"strconv"
 	"strings"
 	"unicode"
@@ -17,7 +15,13 @@ import (
 	"github.com/ls6-events/astra/utils"
 
 	"github.com/iancoleman/strcase"
-	"gopkg.in/yaml.v3"
+)
+
+const (
+	// multipartFormContentType is the content type of request bodies that upload files.
+	multipartFormContentType = "multipart/form-data"
+	// urlEncodedFormContentType is the content type of request bodies that only contain form values.
+	urlEncodedFormContentType = "application/x-www-form-urlencoded"
 )
 
 func preferredComponentBinding(bindingTags []astTraversal.BindingTagType) astTraversal.BindingTagType {
@@ -46,6 +50,17 @@ func preferredComponentBinding(bindingTags []astTraversal.BindingTagType) astTra
 	return astTraversal.NoBindingTag
 }
 
+// componentBindings returns the binding types that a component has a schema for.
+// A component whose fields have unique binding tags has a schema for each of them, otherwise it only has one for the preferred binding.
+func componentBindings(component astra.Field) []astTraversal.BindingTagType {
+	bindingTags, uniqueBindings := astra.ExtractBindingTags(component.StructFields)
+	if uniqueBindings {
+		return bindingTags
+	}
+
+	return []astTraversal.BindingTagType{preferredComponentBinding(bindingTags)}
+}
+
 func defaultOperationID(method string, endpointPath string) string {
 	raw := strings.ToLower(method) + " " + endpointPath
 	sanitized := strings.Map(func(r rune) rune {
@@ -57,381 +72,545 @@ func defaultOperationID(method string, endpointPath string) string {
 	return strcase.ToLowerCamel(sanitized)
 }
 
+// mapBoundParamToParameters spreads a bound struct param into one parameter per struct field, as OpenAPI requires every parameter to be named.
+// The doc comment and validation rules of each struct field document and constrain the parameter.
+func mapBoundParamToParameters(s *astra.Service, param astra.Param, bindingType astTraversal.BindingTagType, in string) []Parameter {
+	field, found := findComponentByPackageAndType(s.Components, param.Field.Package, param.Field.Type)
+	if !found {
+		return nil
+	}
+
+	component, bound := componentToSchema(s, field, bindingType)
+	if !bound {
+		return nil
+	}
+
+	parameters := make([]Parameter, 0, len(component.Properties))
+	for _, structField := range field.StructFields {
+		fieldBinding := structField.StructFieldBindingTags[bindingType]
+		if fieldBinding == (astTraversal.BindingTag{}) {
+			fieldBinding = structField.StructFieldBindingTags[astTraversal.NoBindingTag]
+		}
+		if fieldBinding.Name == "" || fieldBinding.NotShown {
+			continue
+		}
+
+		propertySchema, ok := component.Properties[fieldBinding.Name]
+		if !ok {
+			continue
+		}
+
+		required := param.IsRequired
+		for _, validationTag := range structField.StructFieldValidationTags {
+			required = required || validationTag.IsRequired
+		}
+
+		parameters = append(parameters, withParameterExtensions(Parameter{
+			Name:        fieldBinding.Name,
+			In:          in,
+			Description: structField.Doc,
+			Required:    required,
+			Schema:      applyFieldSchemaProperties(applyValidationRules(ensureSchema(propertySchema), astra.ExtractValidationRules(structField.StructFieldValidationTags)), structField),
+		}))
+	}
+
+	return parameters
+}
+
+// mapResponseHeaders adds the response headers to the headers of a response.
+func mapResponseHeaders(s *astra.Service, endpoint astra.Route, headers map[string]Header, responseHeaders []astra.Param) map[string]Header {
+	for _, responseHeader := range responseHeaders {
+		s.Log.Debug().Str("endpointPath", endpoint.Path).Str("method", endpoint.Method).Str("param", responseHeader.Name).Msg("Adding response header")
+		schema, bound := mapParamToSchema(astTraversal.HeaderBindingTag, responseHeader)
+		if !bound {
+			continue
+		}
+
+		if headers == nil {
+			headers = make(map[string]Header)
+		}
+		headers[responseHeader.Name] = Header{
+			Schema:   schema,
+			Required: responseHeader.IsRequired,
+		}
+	}
+
+	return headers
+}
+
 // Generate the OpenAPI output.
 // It will marshal the OpenAPI struct and write it to a file.
 // It will also generate the paths and their operations.
 // It will also generate the components and their schemas.
-func Generate(filePath string) astra.ServiceFunction {
-	return func(s *astra.Service) error {
-		s.Log.Debug().Msg("Generating OpenAPI output")
-		if s.Config == nil {
-			s.Log.Error().Msg("No config found")
-			return astra.ErrConfigNotFound
+func Generate(filePath string, options Options) astra.ServiceFunction {
+	return generateOutput(options, func(s *astra.Service, output OpenAPISchema) error {
+		if !strings.HasSuffix(filePath, ".json") && !strings.HasSuffix(filePath, ".yaml") && !strings.HasSuffix(filePath, ".yml") {
+			s.Log.Debug().Msg("No file extension provided, defaulting to .json")
+			filePath += ".json"
 		}
 
-		s.Log.Debug().Msg("Making collision safe struct names")
-		makeCollisionSafeNamesFromComponents(s.Components)
+		var file []byte
+		var err error
+		if strings.HasSuffix(filePath, ".yaml") || strings.HasSuffix(filePath, ".yml") {
+			s.Log.Debug().Msg("Writing YAML file")
+			file, err = marshalYAML(output)
+		} else {
+			s.Log.Debug().Msg("Writing JSON file")
+			file, err = marshalJSON(output)
+		}
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to marshal OpenAPI schema")
+			return err
+		}
 
-		protocol := "http"
-		if s.Config.Secure {
-			protocol += "s"
+		filePath := path.Join(s.WorkDir, filePath)
+		err = os.WriteFile(filePath, file, 0644)
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to write OpenAPI schema file")
+			return err
 		}
 
-		paths := make(Paths)
-		operationIDs := make(map[string]int)
-		s.Log.Debug().Msg("Adding paths")
-		for _, endpoint := range s.Routes {
-			s.Log.Debug().Str("endpointPath", endpoint.Path).Str("method", endpoint.Method).Msg("Generating endpoint")
-			s.Log.Debug().
-				Str("endpointPath", endpoint.Path).
-				Str("method", endpoint.Method).
-				Int("returnTypeCount", len(endpoint.ReturnTypes)).
-				Msg("Preparing endpoint for responses")
-
-			endpoint.Path = utils.MapPathParams(endpoint.Path, func(param string) string {
-				if param[0] == ':' {
-					return fmt.Sprintf("{%s}", param[1:])
-				} else {
-					return fmt.Sprintf("{%s*}", param[1:])
-				}
-			})
+		s.Log.Debug().Str("filePath", filePath).Msg("Successfully generated OpenAPI schema file")
+
+		return nil
+	})
+}
+
+// generateOutput generates the OpenAPI specification from the routes and components, and writes it with the function.
+func generateOutput(options Options, write func(s *astra.Service, output OpenAPISchema) error) astra.ServiceFunction {
+	return func(s *astra.Service) error {
+		output, err := Build(s, options)
+		if err != nil {
+			return err
+		}
 
-			operation := Operation{
-				Responses: make(map[string]Response),
+		reuseComponents(&output, options.ComponentThreshold)
+		convertToVersion(&output, Version(output.OpenAPI))
+
+		return write(s, output)
+	}
+}
+
+// Build builds the OpenAPI specification from the routes and components, before its schemas are converted to its version.
+// The schemas are as they are for OpenAPI 3.0, except that references can be nullable and have examples.
+// Other outputs build on it, so they describe the routes and components in the same way as the OpenAPI specification.
+func Build(s *astra.Service, options Options) (OpenAPISchema, error) {
+	version := options.Version
+	if version == "" {
+		version = Version30
+	}
+
+	s.Log.Debug().Str("version", string(version)).Msg("Generating OpenAPI output")
+	if s.Config == nil {
+		s.Log.Error().Msg("No config found")
+		return OpenAPISchema{}, astra.ErrConfigNotFound
+	}
+
+	s.Log.Debug().Msg("Making collision safe struct names")
+	makeCollisionSafeNamesFromComponents(s.Components)
+
+	paths := make(Paths)
+	webhooks := make(Paths)
+	operationIDs := make(map[string]int)
+	s.Log.Debug().Msg("Adding paths")
+	for _, endpoint := range s.Routes {
+		s.Log.Debug().Str("endpointPath", endpoint.Path).Str("method", endpoint.Method).Msg("Generating endpoint")
+		s.Log.Debug().
+			Str("endpointPath", endpoint.Path).
+			Str("method", endpoint.Method).
+			Int("returnTypeCount", len(endpoint.ReturnTypes)).
+			Msg("Preparing endpoint for responses")
+
+		endpoint.Path = utils.MapPathParams(endpoint.Path, func(param string) string {
+			if param[0] == ':' {
+				return fmt.Sprintf("{%s}", param[1:])
+			} else {
+				return fmt.Sprintf("{%s*}", param[1:])
 			}
+		})
 
-			for _, pathParam := range endpoint.PathParams {
-				s.Log.Debug().Str("endpointPath", endpoint.Path).Str("method", endpoint.Method).Str("param", pathParam.Name).Msg("Adding endpointPath parameter")
-				schema, bound := mapParamToSchema(astTraversal.URIBindingTag, pathParam)
-				if !bound {
-					continue
-				}
-				schema = ensureSchema(schema)
+		operation := Operation{
+			Responses: make(map[string]Response),
+		}
 
-				operation.Parameters = append(operation.Parameters, Parameter{
-					Name:     pathParam.Name,
-					In:       "path",
-					Required: pathParam.IsRequired,
-					Schema:   schema,
-				})
+		// The path params extracted from the path are the source of truth for which params exist
+		// Bound params (c.ShouldBindUri) then override the type and documentation of the ones they bind
+		pathParameters := make([]Parameter, 0, len(endpoint.PathParams))
+		for _, pathParam := range endpoint.PathParams {
+			if pathParam.IsBound {
+				continue
 			}
 
-			for _, requestHeader := range endpoint.RequestHeaders {
-				s.Log.Debug().Str("endpointPath", endpoint.Path).Str("method", endpoint.Method).Str("param", requestHeader.Name).Msg("Adding request header")
-				if requestHeader.IsBound {
-					field, found := findComponentByPackageAndType(s.Components, requestHeader.Field.Package, requestHeader.Field.Type)
-					if !found {
-						continue
-					}
+			s.Log.Debug().Str("endpointPath", endpoint.Path).Str("method", endpoint.Method).Str("param", pathParam.Name).Msg("Adding endpointPath parameter")
+			schema, bound := mapParamToSchema(astTraversal.URIBindingTag, pathParam)
+			if !bound {
+				continue
+			}
+			schema = ensureSchema(schema)
+
+			pathParameters = append(pathParameters, Parameter{
+				Name:        pathParam.Name,
+				In:          "path",
+				Description: pathParam.Doc,
+				Required:    pathParam.IsRequired,
+				Schema:      schema,
+			})
+		}
+		for _, pathParam := range endpoint.PathParams {
+			if !pathParam.IsBound {
+				continue
+			}
 
-					component, bound := componentToSchema(s, field, astTraversal.HeaderBindingTag)
-					if !bound {
-						continue
+			s.Log.Debug().Str("endpointPath", endpoint.Path).Str("method", endpoint.Method).Str("param", pathParam.Field.Type).Msg("Adding bound endpointPath parameters")
+			for _, boundParameter := range mapBoundParamToParameters(s, pathParam, astTraversal.URIBindingTag, "path") {
+				for i, pathParameter := range pathParameters {
+					if pathParameter.Name == boundParameter.Name {
+						boundParameter.Required = pathParameter.Required
+						pathParameters[i] = boundParameter
 					}
+				}
+			}
+		}
+		operation.Parameters = append(operation.Parameters, pathParameters...)
 
-					for propertyName, propertySchema := range component.Properties {
-						propertySchema = ensureSchema(propertySchema)
-						operation.Parameters = append(operation.Parameters, Parameter{
-							Name:     propertyName,
-							In:       "header",
-							Required: requestHeader.IsRequired,
-							Schema:   propertySchema,
-						})
-					}
-				} else {
-					schema, bound := mapParamToSchema(astTraversal.HeaderBindingTag, requestHeader)
-					if !bound {
-						continue
-					}
-					schema = ensureSchema(schema)
+		for _, requestHeader := range endpoint.RequestHeaders {
+			s.Log.Debug().Str("endpointPath", endpoint.Path).Str("method", endpoint.Method).Str("param", requestHeader.Name).Msg("Adding request header")
+			if requestHeader.IsBound {
+				field, found := findComponentByPackageAndType(s.Components, requestHeader.Field.Package, requestHeader.Field.Type)
+				if !found {
+					continue
+				}
 
-					parameter := Parameter{
-						Name:     requestHeader.Name,
+				component, bound := componentToSchema(s, field, astTraversal.HeaderBindingTag)
+				if !bound {
+					continue
+				}
+
+				for propertyName, propertySchema := range component.Properties {
+					propertySchema = ensureSchema(propertySchema)
+					operation.Parameters = append(operation.Parameters, withParameterExtensions(Parameter{
+						Name:     propertyName,
 						In:       "header",
 						Required: requestHeader.IsRequired,
-						Schema:   schema,
-					}
+						Schema:   propertySchema,
+					}))
+				}
+			} else {
+				schema, bound := mapParamToSchema(astTraversal.HeaderBindingTag, requestHeader)
+				if !bound {
+					continue
+				}
+				schema = ensureSchema(schema)
 
-					operation.Parameters = append(operation.Parameters, parameter)
+				parameter := Parameter{
+					Name:        requestHeader.Name,
+					In:          "header",
+					Description: requestHeader.Doc,
+					Required:    requestHeader.IsRequired,
+					Schema:      schema,
 				}
+
+				operation.Parameters = append(operation.Parameters, parameter)
 			}
+		}
 
-			for _, queryParam := range endpoint.QueryParams {
-				s.Log.Debug().Str("endpointPath", endpoint.Path).Str("method", endpoint.Method).Str("param", queryParam.Name).Msg("Adding query parameter")
-				schema, bound := mapParamToSchema(astTraversal.FormBindingTag, queryParam)
-				if !bound {
+		for _, queryParam := range endpoint.QueryParams {
+			s.Log.Debug().Str("endpointPath", endpoint.Path).Str("method", endpoint.Method).Str("param", queryParam.Name).Msg("Adding query parameter")
+			schema, bound := mapParamToSchema(astTraversal.FormBindingTag, queryParam)
+			if !bound {
+				continue
+			}
+
+			// OpenAPI spec requires the use of a name, so bound parameters must be spread
+			if queryParam.IsBound {
+				field, found := findComponentByPackageAndType(s.Components, queryParam.Field.Package, queryParam.Field.Type)
+				if !found {
 					continue
 				}
 
-				// OpenAPI spec requires the use of a name, so bound parameters must be spread
-				if queryParam.IsBound {
-					field, found := findComponentByPackageAndType(s.Components, queryParam.Field.Package, queryParam.Field.Type)
-					if !found {
-						continue
-					}
+				component, bound := componentToSchema(s, field, astTraversal.FormBindingTag)
+				if !bound {
+					continue
+				}
 
-					component, bound := componentToSchema(s, field, astTraversal.FormBindingTag)
-					if !bound {
+				for propertyName, propertySchema := range component.Properties {
+					// Files can't be sent as query parameters, only as parts of a multipart form
+					if isFileSchema(propertySchema) {
 						continue
 					}
 
-					for propertyName, propertySchema := range component.Properties {
-						propertySchema = ensureSchema(propertySchema)
-						style, explode := getQueryParamStyle(propertySchema)
-
-						parameter := Parameter{
-							Name:     propertyName,
-							In:       "query",
-							Required: queryParam.IsRequired,
-							Explode:  explode,
-							Style:    style,
-							Schema:   propertySchema,
-						}
-
-						operation.Parameters = append(operation.Parameters, parameter)
-					}
-				} else {
-					style, explode := getQueryParamStyle(schema)
+					propertySchema = ensureSchema(propertySchema)
+					style, explode := getQueryParamStyle(propertySchema)
 
 					parameter := Parameter{
-						Name:     queryParam.Name,
+						Name:     propertyName,
 						In:       "query",
 						Required: queryParam.IsRequired,
 						Explode:  explode,
 						Style:    style,
-						Schema:   ensureSchema(schema),
+						Schema:   propertySchema,
 					}
 
-					operation.Parameters = append(operation.Parameters, parameter)
+					operation.Parameters = append(operation.Parameters, withParameterExtensions(parameter))
 				}
+			} else {
+				style, explode := getQueryParamStyle(schema)
+
+				parameter := Parameter{
+					Name:        queryParam.Name,
+					In:          "query",
+					Description: queryParam.Doc,
+					Required:    queryParam.IsRequired,
+					Explode:     explode,
+					Style:       style,
+					Schema:      ensureSchema(schema),
+				}
+
+				operation.Parameters = append(operation.Parameters, parameter)
 			}
+		}
 
-			for _, bodyParam := range endpoint.Body {
-				s.Log.Debug().Str("endpointPath", endpoint.Path).Str("method", endpoint.Method).Str("param", bodyParam.Name).Msg("Adding body parameter")
-				bindingType := astra.ContentTypeToBindingTag(bodyParam.ContentType)
-				schema, bound := mapFieldToSchema(bindingType, bodyParam.Field)
-				if !bound {
-					continue
-				}
+		// Form values are read from multipart forms too, so they are sent alongside any uploaded files
+		isMultipart := false
+		for _, bodyParam := range endpoint.Body {
+			if bodyParam.ContentType == multipartFormContentType {
+				isMultipart = true
+			}
+		}
+
+		for _, bodyParam := range endpoint.Body {
+			s.Log.Debug().Str("endpointPath", endpoint.Path).Str("method", endpoint.Method).Str("param", bodyParam.Name).Msg("Adding body parameter")
+			bindingType := astra.ContentTypeToBindingTag(bodyParam.ContentType)
+			schema, bound := mapBodyParamToSchema(bindingType, bodyParam)
+			if !bound {
+				continue
+			}
+
+			contentType := bodyParam.ContentType
+			if isMultipart && bodyParam.Name != "" && contentType == urlEncodedFormContentType {
+				contentType = multipartFormContentType
+			}
 
-				if operation.RequestBody == nil {
-					operation.RequestBody = &RequestBody{
-						Content: map[string]MediaType{},
+			var properties map[string]Schema
+			if bodyParam.IsBound && bindingType == astTraversal.FormBindingTag {
+				if field, found := findComponentByPackageAndType(s.Components, bodyParam.Field.Package, bodyParam.Field.Type); found {
+					if component, bound := componentToSchema(s, field, bindingType); bound {
+						properties = component.Properties
 					}
 				}
 
-				var mediaType MediaType
-				if bodyParam.Name != "" {
-					mediaType.Schema = Schema{
-						Type: "object",
-						Properties: map[string]Schema{
-							bodyParam.Name: schema,
-						},
-					}
-				} else {
-					mediaType.Schema = schema
+				// Files can only be uploaded as parts of a multipart form
+				if contentType == urlEncodedFormContentType && len(mapMultipartEncoding(properties)) > 0 {
+					continue
 				}
+			}
 
-				operation.RequestBody.Content[bodyParam.ContentType] = mediaType
+			if operation.RequestBody == nil {
+				operation.RequestBody = &RequestBody{
+					Content: map[string]MediaType{},
+				}
 			}
 
-			var responseHeaders map[string]Header
-			if len(endpoint.ResponseHeaders) > 0 {
-				responseHeaders = make(map[string]Header)
-				for _, responseHeader := range endpoint.ResponseHeaders {
-					s.Log.Debug().Str("endpointPath", endpoint.Path).Str("method", endpoint.Method).Str("param", responseHeader.Name).Msg("Adding response header")
-					schema, bound := mapParamToSchema(astTraversal.HeaderBindingTag, responseHeader)
-					if bound {
-						responseHeaders[responseHeader.Name] = Header{
-							Schema:   schema,
-							Required: responseHeader.IsRequired,
-						}
+			mediaType := operation.RequestBody.Content[contentType]
+			if bodyParam.Name != "" {
+				if mediaType.Schema.Type != "object" || mediaType.Schema.Properties == nil {
+					mediaType.Schema = Schema{
+						Type:       "object",
+						Properties: make(map[string]Schema),
 					}
 				}
+				mediaType.Schema.Properties[bodyParam.Name] = schema
+				properties = mediaType.Schema.Properties
+			} else {
+				mediaType.Schema = schema
 			}
 
-			for _, returnType := range endpoint.ReturnTypes {
-				s.Log.Debug().Str("endpointPath", endpoint.Path).Str("method", endpoint.Method).Str("return", returnType.Field.Name).Msg("Adding return type")
-				var mediaType MediaType
-				bindingType := astra.ContentTypeToBindingTag(returnType.ContentType)
-				schema, bound := mapFieldToSchema(bindingType, returnType.Field)
-				if bound {
-					mediaType.Schema = schema
-				}
+			if contentType == multipartFormContentType {
+				mediaType.Encoding = mapMultipartEncoding(properties)
+			}
 
-				statusCode := strconv.Itoa(returnType.StatusCode)
-				if _, set := operation.Responses[statusCode]; !set {
-					operation.Responses[statusCode] = Response{
-						Description: "",
-						Headers:     responseHeaders,
-						Content:     map[string]MediaType{},
-						Links:       nil,
-					}
-				}
+			operation.RequestBody.Content[contentType] = mediaType
+		}
 
-				if !reflect.DeepEqual(mediaType, MediaType{}) {
-					operation.Responses[statusCode].Content[returnType.ContentType] = mediaType
-				}
+		for _, returnType := range endpoint.ReturnTypes {
+			s.Log.Debug().Str("endpointPath", endpoint.Path).Str("method", endpoint.Method).Str("return", returnType.Field.Name).Msg("Adding return type")
+			var mediaType MediaType
+			bindingType := astra.ContentTypeToBindingTag(returnType.ContentType)
+			schema, bound := mapFieldToSchema(bindingType, returnType.Field)
+			if bound {
+				mediaType.Schema = schema
 			}
-			if len(endpoint.ReturnTypes) == 0 {
-				operation.Responses["200"] = Response{
+
+			statusCode := strconv.Itoa(returnType.StatusCode)
+			response, set := operation.Responses[statusCode]
+			if !set {
+				response = Response{
 					Description: "",
-					Headers:     responseHeaders,
-					Content: map[string]MediaType{
-						"application/json": {
-							Schema: Schema{
-								Type: "object",
-							},
-						},
-					},
+					Content:     map[string]MediaType{},
+					Links:       nil,
 				}
 			}
-			if len(endpoint.ReturnTypes) > 0 && len(operation.Responses) == 0 {
-				s.Log.Error().
-					Str("endpointPath", endpoint.Path).
-					Str("method", endpoint.Method).
-					Msg("Return types present but responses are empty")
-			}
+			// The headers are only those set before this response is written
+			response.Headers = mapResponseHeaders(s, endpoint, response.Headers, returnType.Headers)
+			response.Description = astra.JoinDescriptions(response.Description, returnType.Description)
+			operation.Responses[statusCode] = response
 
-			if endpoint.Doc != "" {
-				operation.Description = endpoint.Doc
+			if !reflect.DeepEqual(mediaType, MediaType{}) {
+				operation.Responses[statusCode].Content[returnType.ContentType] = mediaType
 			}
-
-			operationID := endpoint.OperationID
-			if operationID == "" {
-				operationID = defaultOperationID(endpoint.Method, endpoint.Path)
+		}
+		// Responses without a known condition are described by their status
+		for statusCode, response := range operation.Responses {
+			if response.Description == "" {
+				code, _ := strconv.Atoi(statusCode)
+				response.Description = http.StatusText(code)
+				operation.Responses[statusCode] = response
 			}
-			if operationID != "" {
-				if count, ok := operationIDs[operationID]; ok {
-					count++
-					operationIDs[operationID] = count
-					operationID = fmt.Sprintf("%s_%d", operationID, count)
-				} else {
-					operationIDs[operationID] = 1
-				}
-				operation.OperationID = operationID
+		}
+		if len(endpoint.ReturnTypes) == 0 {
+			operation.Responses["200"] = Response{
+				Description: http.StatusText(http.StatusOK),
+				Headers:     mapResponseHeaders(s, endpoint, nil, endpoint.ResponseHeaders),
+				Content: map[string]MediaType{
+					"application/json": {
+						Schema: Schema{
+							Type: "object",
+						},
+					},
+				},
 			}
+		}
+		if len(endpoint.ReturnTypes) > 0 && len(operation.Responses) == 0 {
+			s.Log.Error().
+				Str("endpointPath", endpoint.Path).
+				Str("method", endpoint.Method).
+				Msg("Return types present but responses are empty")
+		}
 
-			// Sort parameters by name
-			sort.Slice(operation.Parameters, func(i, j int) bool {
-				return operation.Parameters[i].Name < operation.Parameters[j].Name
-			})
+		if endpoint.Doc != "" {
+			operation.Description = endpoint.Doc
+		}
+		operation.Summary = endpoint.Summary
+		operation.Tags = endpoint.Tags
+		operation.Deprecated = endpoint.Deprecated
+		operation.Extensions = endpoint.Extensions
+		for _, securityRequirement := range endpoint.Security {
+			operation.Security = append(operation.Security, Security(securityRequirement))
+		}
 
-			var endpointPath Path
-			if _, ok := paths[endpoint.Path]; !ok {
-				endpointPath = Path{}
+		operationID := endpoint.OperationID
+		if operationID == "" {
+			operationID = defaultOperationID(endpoint.Method, endpoint.Path)
+		}
+		if operationID != "" {
+			if count, ok := operationIDs[operationID]; ok {
+				count++
+				operationIDs[operationID] = count
+				operationID = fmt.Sprintf("%s_%d", operationID, count)
 			} else {
-				endpointPath = paths[endpoint.Path]
-			}
-			switch endpoint.Method {
-			case http.MethodGet:
-				endpointPath.Get = &operation
-			case http.MethodPost:
-				endpointPath.Post = &operation
-			case http.MethodPut:
-				endpointPath.Put = &operation
-			case http.MethodPatch:
-				endpointPath.Patch = &operation
-			case http.MethodDelete:
-				endpointPath.Delete = &operation
-			case http.MethodHead:
-				endpointPath.Head = &operation
-			case http.MethodOptions:
-				endpointPath.Options = &operation
+				operationIDs[operationID] = 1
 			}
-
-			paths[endpoint.Path] = endpointPath
-			s.Log.Debug().Str("path", endpoint.Path).Str("method", endpoint.Method).Msg("Added path")
+			operation.OperationID = operationID
 		}
-		s.Log.Debug().Msg("Added paths")
 
-		components := Components{
-			Schemas: make(map[string]Schema),
+		// Webhooks are only supported by OpenAPI 3.1, so they are paths in OpenAPI 3.0
+		pathItems, pathKey := paths, endpoint.Path
+		if endpoint.Webhook != "" && version == Version31 {
+			pathItems, pathKey = webhooks, endpoint.Webhook
 		}
 
-		s.Log.Debug().Msg("Adding components")
-		for _, component := range s.Components {
-			addComponentSchema := func(bindingType astTraversal.BindingTagType) {
-				schema, bound := componentToSchema(s, component, bindingType)
-				if !bound {
-					return
-				}
-
-				s.Log.Debug().Interface("binding", bindingType).Str("name", component.Name).Msg("Adding component")
+		var endpointPath Path
+		if _, ok := pathItems[pathKey]; !ok {
+			endpointPath = Path{}
+		} else {
+			endpointPath = pathItems[pathKey]
+		}
+		switch endpoint.Method {
+		case http.MethodGet:
+			endpointPath.Get = &operation
+		case http.MethodPost:
+			endpointPath.Post = &operation
+		case http.MethodPut:
+			endpointPath.Put = &operation
+		case http.MethodPatch:
+			endpointPath.Patch = &operation
+		case http.MethodDelete:
+			endpointPath.Delete = &operation
+		case http.MethodHead:
+			endpointPath.Head = &operation
+		case http.MethodOptions:
+			endpointPath.Options = &operation
+		}
 
-				if component.Doc != "" {
-					schema.Description = component.Doc
-				}
+		pathItems[pathKey] = endpointPath
+		s.Log.Debug().Str("path", endpoint.Path).Str("method", endpoint.Method).Msg("Added path")
+	}
+	s.Log.Debug().Msg("Added paths")
 
-				componentName, bound := makeComponentRefName(bindingType, component.Name, component.Package)
-				if bound {
-					components.Schemas[componentName] = schema
-				}
-			}
+	components := Components{
+		Schemas:         make(map[string]Schema),
+		SecuritySchemes: mapSecuritySchemes(s.Config.SecuritySchemes),
+	}
 
-			bindingTags, uniqueBindings := astra.ExtractBindingTags(component.StructFields)
-			if uniqueBindings {
-				for _, bindingType := range bindingTags {
-					addComponentSchema(bindingType)
-				}
-				continue
+	s.Log.Debug().Msg("Adding components")
+	for _, component := range s.Components {
+		addComponentSchema := func(bindingType astTraversal.BindingTagType) {
+			schema, bound := componentToSchema(s, component, bindingType)
+			if !bound {
+				return
 			}
 
-			addComponentSchema(preferredComponentBinding(bindingTags))
-		}
-		s.Log.Debug().Msg("Added components")
+			s.Log.Debug().Interface("binding", bindingType).Str("name", component.Name).Msg("Adding component")
 
-		if s.Config.Description == "" {
-			s.Config.Description = "Generated by astra"
-		}
+			if component.Doc != "" {
+				schema.Description = component.Doc
+			}
+			if s.GoTypeExtensions {
+				schema.Extensions = mergeExtensions(schema.Extensions, goTypeExtensions(component))
+			}
 
-		s.Log.Debug().Msg("Generating OpenAPI schema file")
-		output := OpenAPISchema{
-			OpenAPI: "3.0.0",
-			Info: Info{
-				Title:       s.Config.Title,
-				Description: s.Config.Description,
-				Contact:     Contact(s.Config.Contact),
-				License:     License(s.Config.License),
-				Version:     s.Config.Version,
-			},
-			Servers: []Server{
-				{
-					URL: fmt.Sprintf("%s://%s:%d%s", protocol, s.Config.Host, s.Config.Port, s.Config.BasePath),
-				},
-			},
-			Paths:      paths,
-			Components: components,
+			componentName, bound := makeComponentRefName(bindingType, component.Name, component.Package)
+			if bound {
+				components.Schemas[componentName] = schema
+			}
 		}
 
-		if !strings.HasSuffix(filePath, ".json") && !strings.HasSuffix(filePath, ".yaml") && !strings.HasSuffix(filePath, ".yml") {
-			s.Log.Debug().Msg("No file extension provided, defaulting to .json")
-			filePath += ".json"
+		for _, bindingType := range componentBindings(component) {
+			addComponentSchema(bindingType)
 		}
+	}
+	s.Log.Debug().Msg("Added components")
 
-		var file []byte
-		var err error
-		if strings.HasSuffix(filePath, ".yaml") || strings.HasSuffix(filePath, ".yml") {
-			s.Log.Debug().Msg("Writing YAML file")
-			file, err = yaml.Marshal(output)
-		} else {
-			s.Log.Debug().Msg("Writing JSON file")
-			file, err = json.MarshalIndent(output, "", "  ")
-		}
-		if err != nil {
-			s.Log.Error().Err(err).Msg("Failed to marshal OpenAPI schema")
-			return err
-		}
+	if s.Config.Description == "" {
+		s.Config.Description = "Generated by astra"
+	}
 
-		filePath = path.Join(s.WorkDir, filePath)
-		err = os.WriteFile(filePath, file, 0644)
-		if err != nil {
-			s.Log.Error().Err(err).Msg("Failed to write OpenAPI schema file")
-			return err
-		}
+	s.Log.Debug().Msg("Generating OpenAPI schema file")
+	output := OpenAPISchema{
+		OpenAPI: string(version),
+		Info: Info{
+			Title:       s.Config.Title,
+			Description: s.Config.Description,
+			Contact:     Contact(s.Config.Contact),
+			License:     License(s.Config.License),
+			Version:     s.Config.Version,
+			Extensions:  s.Config.Extensions,
+		},
+		Servers:    mapServers(s.Config),
+		Paths:      paths,
+		Components: components,
+	}
+	if len(webhooks) > 0 {
+		output.Webhooks = webhooks
+	}
+	for _, tag := range s.Tags {
+		output.Tags = append(output.Tags, Tag{
+			Name:        tag.Name,
+			Description: tag.Description,
+		})
+	}
 
-		s.Log.Debug().Str("filePath", filePath).Msg("Successfully generated OpenAPI schema file")
+	sortOutput(&output)
 
-		return nil
-	}
+	return output, nil
 }
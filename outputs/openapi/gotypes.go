@@ -0,0 +1,88 @@
+package openapi
+
+import (
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
+)
+
+// GoTypes are the Go types of the components of the OpenAPI specification, by the names of their schemas.
+// They describe what the specification can't (i.e. omitempty), for the outputs that are written from the Go types rather than the specification alone.
+type GoTypes map[string]GoType
+
+// GoType is the Go type of a component, by its name and package path, with the struct fields of the properties of its schema.
+type GoType struct {
+	Name    string
+	Package string
+	Fields  Fields
+}
+
+// Fields are the struct fields of the properties of a schema, by the names of the properties.
+type Fields map[string]Field
+
+// Field is the struct field of a property.
+type Field struct {
+	// Doc is the doc comment of the field.
+	Doc string
+	// OmitEmpty is whether the property is omitted when it is empty (i.e. omitempty).
+	OmitEmpty bool
+	// IsRequired is whether the validation tags of the field require it (i.e. binding:"required").
+	IsRequired bool
+	// ValidationRules are the rules of the validation tags of the field (i.e. min=1).
+	ValidationRules map[string]string
+	// Fields are the struct fields of the properties of the field, if it is an inline struct.
+	Fields Fields
+}
+
+// BuildGoTypes builds the Go types of the components of the OpenAPI specification that Build builds from the service.
+// It must be called after Build, as the names of the schemas are made collision safe by it.
+func BuildGoTypes(s *astra.Service) GoTypes {
+	goTypes := make(GoTypes)
+	for _, component := range s.Components {
+		for _, bindingType := range componentBindings(component) {
+			componentName, bound := makeComponentRefName(bindingType, component.Name, component.Package)
+			if !bound {
+				continue
+			}
+
+			goTypes[componentName] = GoType{
+				Name:    component.Name,
+				Package: component.Package,
+				Fields:  structFields(component, bindingType),
+			}
+		}
+	}
+
+	return goTypes
+}
+
+// structFields returns the struct fields of a struct by the names of their properties, as the schema of the struct names them by the binding type.
+func structFields(component astra.Field, bindingType astTraversal.BindingTagType) Fields {
+	if component.Type != "struct" {
+		return nil
+	}
+
+	fields := make(Fields)
+	for _, field := range component.StructFields {
+		if field.IsEmbedded {
+			continue
+		}
+
+		fieldBinding := field.StructFieldBindingTags[bindingType]
+		if fieldBinding == (astTraversal.BindingTag{}) {
+			fieldBinding = field.StructFieldBindingTags[astTraversal.NoBindingTag]
+		}
+		if fieldBinding == (astTraversal.BindingTag{}) || fieldBinding.NotShown {
+			continue
+		}
+
+		fields[fieldBinding.Name] = Field{
+			Doc:             field.Doc,
+			OmitEmpty:       fieldBinding.ReturnOptional,
+			IsRequired:      isRequiredField(field),
+			ValidationRules: astra.ExtractValidationRules(field.StructFieldValidationTags),
+			Fields:          structFields(field, bindingType),
+		}
+	}
+
+	return fields
+}
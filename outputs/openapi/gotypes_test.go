@@ -0,0 +1,71 @@
+package openapi
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildGoTypes(t *testing.T) {
+	jsonTag := func(name string, omitEmpty bool) astTraversal.BindingTagMap {
+		return astTraversal.BindingTagMap{astTraversal.JSONBindingTag: {Name: name, ReturnOptional: omitEmpty}}
+	}
+	pet := astra.Field{
+		Name:    "Pet",
+		Package: "github.com/example/pets",
+		Type:    "struct",
+		StructFields: map[string]astra.Field{
+			"Name": {
+				Type:                   "string",
+				Doc:                    "Name is the name of the pet.",
+				StructFieldBindingTags: jsonTag("name", false),
+				StructFieldValidationTags: astTraversal.ValidationTagMap{
+					astTraversal.GinValidationTag: {IsRequired: true, Rules: map[string]string{"min": "1"}},
+				},
+			},
+			"Nickname": {
+				Type:                   "string",
+				StructFieldBindingTags: jsonTag("nickname", true),
+			},
+			"Secret": {
+				Type:                   "string",
+				StructFieldBindingTags: astTraversal.BindingTagMap{astTraversal.JSONBindingTag: {NotShown: true}},
+			},
+			"Owner": {
+				Type:                   "struct",
+				StructFieldBindingTags: jsonTag("owner", false),
+				StructFields: map[string]astra.Field{
+					"Email": {Type: "string", StructFieldBindingTags: jsonTag("email", true)},
+				},
+			},
+		},
+	}
+	s := &astra.Service{Components: []astra.Field{pet}}
+	makeCollisionSafeNamesFromComponents(s.Components)
+	name, bound := makeComponentRefName(astTraversal.JSONBindingTag, pet.Name, pet.Package)
+	require.True(t, bound)
+
+	goTypes := BuildGoTypes(s)
+
+	require.Equal(t, GoTypes{
+		name: {
+			Name:    "Pet",
+			Package: "github.com/example/pets",
+			Fields: Fields{
+				"name": {
+					Doc:             "Name is the name of the pet.",
+					IsRequired:      true,
+					ValidationRules: map[string]string{"min": "1"},
+				},
+				"nickname": {OmitEmpty: true},
+				"owner": {
+					Fields: Fields{
+						"email": {OmitEmpty: true},
+					},
+				},
+			},
+		},
+	}, goTypes)
+}
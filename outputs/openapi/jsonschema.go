@@ -0,0 +1,156 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/ls6-events/astra"
+)
+
+const (
+	// jsonSchemaDialect is the JSON Schema dialect of the documents of the JSON Schema output.
+	jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+	// tempJSONSchemaOutputDir is the temporary directory in the .astra directory that the JSON Schema documents are written to.
+	tempJSONSchemaOutputDir = "jsonschema"
+	// jsonSchemaBundleFile is the name of the file of the bundle of all the components, without its extension.
+	jsonSchemaBundleFile = "bundle"
+	// jsonSchemaDefsPrefix is the prefix of the references to the components in the bundle.
+	jsonSchemaDefsPrefix = "#/$defs/"
+)
+
+// GenerateJSONSchema generates the JSON Schema output in the directory.
+// It writes a JSON Schema 2020-12 document for every component (i.e. Pet.json), which reference each other with relative $refs, and bundle.json with all of them in its $defs.
+// The schemas are those of the components of the OpenAPI 3.1 specification, so they are bound in the same way.
+func GenerateJSONSchema(directoryPath string) astra.ServiceFunction {
+	return generateOutput(NewOptions(Version31), func(s *astra.Service, output OpenAPISchema) error {
+		s.Log.Debug().Msg("Writing JSON Schema documents")
+		files, err := jsonSchemaFiles(output.Components.Schemas)
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to marshal JSON Schema documents")
+			return err
+		}
+
+		tempOutputDirectoryPath, err := s.SetupTempOutputDir(tempJSONSchemaOutputDir)
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to create directory")
+			return err
+		}
+
+		for filePath, file := range files {
+			err = os.WriteFile(path.Join(tempOutputDirectoryPath, filePath), file, 0644)
+			if err != nil {
+				s.Log.Error().Err(err).Str("filePath", filePath).Msg("Failed to write JSON Schema document")
+				return err
+			}
+		}
+
+		err = s.MoveTempOutputDir(tempJSONSchemaOutputDir, directoryPath)
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to move temporary output directory to final location")
+			return err
+		}
+
+		s.Log.Debug().Str("directoryPath", directoryPath).Msg("Successfully generated JSON Schema documents")
+
+		return nil
+	})
+}
+
+// jsonSchemaFiles writes the JSON Schema documents of the components and their bundle, by their file names.
+// The components are titled by their names if they don't have titles, so the documents are named when they are used on their own.
+func jsonSchemaFiles(schemas map[string]Schema) (map[string][]byte, error) {
+	// The bundle is in the same directory as the components, so its name is taken
+	fileNames := map[string]string{"": jsonSchemaBundleFile}
+	componentNames := make(map[string]string, 2*len(schemas))
+	for _, name := range sortedKeys(schemas) {
+		fileNames[name] = uniqueFileName(fileNames, name)
+		componentNames[schemaRefPrefix+name] = name
+		componentNames[fileNames[name]+".json"] = name
+	}
+
+	// The schemas within the components are shared by the documents and the bundle, so the references are rewritten from either form
+	jsonSchemaRef := func(ref func(name string) string) func(Schema) Schema {
+		return func(schema Schema) Schema {
+			if name, ok := componentNames[schema.Ref]; ok {
+				schema.Ref = ref(name)
+			}
+
+			return schema
+		}
+	}
+
+	files := make(map[string][]byte, len(schemas)+1)
+	for _, name := range sortedKeys(schemas) {
+		schema := mapSchema(schemas[name], jsonSchemaRef(func(name string) string {
+			return fileNames[name] + ".json"
+		}))
+		if schema.Title == "" {
+			schema.Title = name
+		}
+
+		file, err := jsonSchemaDocument(fileNames[name]+".json", schema)
+		if err != nil {
+			return nil, err
+		}
+		files[fileNames[name]+".json"] = file
+	}
+
+	defs := make(map[string]Schema, len(schemas))
+	for _, name := range sortedKeys(schemas) {
+		schema := mapSchema(schemas[name], jsonSchemaRef(func(name string) string {
+			// The names are JSON pointers in the references, in which slashes are escaped
+			return jsonSchemaDefsPrefix + url.PathEscape(strings.ReplaceAll(strings.ReplaceAll(name, "~", "~0"), "/", "~1"))
+		}))
+		if schema.Title == "" {
+			schema.Title = name
+		}
+		defs[name] = schema
+	}
+
+	file, err := jsonSchemaDocument(jsonSchemaBundleFile+".json", struct {
+		Defs map[string]Schema `json:"$defs"`
+	}{defs})
+	if err != nil {
+		return nil, err
+	}
+	files[jsonSchemaBundleFile+".json"] = file
+
+	return files, nil
+}
+
+// jsonSchemaDocument writes a schema as a JSON Schema document, with its dialect and ID before its keywords.
+func jsonSchemaDocument(id string, schema any) ([]byte, error) {
+	object, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	header, err := json.Marshal(struct {
+		Schema string `json:"$schema"`
+		ID     string `json:"$id"`
+	}{jsonSchemaDialect, id})
+	if err != nil {
+		return nil, err
+	}
+
+	var document bytes.Buffer
+	document.Write(header[:len(header)-1])
+	if len(object) > 2 {
+		document.WriteByte(',')
+		document.Write(object[1:])
+	} else {
+		document.WriteByte('}')
+	}
+
+	var file bytes.Buffer
+	err = json.Indent(&file, document.Bytes(), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	file.WriteByte('\n')
+
+	return file.Bytes(), nil
+}
@@ -0,0 +1,74 @@
+package openapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONSchemaFiles(t *testing.T) {
+	newSchemas := func() map[string]Schema {
+		return map[string]Schema{
+			"Pet": {
+				Type: "object",
+				Properties: map[string]Schema{
+					"owner": {Ref: "#/components/schemas/Owner"},
+					"tags":  {Type: "array", Items: &Schema{Ref: "#/components/schemas/Tag"}},
+				},
+			},
+			"Owner":  {Type: "object", Title: "The owner"},
+			"Tag":    {Type: "string"},
+			"bundle": {Type: "string"},
+		}
+	}
+
+	files, err := jsonSchemaFiles(newSchemas())
+	require.NoError(t, err)
+	require.Len(t, files, 5)
+
+	decode := func(t *testing.T, fileName string) map[string]any {
+		require.Contains(t, files, fileName)
+
+		var document map[string]any
+		require.NoError(t, json.Unmarshal(files[fileName], &document))
+		return document
+	}
+
+	t.Run("it writes a document for every component", func(t *testing.T) {
+		pet := decode(t, "Pet.json")
+		require.Equal(t, jsonSchemaDialect, pet["$schema"])
+		require.Equal(t, "Pet.json", pet["$id"])
+		require.Equal(t, "Pet", pet["title"])
+		require.Equal(t, map[string]any{
+			"owner": map[string]any{"$ref": "Owner.json"},
+			"tags":  map[string]any{"type": "array", "items": map[string]any{"$ref": "Tag.json"}},
+		}, pet["properties"])
+
+		require.Equal(t, "The owner", decode(t, "Owner.json")["title"])
+	})
+
+	t.Run("it writes the dialect and ID first", func(t *testing.T) {
+		require.Equal(t, "{\n  \"$schema\": \"https://json-schema.org/draft/2020-12/schema\",\n  \"$id\": \"Tag.json\",\n  \"title\": \"Tag\",\n  \"type\": \"string\"\n}\n", string(files["Tag.json"]))
+	})
+
+	t.Run("it doesn't replace the bundle with a component", func(t *testing.T) {
+		require.Equal(t, "bundle_2.json", decode(t, "bundle_2.json")["$id"])
+		require.Equal(t, "bundle.json", decode(t, "bundle.json")["$id"])
+	})
+
+	t.Run("it bundles the components in $defs", func(t *testing.T) {
+		defs := decode(t, "bundle.json")["$defs"].(map[string]any)
+		require.Len(t, defs, 4)
+		require.Equal(t, map[string]any{"$ref": "#/$defs/Owner"}, defs["Pet"].(map[string]any)["properties"].(map[string]any)["owner"])
+		require.Equal(t, "The owner", defs["Owner"].(map[string]any)["title"])
+	})
+}
+
+func TestJSONSchemaDocument(t *testing.T) {
+	t.Run("it writes an empty schema", func(t *testing.T) {
+		file, err := jsonSchemaDocument("Any.json", Schema{})
+		require.NoError(t, err)
+		require.Equal(t, "{\n  \"$schema\": \"https://json-schema.org/draft/2020-12/schema\",\n  \"$id\": \"Any.json\"\n}\n", string(file))
+	})
+}
@@ -0,0 +1,65 @@
+package openapi
+
+// Options are the options of the OpenAPI output.
+type Options struct {
+	// Version is the version of the OpenAPI specification, which is OpenAPI 3.0 by default
+	Version Version
+	// ComponentThreshold is how many operations must share an identical parameter, response or header for it to be lifted into the components.
+	// They aren't lifted if it is less than 2 (the default).
+	ComponentThreshold int
+	// SplitStrategy is how the paths are split into files when the output is split, which is by path by default.
+	SplitStrategy SplitStrategy
+}
+
+// Option is an option of the OpenAPI output, which is a version of the specification (i.e. Version31) or one of the With options.
+type Option interface {
+	apply(o *Options)
+}
+
+// optionFunc is an option that sets the options with a function.
+type optionFunc func(*Options)
+
+// apply sets the options with the function.
+func (f optionFunc) apply(o *Options) {
+	f(o)
+}
+
+// apply picks the version of the specification, so a version can be passed as an option itself.
+func (v Version) apply(o *Options) {
+	o.Version = v
+}
+
+// NewOptions creates the options of the OpenAPI output, with the defaults for those that aren't set.
+func NewOptions(options ...Option) Options {
+	o := Options{
+		Version:       Version30,
+		SplitStrategy: SplitByPath,
+	}
+	for _, option := range options {
+		option.apply(&o)
+	}
+
+	if o.Version == "" {
+		o.Version = Version30
+	}
+	if o.SplitStrategy == "" {
+		o.SplitStrategy = SplitByPath
+	}
+
+	return o
+}
+
+// WithComponentThreshold is an option to lift the parameters, responses and headers that are identical in at least the threshold of operations into the components.
+// They are then referenced from the operations with $ref.
+func WithComponentThreshold(threshold int) Option {
+	return optionFunc(func(o *Options) {
+		o.ComponentThreshold = threshold
+	})
+}
+
+// WithSplitStrategy is an option to pick how the paths are split into files when the output is split (i.e. SplitByTag).
+func WithSplitStrategy(strategy SplitStrategy) Option {
+	return optionFunc(func(o *Options) {
+		o.SplitStrategy = strategy
+	})
+}
@@ -0,0 +1,21 @@
+package openapi
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestNewOptions(t *testing.T) {
+	t.Run("it sets the defaults", func(t *testing.T) {
+		require.Equal(t, Options{Version: Version30, SplitStrategy: SplitByPath}, NewOptions())
+	})
+
+	t.Run("it applies the options", func(t *testing.T) {
+		require.Equal(t, Options{Version: Version31, ComponentThreshold: 2, SplitStrategy: SplitByTag}, NewOptions(Version31, WithComponentThreshold(2), WithSplitStrategy(SplitByTag)))
+	})
+
+	t.Run("it defaults an empty version and split strategy", func(t *testing.T) {
+		require.Equal(t, Version30, NewOptions(Version("")).Version)
+		require.Equal(t, SplitByPath, NewOptions(WithSplitStrategy("")).SplitStrategy)
+	})
+}
@@ -0,0 +1,131 @@
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/utils"
+)
+
+// PathOperation is an operation of the OpenAPI specification, with the method and path it is under.
+type PathOperation struct {
+	Method string
+	Path   string
+	*Operation
+}
+
+// PathOperations returns the operations of the OpenAPI specification, sorted by their paths and methods.
+func PathOperations(output OpenAPISchema) []PathOperation {
+	var operations []PathOperation
+	for _, path := range sortedKeys(output.Paths) {
+		pathItem := output.Paths[path]
+		for _, operation := range []PathOperation{
+			{http.MethodGet, path, pathItem.Get},
+			{http.MethodPut, path, pathItem.Put},
+			{http.MethodPost, path, pathItem.Post},
+			{http.MethodDelete, path, pathItem.Delete},
+			{http.MethodOptions, path, pathItem.Options},
+			{http.MethodHead, path, pathItem.Head},
+			{http.MethodPatch, path, pathItem.Patch},
+			{http.MethodTrace, path, pathItem.Trace},
+		} {
+			if operation.Operation != nil {
+				operations = append(operations, operation)
+			}
+		}
+	}
+
+	return operations
+}
+
+// RouteOperation returns the operation of the route in the OpenAPI specification, with its method and path, if it has one.
+func RouteOperation(output OpenAPISchema, route astra.Route) *PathOperation {
+	routePath := utils.MapPathParams(route.Path, func(param string) string {
+		if param[0] == ':' {
+			return fmt.Sprintf("{%s}", param[1:])
+		}
+		return fmt.Sprintf("{%s*}", param[1:])
+	})
+
+	pathItem, ok := output.Paths[routePath]
+	if !ok {
+		return nil
+	}
+
+	var operation *Operation
+	switch route.Method {
+	case http.MethodGet:
+		operation = pathItem.Get
+	case http.MethodPut:
+		operation = pathItem.Put
+	case http.MethodPost:
+		operation = pathItem.Post
+	case http.MethodDelete:
+		operation = pathItem.Delete
+	case http.MethodOptions:
+		operation = pathItem.Options
+	case http.MethodHead:
+		operation = pathItem.Head
+	case http.MethodPatch:
+		operation = pathItem.Patch
+	case http.MethodTrace:
+		operation = pathItem.Trace
+	}
+	if operation == nil {
+		return nil
+	}
+
+	return &PathOperation{Method: route.Method, Path: routePath, Operation: operation}
+}
+
+// ServerURL returns the URL of the first server, with the defaults of its variables, and the base path that the paths are relative to.
+// The paths are only relative to the base path if the URL of the server ends with it, as it does when the server is made from the config.
+func ServerURL(servers []Server, basePath string) (string, string) {
+	if len(servers) == 0 {
+		return "", ""
+	}
+
+	serverURL := servers[0].URL
+	for name, variable := range servers[0].Variables {
+		serverURL = strings.ReplaceAll(serverURL, "{"+name+"}", variable.Default)
+	}
+	serverURL = strings.TrimSuffix(serverURL, "/")
+
+	basePath = strings.TrimSuffix(basePath, "/")
+	if basePath == "" || !strings.HasSuffix(serverURL, basePath) {
+		return serverURL, ""
+	}
+
+	return serverURL, basePath
+}
+
+// TrimBasePath returns the path relative to the base path, if it is under it.
+func TrimBasePath(path string, basePath string) string {
+	basePath = strings.TrimSuffix(basePath, "/")
+	if basePath != "" && (path == basePath || strings.HasPrefix(path, basePath+"/")) {
+		return strings.TrimPrefix(path, basePath)
+	}
+
+	return path
+}
+
+// PreferredContentType returns the content type of a body, which is JSON if it can be.
+func PreferredContentType(content map[string]MediaType) (string, bool) {
+	if _, ok := content["application/json"]; ok {
+		return "application/json", true
+	}
+
+	contentTypes := make([]string, 0, len(content))
+	for contentType := range content {
+		contentTypes = append(contentTypes, contentType)
+	}
+	if len(contentTypes) == 0 {
+		return "", false
+	}
+	sort.Strings(contentTypes)
+
+	return contentTypes[0], true
+}
@@ -0,0 +1,115 @@
+package openapi
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPathOperations(t *testing.T) {
+	operations := PathOperations(OpenAPISchema{
+		Paths: Paths{
+			"/pets/{id}": {
+				Get:    &Operation{OperationID: "getPet"},
+				Delete: &Operation{OperationID: "deletePet"},
+			},
+			"/pets": {
+				Post: &Operation{OperationID: "createPet"},
+				Get:  &Operation{OperationID: "listPets"},
+			},
+		},
+	})
+
+	routes := make([]string, 0, len(operations))
+	for _, operation := range operations {
+		routes = append(routes, operation.Method+" "+operation.Path+" "+operation.OperationID)
+	}
+	require.Equal(t, []string{
+		"GET /pets listPets",
+		"POST /pets createPet",
+		"GET /pets/{id} getPet",
+		"DELETE /pets/{id} deletePet",
+	}, routes)
+}
+
+func TestRouteOperation(t *testing.T) {
+	output := OpenAPISchema{
+		Paths: Paths{
+			"/pets/{id}": {
+				Get: &Operation{OperationID: "getPet"},
+			},
+			"/files/{filepath*}": {
+				Get: &Operation{OperationID: "getFile"},
+			},
+		},
+	}
+
+	t.Run("it finds the operation of the route by its path params", func(t *testing.T) {
+		operation := RouteOperation(output, astra.Route{Method: http.MethodGet, Path: "/pets/:id"})
+
+		require.NotNil(t, operation)
+		require.Equal(t, http.MethodGet, operation.Method)
+		require.Equal(t, "/pets/{id}", operation.Path)
+		require.Equal(t, "getPet", operation.OperationID)
+	})
+
+	t.Run("it finds the operation of a wildcard route", func(t *testing.T) {
+		operation := RouteOperation(output, astra.Route{Method: http.MethodGet, Path: "/files/*filepath"})
+
+		require.NotNil(t, operation)
+		require.Equal(t, "/files/{filepath*}", operation.Path)
+	})
+
+	t.Run("it returns nil if the route has no operation", func(t *testing.T) {
+		require.Nil(t, RouteOperation(output, astra.Route{Method: http.MethodDelete, Path: "/pets/:id"}))
+		require.Nil(t, RouteOperation(output, astra.Route{Method: http.MethodGet, Path: "/owners"}))
+	})
+}
+
+func TestServerURL(t *testing.T) {
+	t.Run("it trims the base path of the paths if the server ends with it", func(t *testing.T) {
+		baseURL, basePath := ServerURL([]Server{{URL: "http://localhost:8000/api/"}}, "/api/")
+
+		require.Equal(t, "http://localhost:8000/api", baseURL)
+		require.Equal(t, "/api", basePath)
+	})
+
+	t.Run("it keeps the paths if the server doesn't end with the base path", func(t *testing.T) {
+		baseURL, basePath := ServerURL([]Server{{URL: "https://example.com"}}, "/api")
+
+		require.Equal(t, "https://example.com", baseURL)
+		require.Empty(t, basePath)
+	})
+
+	t.Run("it replaces the variables of the server with their defaults", func(t *testing.T) {
+		baseURL, _ := ServerURL([]Server{{
+			URL:       "https://{environment}.example.com",
+			Variables: map[string]ServerVariable{"environment": {Default: "staging"}},
+		}}, "/")
+
+		require.Equal(t, "https://staging.example.com", baseURL)
+	})
+}
+
+func TestTrimBasePath(t *testing.T) {
+	require.Equal(t, "/pets", TrimBasePath("/api/pets", "/api"))
+	require.Equal(t, "/pets", TrimBasePath("/api/pets", "/api/"))
+	require.Equal(t, "", TrimBasePath("/api", "/api"))
+	require.Equal(t, "/apis/pets", TrimBasePath("/apis/pets", "/api"))
+	require.Equal(t, "/pets", TrimBasePath("/pets", "/"))
+}
+
+func TestPreferredContentType(t *testing.T) {
+	contentType, ok := PreferredContentType(map[string]MediaType{"text/plain": {}, "application/json": {}})
+	require.True(t, ok)
+	require.Equal(t, "application/json", contentType)
+
+	contentType, ok = PreferredContentType(map[string]MediaType{"text/plain": {}, "application/xml": {}})
+	require.True(t, ok)
+	require.Equal(t, "application/xml", contentType)
+
+	_, ok = PreferredContentType(nil)
+	require.False(t, ok)
+}
@@ -0,0 +1,317 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+)
+
+// invalidComponentNameCharacters are the characters that OpenAPI doesn't allow in the names of components.
+var invalidComponentNameCharacters = regexp.MustCompile(`[^a-zA-Z0-9.\-_]`)
+
+// reusable counts the operations that identical values (i.e. parameters) are in.
+// Values are identical if they have the same name and JSON, and are kept in the order they are first found in so they are named the same way every time.
+type reusable[T any] struct {
+	keys          []string
+	names         map[string]string
+	values        map[string]T
+	operations    map[string]int
+	lastOperation map[string]int
+}
+
+func newReusable[T any]() *reusable[T] {
+	return &reusable[T]{
+		names:         make(map[string]string),
+		values:        make(map[string]T),
+		operations:    make(map[string]int),
+		lastOperation: make(map[string]int),
+	}
+}
+
+// key returns the key of a value with the name it would have as a component, which is the same for identical values.
+func (r *reusable[T]) key(name string, value T) (string, bool) {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return "", false
+	}
+
+	return name + " " + string(bytes), true
+}
+
+// add adds a value found in an operation (by its index) with the name it would have as a component.
+func (r *reusable[T]) add(operation int, name string, value T) {
+	key, ok := r.key(name, value)
+	if !ok {
+		return
+	}
+
+	if _, ok := r.values[key]; !ok {
+		r.keys = append(r.keys, key)
+		r.names[key] = name
+		r.values[key] = value
+		r.lastOperation[key] = -1
+	}
+	if r.lastOperation[key] != operation {
+		r.lastOperation[key] = operation
+		r.operations[key]++
+	}
+}
+
+// lift adds the values in at least the threshold of operations to the components, with names that aren't already taken.
+// It returns the names of the components by the keys of the values.
+func (r *reusable[T]) lift(threshold int, components map[string]T) (map[string]T, map[string]string) {
+	names := make(map[string]string)
+	for _, key := range r.keys {
+		if r.operations[key] < threshold {
+			continue
+		}
+
+		if components == nil {
+			components = make(map[string]T)
+		}
+
+		name := invalidComponentNameCharacters.ReplaceAllString(r.names[key], "_")
+		uniqueName := name
+		for i := 2; ; i++ {
+			if _, ok := components[uniqueName]; !ok {
+				break
+			}
+			uniqueName = fmt.Sprintf("%s_%d", name, i)
+		}
+
+		components[uniqueName] = r.values[key]
+		names[key] = uniqueName
+	}
+
+	return components, names
+}
+
+// componentRef is a reference to a component, which can't have any other fields.
+type componentRef struct {
+	Ref string `json:"$ref" yaml:"$ref"`
+}
+
+// MarshalJSON writes the parameter, or only its reference if it references a component.
+func (p Parameter) MarshalJSON() ([]byte, error) {
+	if p.Ref != "" {
+		return json.Marshal(componentRef{p.Ref})
+	}
+
+	// The alias doesn't have the methods of the parameter, so it doesn't call MarshalJSON again
+	type parameter Parameter
+	return marshalJSONWithExtensions(parameter(p), p.Extensions)
+}
+
+// MarshalYAML writes the parameter, or only its reference if it references a component.
+func (p Parameter) MarshalYAML() (any, error) {
+	if p.Ref != "" {
+		return componentRef{p.Ref}, nil
+	}
+
+	type parameter Parameter
+	return parameter(p), nil
+}
+
+// MarshalJSON writes the header, or only its reference if it references a component.
+func (h Header) MarshalJSON() ([]byte, error) {
+	if h.Ref != "" {
+		return json.Marshal(componentRef{h.Ref})
+	}
+
+	type header Header
+	return json.Marshal(header(h))
+}
+
+// MarshalYAML writes the header, or only its reference if it references a component.
+func (h Header) MarshalYAML() (any, error) {
+	if h.Ref != "" {
+		return componentRef{h.Ref}, nil
+	}
+
+	type header Header
+	return header(h), nil
+}
+
+// MarshalJSON writes the response, or only its reference if it references a component.
+func (r Response) MarshalJSON() ([]byte, error) {
+	if r.Ref != "" {
+		return json.Marshal(componentRef{r.Ref})
+	}
+
+	type response Response
+	return json.Marshal(response(r))
+}
+
+// MarshalYAML writes the response, or only its reference if it references a component.
+func (r Response) MarshalYAML() (any, error) {
+	if r.Ref != "" {
+		return componentRef{r.Ref}, nil
+	}
+
+	type response Response
+	return response(r), nil
+}
+
+// reuseComponents lifts the parameters, responses and headers that are identical in at least the threshold of operations into the components.
+// The operations then reference them with $ref.
+// The headers are lifted first, so that responses with the same headers are still identical.
+func reuseComponents(output *OpenAPISchema, threshold int) {
+	if threshold < 2 {
+		return
+	}
+
+	operations := sortedOperations(output)
+	output.Components.Headers = reuseHeaders(operations, threshold, output.Components.Headers)
+	output.Components.Responses = reuseResponses(operations, threshold, output.Components.Responses)
+	output.Components.Parameters = reuseParameters(operations, threshold, output.Components.Parameters)
+}
+
+// sortedOperations returns the operations of the paths and webhooks, sorted by their paths and methods.
+func sortedOperations(output *OpenAPISchema) []*Operation {
+	var operations []*Operation
+	for _, paths := range []Paths{output.Paths, output.Webhooks} {
+		for _, pathName := range sortedKeys(paths) {
+			pathItem := paths[pathName]
+			for _, operation := range []*Operation{pathItem.Get, pathItem.Put, pathItem.Post, pathItem.Delete, pathItem.Options, pathItem.Head, pathItem.Patch, pathItem.Trace} {
+				if operation != nil {
+					operations = append(operations, operation)
+				}
+			}
+		}
+	}
+
+	return operations
+}
+
+// sortedKeys returns the keys of a map in order.
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// reuseHeaders lifts the response headers that are identical in at least the threshold of operations into the header components.
+// The header components are named after their header (i.e. X-Request-ID).
+func reuseHeaders(operations []*Operation, threshold int, components map[string]Header) map[string]Header {
+	headers := newReusable[Header]()
+	for i, operation := range operations {
+		for _, statusCode := range sortedKeys(operation.Responses) {
+			for headerName, header := range operation.Responses[statusCode].Headers {
+				if header.Ref == "" {
+					headers.add(i, headerName, header)
+				}
+			}
+		}
+	}
+
+	components, names := headers.lift(threshold, components)
+	for _, operation := range operations {
+		for _, response := range operation.Responses {
+			for headerName, header := range response.Headers {
+				if header.Ref != "" {
+					continue
+				}
+
+				key, _ := headers.key(headerName, header)
+				if name, ok := names[key]; ok {
+					response.Headers[headerName] = Header{Ref: "#/components/headers/" + name}
+				}
+			}
+		}
+	}
+
+	return components
+}
+
+// reuseResponses lifts the responses that are identical in at least the threshold of operations into the response components.
+func reuseResponses(operations []*Operation, threshold int, components map[string]Response) map[string]Response {
+	responses := newReusable[Response]()
+	for i, operation := range operations {
+		for _, statusCode := range sortedKeys(operation.Responses) {
+			response := operation.Responses[statusCode]
+			if response.Ref == "" {
+				responses.add(i, responseComponentName(statusCode, response), response)
+			}
+		}
+	}
+
+	components, names := responses.lift(threshold, components)
+	for _, operation := range operations {
+		for statusCode, response := range operation.Responses {
+			if response.Ref != "" {
+				continue
+			}
+
+			key, _ := responses.key(responseComponentName(statusCode, response), response)
+			if name, ok := names[key]; ok {
+				operation.Responses[statusCode] = Response{Ref: "#/components/responses/" + name}
+			}
+		}
+	}
+
+	return components
+}
+
+// reuseParameters lifts the parameters that are identical in at least the threshold of operations into the parameter components.
+// The parameter components are named after the parameter and where it is (i.e. LimitQuery).
+func reuseParameters(operations []*Operation, threshold int, components map[string]Parameter) map[string]Parameter {
+	parameters := newReusable[Parameter]()
+	for i, operation := range operations {
+		for _, parameter := range operation.Parameters {
+			if parameter.Ref == "" {
+				parameters.add(i, parameterComponentName(parameter), parameter)
+			}
+		}
+	}
+
+	components, names := parameters.lift(threshold, components)
+	for _, operation := range operations {
+		for i, parameter := range operation.Parameters {
+			if parameter.Ref != "" {
+				continue
+			}
+
+			key, _ := parameters.key(parameterComponentName(parameter), parameter)
+			if name, ok := names[key]; ok {
+				operation.Parameters[i] = Parameter{Ref: "#/components/parameters/" + name}
+			}
+		}
+	}
+
+	return components
+}
+
+// parameterComponentName names a parameter component by its name and where it is (i.e. LimitQuery).
+func parameterComponentName(parameter Parameter) string {
+	return strcase.ToCamel(parameter.Name) + strcase.ToCamel(parameter.In)
+}
+
+// responseComponentName names a response component by its status (i.e. NotFound), and the schema of its content if it only has one (i.e. NotFoundErrorResponse).
+func responseComponentName(statusCode string, response Response) string {
+	code, _ := strconv.Atoi(statusCode)
+	name := strcase.ToCamel(http.StatusText(code))
+	if name == "" {
+		name = "Status" + statusCode
+	}
+
+	if len(response.Content) == 1 {
+		for _, mediaType := range response.Content {
+			// The schema is named without its package (i.e. petstore.ErrorResponse is ErrorResponse)
+			if schemaName, ok := strings.CutPrefix(mediaType.Schema.Ref, "#/components/schemas/"); ok {
+				name += schemaName[strings.LastIndex(schemaName, ".")+1:]
+			}
+		}
+	}
+
+	return name
+}
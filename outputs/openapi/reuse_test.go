@@ -0,0 +1,159 @@
+package openapi
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+func TestReuseComponents(t *testing.T) {
+	newOutput := func() *OpenAPISchema {
+		limit := Parameter{Name: "limit", In: "query", Schema: Schema{Type: "integer"}}
+		requestID := Header{Schema: Schema{Type: "string"}}
+		badRequest := func() Response {
+			return Response{
+				Description: "Bad Request",
+				Headers:     map[string]Header{"X-Request-ID": requestID},
+				Content: map[string]MediaType{
+					"application/json": {Schema: Schema{Ref: "#/components/schemas/petstore.ErrorResponse"}},
+				},
+			}
+		}
+
+		return &OpenAPISchema{
+			Paths: Paths{
+				"/pets": {
+					Get: &Operation{
+						Parameters: []Parameter{limit},
+						Responses:  Responses{"400": badRequest()},
+					},
+				},
+				"/owners": {
+					Get: &Operation{
+						Parameters: []Parameter{limit, {Name: "name", In: "query", Schema: Schema{Type: "string"}}},
+						Responses:  Responses{"400": badRequest()},
+					},
+					Post: &Operation{
+						Parameters: []Parameter{{Name: "limit", In: "query", Schema: Schema{Type: "string"}}},
+						Responses:  Responses{"200": {Description: "OK"}},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("it doesn't lift anything below a threshold of 2", func(t *testing.T) {
+		output := newOutput()
+		reuseComponents(output, 1)
+
+		require.Empty(t, output.Components.Parameters)
+		require.Empty(t, output.Components.Responses)
+		require.Empty(t, output.Components.Headers)
+	})
+
+	t.Run("it lifts identical parameters, responses and headers", func(t *testing.T) {
+		output := newOutput()
+		reuseComponents(output, 2)
+
+		require.Equal(t, map[string]Parameter{
+			"LimitQuery": {Name: "limit", In: "query", Schema: Schema{Type: "integer"}},
+		}, output.Components.Parameters)
+		require.Equal(t, map[string]Header{
+			"X-Request-ID": {Schema: Schema{Type: "string"}},
+		}, output.Components.Headers)
+		require.Equal(t, map[string]Response{
+			"BadRequestErrorResponse": {
+				Description: "Bad Request",
+				Headers:     map[string]Header{"X-Request-ID": {Ref: "#/components/headers/X-Request-ID"}},
+				Content: map[string]MediaType{
+					"application/json": {Schema: Schema{Ref: "#/components/schemas/petstore.ErrorResponse"}},
+				},
+			},
+		}, output.Components.Responses)
+
+		for _, path := range []string{"/pets", "/owners"} {
+			operation := output.Paths[path].Get
+			require.Equal(t, Parameter{Ref: "#/components/parameters/LimitQuery"}, operation.Parameters[0])
+			require.Equal(t, Response{Ref: "#/components/responses/BadRequestErrorResponse"}, operation.Responses["400"])
+		}
+
+		// Parameters and responses in fewer operations than the threshold stay inline
+		require.Equal(t, "name", output.Paths["/owners"].Get.Parameters[1].Name)
+		require.Equal(t, "limit", output.Paths["/owners"].Post.Parameters[0].Name)
+		require.Equal(t, "OK", output.Paths["/owners"].Post.Responses["200"].Description)
+	})
+
+	t.Run("it names different components with the same name uniquely", func(t *testing.T) {
+		output := newOutput()
+		output.Paths["/stores"] = Path{
+			Post: &Operation{
+				Parameters: []Parameter{{Name: "limit", In: "query", Schema: Schema{Type: "string"}}},
+			},
+		}
+		reuseComponents(output, 2)
+
+		// The operations are in order of their paths, so the integer limit of /owners is found first
+		require.Equal(t, "integer", output.Components.Parameters["LimitQuery"].Schema.Type)
+		require.Equal(t, "string", output.Components.Parameters["LimitQuery_2"].Schema.Type)
+	})
+}
+
+func TestResponseComponentName(t *testing.T) {
+	require.Equal(t, "NotFound", responseComponentName("404", Response{}))
+	require.Equal(t, "Status299", responseComponentName("299", Response{}))
+	require.Equal(t, "BadRequestErrorResponse", responseComponentName("400", Response{
+		Content: map[string]MediaType{
+			"application/json": {Schema: Schema{Ref: "#/components/schemas/petstore.ErrorResponse"}},
+		},
+	}))
+}
+
+func TestParameterComponentName(t *testing.T) {
+	require.Equal(t, "LimitQuery", parameterComponentName(Parameter{Name: "limit", In: "query"}))
+	require.Equal(t, "XRequestIdHeader", parameterComponentName(Parameter{Name: "X-Request-ID", In: "header"}))
+}
+
+func TestComponentRefMarshalling(t *testing.T) {
+	t.Run("it only marshals the reference of a parameter", func(t *testing.T) {
+		bytes, err := json.Marshal(Parameter{Ref: "#/components/parameters/LimitQuery"})
+		require.NoError(t, err)
+		require.JSONEq(t, `{"$ref": "#/components/parameters/LimitQuery"}`, string(bytes))
+
+		out, err := yaml.Marshal(Parameter{Ref: "#/components/parameters/LimitQuery"})
+		require.NoError(t, err)
+		require.Equal(t, "$ref: '#/components/parameters/LimitQuery'\n", string(out))
+	})
+
+	t.Run("it marshals a parameter without a reference", func(t *testing.T) {
+		bytes, err := json.Marshal(Parameter{Name: "limit", In: "query", Schema: Schema{Type: "integer"}})
+		require.NoError(t, err)
+		require.JSONEq(t, `{"name": "limit", "in": "query", "schema": {"type": "integer"}}`, string(bytes))
+	})
+
+	t.Run("it only marshals the reference of a header", func(t *testing.T) {
+		bytes, err := json.Marshal(Header{Ref: "#/components/headers/X-Request-ID"})
+		require.NoError(t, err)
+		require.JSONEq(t, `{"$ref": "#/components/headers/X-Request-ID"}`, string(bytes))
+	})
+
+	t.Run("it only marshals the reference of a response", func(t *testing.T) {
+		bytes, err := json.Marshal(Response{Ref: "#/components/responses/NotFound"})
+		require.NoError(t, err)
+		require.JSONEq(t, `{"$ref": "#/components/responses/NotFound"}`, string(bytes))
+
+		out, err := yaml.Marshal(Response{Ref: "#/components/responses/NotFound"})
+		require.NoError(t, err)
+		require.Equal(t, "$ref: '#/components/responses/NotFound'\n", string(out))
+	})
+
+	t.Run("it marshals the description of a response without one", func(t *testing.T) {
+		bytes, err := json.Marshal(Response{})
+		require.NoError(t, err)
+		require.JSONEq(t, `{"description": ""}`, string(bytes))
+
+		out, err := yaml.Marshal(Response{})
+		require.NoError(t, err)
+		require.Equal(t, "description: \"\"\n", string(out))
+	})
+}
@@ -1,6 +1,10 @@
 package openapi
 
 import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
 	"github.com/ls6-events/astra"
 	"github.com/ls6-events/astra/astTraversal"
 )
@@ -36,11 +40,46 @@ func mapParamToSchema(bindingType astTraversal.BindingTagType, param astra.Param
 			Type:                 "object",
 			AdditionalProperties: &additionalProperties,
 		}, true
+	} else if param.Field.Package != "" && !astra.IsAcceptedType(param.Field.Type) {
+		// Named types such as time.Time or uuid.UUID are predefined by their fully qualified name
+		if schema := mapPredefinedTypeFormat(param.Field.Package + "." + param.Field.Type); schema.Type != "" {
+			return schema, true
+		}
+
+		componentRef, bound := makeComponentRef(bindingType, param.Field.Type, param.Field.Package)
+		if bound {
+			return Schema{
+				Ref: componentRef,
+			}, true
+		}
+
+		return Schema{Type: "string"}, true
 	} else {
 		return mapPredefinedTypeFormat(param.Field.Type), true
 	}
 }
 
+func mapBodyParamToSchema(bindingType astTraversal.BindingTagType, bodyParam astra.BodyParam) (Schema, bool) {
+	schema, bound := mapFieldToSchema(bindingType, bodyParam.Field)
+	if !bound {
+		return Schema{}, false
+	}
+
+	if bodyParam.IsArray {
+		return Schema{
+			Type:  "array",
+			Items: &schema,
+		}, true
+	} else if bodyParam.IsMap {
+		return Schema{
+			Type:                 "object",
+			AdditionalProperties: &schema,
+		}, true
+	}
+
+	return schema, true
+}
+
 func mapFieldToSchema(bindingType astTraversal.BindingTagType, field astra.Field) (Schema, bool) {
 	if field.Type == "struct" && len(field.StructFields) > 0 {
 		if schema, ok := mapInlineStructToSchema(bindingType, field); ok {
@@ -110,7 +149,8 @@ func mapInlineStructToSchema(bindingType astTraversal.BindingTagType, field astr
 		if !fieldBinding.NotShown {
 			fieldSchema, fieldBound := mapFieldToSchema(bindingType, structField)
 			if fieldBound {
-				schema.Properties[fieldBinding.Name] = ensureSchema(fieldSchema)
+				fieldSchema = applyFieldSchemaProperties(ensureSchema(fieldSchema), structField)
+				schema.Properties[fieldBinding.Name] = fieldSchema
 			}
 		}
 	}
@@ -129,6 +169,55 @@ func mapInlineStructToSchema(bindingType astTraversal.BindingTagType, field astr
 	return schema, true
 }
 
+// isRequiredField returns whether a struct field is required by any of its validation tags (i.e. binding:"required" or validate:"required").
+func isRequiredField(field astra.Field) bool {
+	for _, validationTag := range field.StructFieldValidationTags {
+		if validationTag.IsRequired {
+			return true
+		}
+	}
+
+	return false
+}
+
+// applyFieldSchemaProperties adds the properties of a struct field that aren't part of its type to its schema.
+// A pointer field is nullable (only written in OpenAPI 3.1), the example tag of a field is its example, and its x- tags are its extensions.
+func applyFieldSchemaProperties(schema Schema, field astra.Field) Schema {
+	schema.Nullable = field.IsNullable
+	if field.Example != "" {
+		schema.Example = parseExample(schema, field.Example)
+	}
+	schema.Extensions = mergeExtensions(schema.Extensions, field.Extensions)
+
+	return schema
+}
+
+// parseExample parses an example by the type of its schema, so it is written as the type (i.e. 3 rather than "3" for an integer).
+// An example that can't be parsed as the type is written as it is.
+func parseExample(schema Schema, example string) any {
+	switch schema.Type {
+	case "integer":
+		if i, err := strconv.ParseInt(example, 10, 64); err == nil {
+			return i
+		}
+	case "number":
+		if f, err := strconv.ParseFloat(example, 64); err == nil {
+			return f
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(example); err == nil {
+			return b
+		}
+	case "array", "object":
+		var value any
+		if err := json.Unmarshal([]byte(example), &value); err == nil {
+			return value
+		}
+	}
+
+	return example
+}
+
 func ensureSchema(schema Schema) Schema {
 	if isSchemaEmpty(schema) {
 		return Schema{Type: "string"}
@@ -240,6 +329,36 @@ func getQueryParamStyle(schema Schema) (style string, explode bool) {
 	return "form", false
 }
 
+// isFileSchema checks whether the schema describes an uploaded file, or a collection of them.
+func isFileSchema(schema Schema) bool {
+	if schema.Items != nil {
+		return isFileSchema(*schema.Items)
+	} else if schema.AdditionalProperties != nil {
+		return isFileSchema(*schema.AdditionalProperties)
+	}
+
+	return schema.Type == "string" && schema.Format == "binary"
+}
+
+// mapMultipartEncoding maps the file properties of a multipart request body to the content type of their parts.
+func mapMultipartEncoding(properties map[string]Schema) map[string]Encoding {
+	var encoding map[string]Encoding
+	for name, property := range properties {
+		if !isFileSchema(property) {
+			continue
+		}
+
+		if encoding == nil {
+			encoding = make(map[string]Encoding)
+		}
+		encoding[name] = Encoding{
+			ContentType: "application/octet-stream",
+		}
+	}
+
+	return encoding
+}
+
 // findComponentByPackageAndType finds the schema by the package and type.
 func findComponentByPackageAndType(fields []astra.Field, pkg string, typeName string) (astra.Field, bool) {
 	for _, field := range fields {
@@ -250,3 +369,105 @@ func findComponentByPackageAndType(fields []astra.Field, pkg string, typeName st
 
 	return astra.Field{}, false
 }
+
+// validationRuleFormats maps the validation rules that describe a string format to their OpenAPI format.
+var validationRuleFormats = map[string]string{
+	"email":    "email",
+	"uuid":     "uuid",
+	"uuid3":    "uuid",
+	"uuid4":    "uuid",
+	"uuid5":    "uuid",
+	"url":      "uri",
+	"uri":      "uri",
+	"hostname": "hostname",
+	"ipv4":     "ipv4",
+	"ipv6":     "ipv6",
+	"datetime": "date-time",
+}
+
+// applyValidationRules adds the constraints described by the validation rules of a field (binding/validate tags) to its schema.
+// The length rules (min, max, len) apply to the value for numbers, to the length for strings and to the number of items for arrays.
+// Lengths are whole, so the exclusive rules (gt, lt) are the inclusive bounds next to them for strings and arrays (i.e. gt=2 is a minimum length of 3).
+func applyValidationRules(schema Schema, rules map[string]string) Schema {
+	// A reference can't be combined with sibling keywords in OpenAPI 3.0
+	if schema.Ref != "" {
+		return schema
+	}
+
+	for name, value := range rules {
+		switch name {
+		case "min", "gte", "gt":
+			number, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			length := int(number)
+			if name == "gt" {
+				length++
+			}
+			switch schema.Type {
+			case "integer", "number":
+				schema.Minimum = number
+				schema.ExclusiveMinimum = name == "gt"
+			case "string":
+				schema.MinLength = length
+			case "array":
+				schema.MinItems = length
+			}
+		case "max", "lte", "lt":
+			number, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			length := int(number)
+			if name == "lt" {
+				length--
+			}
+			switch schema.Type {
+			case "integer", "number":
+				schema.Maximum = number
+				schema.ExclusiveMaximum = name == "lt"
+			case "string":
+				schema.MaxLength = length
+			case "array":
+				schema.MaxItems = length
+			}
+		case "len":
+			length, err := strconv.Atoi(value)
+			if err != nil {
+				continue
+			}
+			switch schema.Type {
+			case "string":
+				schema.MinLength = length
+				schema.MaxLength = length
+			case "array":
+				schema.MinItems = length
+				schema.MaxItems = length
+			}
+		case "oneof":
+			schema.Enum = nil
+			for _, option := range strings.Fields(value) {
+				switch schema.Type {
+				case "integer":
+					if i, err := strconv.Atoi(option); err == nil {
+						schema.Enum = append(schema.Enum, i)
+					}
+				case "number":
+					if f, err := strconv.ParseFloat(option, 64); err == nil {
+						schema.Enum = append(schema.Enum, f)
+					}
+				default:
+					schema.Enum = append(schema.Enum, option)
+				}
+			}
+		default:
+			if format, ok := validationRuleFormats[name]; ok && (schema.Type == "string" || schema.Type == "") {
+				schema.Type = "string"
+				schema.Format = format
+			}
+		}
+	}
+
+	return schema
+}
@@ -1,6 +1,8 @@
 package openapi
 
 import (
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -45,3 +47,134 @@ func TestGetQueryParamStyle(t *testing.T) {
 		})
 	})
 }
+
+func TestMapParamToSchema(t *testing.T) {
+	t.Run("it maps predefined named types by their qualified name", func(t *testing.T) {
+		schema, bound := mapParamToSchema("", astra.Param{
+			Field: astra.Field{
+				Type:    "UUID",
+				Package: "github.com/google/uuid",
+			},
+		})
+
+		require.True(t, bound)
+		require.Equal(t, Schema{Type: "string", Format: "uuid"}, schema)
+	})
+
+	t.Run("it maps primitive types", func(t *testing.T) {
+		schema, bound := mapParamToSchema("", astra.Param{
+			Field: astra.Field{
+				Type: "int64",
+			},
+		})
+
+		require.True(t, bound)
+		require.Equal(t, Schema{Type: "integer", Format: "int64"}, schema)
+	})
+}
+
+func TestIsRequiredField(t *testing.T) {
+	require.True(t, isRequiredField(astra.Field{StructFieldValidationTags: astTraversal.ValidationTagMap{
+		astTraversal.ValidatorValidationTag: {IsRequired: true},
+	}}))
+	require.False(t, isRequiredField(astra.Field{StructFieldValidationTags: astTraversal.ValidationTagMap{
+		astTraversal.GinValidationTag: {Rules: map[string]string{"min": "1"}},
+	}}))
+	require.False(t, isRequiredField(astra.Field{}))
+}
+
+func TestApplyValidationRules(t *testing.T) {
+	t.Run("it applies numeric bounds", func(t *testing.T) {
+		schema := applyValidationRules(Schema{Type: "integer"}, map[string]string{"gt": "1", "lte": "10"})
+
+		require.Equal(t, float64(1), schema.Minimum)
+		require.True(t, schema.ExclusiveMinimum)
+		require.Equal(t, float64(10), schema.Maximum)
+		require.False(t, schema.ExclusiveMaximum)
+	})
+
+	t.Run("it applies length bounds", func(t *testing.T) {
+		schema := applyValidationRules(Schema{Type: "string"}, map[string]string{"min": "2", "max": "5"})
+		require.Equal(t, 2, schema.MinLength)
+		require.Equal(t, 5, schema.MaxLength)
+
+		schema = applyValidationRules(Schema{Type: "array"}, map[string]string{"len": "3"})
+		require.Equal(t, 3, schema.MinItems)
+		require.Equal(t, 3, schema.MaxItems)
+	})
+
+	t.Run("it applies exclusive length bounds as inclusive ones", func(t *testing.T) {
+		schema := applyValidationRules(Schema{Type: "string"}, map[string]string{"gt": "2", "lt": "5"})
+		require.Equal(t, 3, schema.MinLength)
+		require.Equal(t, 4, schema.MaxLength)
+
+		schema = applyValidationRules(Schema{Type: "array"}, map[string]string{"gt": "0", "lt": "10"})
+		require.Equal(t, 1, schema.MinItems)
+		require.Equal(t, 9, schema.MaxItems)
+	})
+
+	t.Run("it applies enums and formats", func(t *testing.T) {
+		schema := applyValidationRules(Schema{Type: "integer"}, map[string]string{"oneof": "1 2 3"})
+		require.Equal(t, []any{1, 2, 3}, schema.Enum)
+
+		schema = applyValidationRules(Schema{Type: "string"}, map[string]string{"email": ""})
+		require.Equal(t, "email", schema.Format)
+	})
+
+	t.Run("it leaves references untouched", func(t *testing.T) {
+		schema := applyValidationRules(Schema{Ref: "#/components/schemas/Pet"}, map[string]string{"uuid": ""})
+		require.Equal(t, Schema{Ref: "#/components/schemas/Pet"}, schema)
+	})
+}
+
+func TestMapBodyParamToSchema(t *testing.T) {
+	t.Run("it maps a multi file part to an array", func(t *testing.T) {
+		schema, bound := mapBodyParamToSchema(astTraversal.FormBindingTag, astra.BodyParam{Name: "photos", Field: astra.Field{Type: "file"}, IsArray: true})
+		require.True(t, bound)
+		require.Equal(t, "array", schema.Type)
+		require.Equal(t, Schema{Type: "string", Format: "binary"}, *schema.Items)
+	})
+
+	t.Run("it maps a map of values to an object", func(t *testing.T) {
+		schema, bound := mapBodyParamToSchema(astTraversal.FormBindingTag, astra.BodyParam{Name: "labels", Field: astra.Field{Type: "string"}, IsMap: true})
+		require.True(t, bound)
+		require.Equal(t, "object", schema.Type)
+		require.Equal(t, Schema{Type: "string"}, *schema.AdditionalProperties)
+	})
+}
+
+func TestMapMultipartEncoding(t *testing.T) {
+	t.Run("it encodes the file parts", func(t *testing.T) {
+		file := Schema{Type: "string", Format: "binary"}
+		encoding := mapMultipartEncoding(map[string]Schema{
+			"name":   {Type: "string"},
+			"cover":  file,
+			"photos": {Type: "array", Items: &file},
+		})
+
+		require.Equal(t, map[string]Encoding{
+			"cover":  {ContentType: "application/octet-stream"},
+			"photos": {ContentType: "application/octet-stream"},
+		}, encoding)
+	})
+
+	t.Run("it returns nil without file parts", func(t *testing.T) {
+		require.Nil(t, mapMultipartEncoding(map[string]Schema{"name": {Type: "string"}}))
+	})
+}
+
+func TestParseExample(t *testing.T) {
+	require.Equal(t, int64(3), parseExample(Schema{Type: "integer"}, "3"))
+	require.Equal(t, 1.5, parseExample(Schema{Type: "number"}, "1.5"))
+	require.Equal(t, true, parseExample(Schema{Type: "boolean"}, "true"))
+	require.Equal(t, []any{"a", "b"}, parseExample(Schema{Type: "array"}, `["a", "b"]`))
+	require.Equal(t, "Rex", parseExample(Schema{Type: "string"}, "Rex"))
+	// Examples that aren't the type of their schema are kept as they are written
+	require.Equal(t, "three", parseExample(Schema{Type: "integer"}, "three"))
+}
+
+func TestApplyFieldSchemaProperties(t *testing.T) {
+	schema := applyFieldSchemaProperties(Schema{Type: "integer"}, astra.Field{IsNullable: true, Example: "3"})
+	require.True(t, schema.Nullable)
+	require.Equal(t, int64(3), schema.Example)
+}
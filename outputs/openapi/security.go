@@ -0,0 +1,55 @@
+package openapi
+
+import "github.com/ls6-events/astra"
+
+// mapSecuritySchemes maps the security schemes of the config to the OpenAPI security schemes, by their names.
+func mapSecuritySchemes(securitySchemes map[string]astra.SecurityScheme) map[string]SecurityScheme {
+	if len(securitySchemes) == 0 {
+		return nil
+	}
+
+	schemes := make(map[string]SecurityScheme, len(securitySchemes))
+	for name, securityScheme := range securitySchemes {
+		scheme := SecurityScheme{
+			Type:             string(securityScheme.Type),
+			Description:      securityScheme.Description,
+			Name:             securityScheme.Name,
+			In:               string(securityScheme.In),
+			Scheme:           securityScheme.Scheme,
+			BearerFormat:     securityScheme.BearerFormat,
+			OpenIDConnectURL: securityScheme.OpenIDConnectURL,
+		}
+		if securityScheme.Flows != nil {
+			scheme.Flows = &OAuthFlows{
+				Implicit:          mapOAuthFlow(securityScheme.Flows.Implicit),
+				Password:          mapOAuthFlow(securityScheme.Flows.Password),
+				ClientCredentials: mapOAuthFlow(securityScheme.Flows.ClientCredentials),
+				AuthorizationCode: mapOAuthFlow(securityScheme.Flows.AuthorizationCode),
+			}
+		}
+
+		schemes[name] = scheme
+	}
+
+	return schemes
+}
+
+// mapOAuthFlow maps an OAuth2 flow of a security scheme to the OpenAPI OAuth flow, if the scheme supports it.
+// The scopes are always set, as OpenAPI requires them even if they are empty.
+func mapOAuthFlow(flow *astra.OAuthFlow) *OAuthFlow {
+	if flow == nil {
+		return nil
+	}
+
+	scopes := flow.Scopes
+	if scopes == nil {
+		scopes = make(map[string]string)
+	}
+
+	return &OAuthFlow{
+		AuthorizationURL: flow.AuthorizationURL,
+		TokenURL:         flow.TokenURL,
+		RefreshURL:       flow.RefreshURL,
+		Scopes:           scopes,
+	}
+}
@@ -0,0 +1,36 @@
+package openapi
+
+import (
+	"github.com/ls6-events/astra"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestMapSecuritySchemes(t *testing.T) {
+	t.Run("it returns nil for no security schemes", func(t *testing.T) {
+		require.Nil(t, mapSecuritySchemes(nil))
+	})
+
+	t.Run("it maps every type of security scheme", func(t *testing.T) {
+		schemes := mapSecuritySchemes(map[string]astra.SecurityScheme{
+			"bearerAuth": astra.NewBearerSecurityScheme("JWT"),
+			"basicAuth":  astra.NewBasicSecurityScheme(),
+			"apiKey":     astra.NewAPIKeySecurityScheme(astra.APIKeyInQuery, "api_key"),
+			"oauth2": astra.NewOAuth2SecurityScheme(astra.OAuthFlows{
+				ClientCredentials: &astra.OAuthFlow{
+					TokenURL: "https://example.com/token",
+				},
+			}),
+		})
+
+		require.Equal(t, SecurityScheme{Type: "http", Scheme: "bearer", BearerFormat: "JWT"}, schemes["bearerAuth"])
+		require.Equal(t, SecurityScheme{Type: "http", Scheme: "basic"}, schemes["basicAuth"])
+		require.Equal(t, SecurityScheme{Type: "apiKey", In: "query", Name: "api_key"}, schemes["apiKey"])
+
+		require.Equal(t, "oauth2", schemes["oauth2"].Type)
+		require.Nil(t, schemes["oauth2"].Flows.Implicit)
+		require.Equal(t, "https://example.com/token", schemes["oauth2"].Flows.ClientCredentials.TokenURL)
+		// OpenAPI requires the scopes of a flow, even if there are none
+		require.NotNil(t, schemes["oauth2"].Flows.ClientCredentials.Scopes)
+	})
+}
@@ -0,0 +1,43 @@
+package openapi
+
+import (
+	"fmt"
+
+	"github.com/ls6-events/astra"
+)
+
+// mapServers maps the servers of the config to the OpenAPI servers.
+// If the config has no servers, the server is made from its host, port, secure and base path fields.
+func mapServers(config *astra.Config) []Server {
+	if len(config.Servers) == 0 {
+		protocol := "http"
+		if config.Secure {
+			protocol += "s"
+		}
+
+		return []Server{
+			{
+				URL: fmt.Sprintf("%s://%s:%d%s", protocol, config.Host, config.Port, config.BasePath),
+			},
+		}
+	}
+
+	servers := make([]Server, 0, len(config.Servers))
+	for _, server := range config.Servers {
+		var variables map[string]ServerVariable
+		if len(server.Variables) > 0 {
+			variables = make(map[string]ServerVariable, len(server.Variables))
+			for name, variable := range server.Variables {
+				variables[name] = ServerVariable(variable)
+			}
+		}
+
+		servers = append(servers, Server{
+			URL:         server.URL,
+			Description: server.Description,
+			Variables:   variables,
+		})
+	}
+
+	return servers
+}
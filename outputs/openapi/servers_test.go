@@ -0,0 +1,50 @@
+package openapi
+
+import (
+	"github.com/ls6-events/astra"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestMapServers(t *testing.T) {
+	t.Run("it maps the host and port if there are no servers", func(t *testing.T) {
+		servers := mapServers(&astra.Config{
+			Secure:   true,
+			Host:     "localhost",
+			Port:     8000,
+			BasePath: "/api",
+		})
+
+		require.Equal(t, []Server{{URL: "https://localhost:8000/api"}}, servers)
+	})
+
+	t.Run("it maps the servers with their variables", func(t *testing.T) {
+		servers := mapServers(&astra.Config{
+			Host: "localhost",
+			Port: 8000,
+			Servers: []astra.Server{
+				{URL: "http://localhost:8000", Description: "Local"},
+				{
+					URL:         "https://{environment}.example.com{basePath}",
+					Description: "Deployed",
+					Variables: map[string]astra.ServerVariable{
+						"environment": {Enum: []string{"staging", "api"}, Default: "api", Description: "The environment"},
+						"basePath":    {Default: "/v1"},
+					},
+				},
+			},
+		})
+
+		require.Equal(t, []Server{
+			{URL: "http://localhost:8000", Description: "Local"},
+			{
+				URL:         "https://{environment}.example.com{basePath}",
+				Description: "Deployed",
+				Variables: map[string]ServerVariable{
+					"environment": {Enum: []string{"staging", "api"}, Default: "api", Description: "The environment"},
+					"basePath":    {Default: "/v1"},
+				},
+			},
+		}, servers)
+	})
+}
@@ -0,0 +1,225 @@
+package openapi
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/ls6-events/astra"
+)
+
+// SplitStrategy is how the paths of a split OpenAPI specification are split into files.
+type SplitStrategy string
+
+const (
+	SplitByPath SplitStrategy = "path" // A file for every path (i.e. paths/pets_id.yaml for /pets/{id}), the default.
+	SplitByTag  SplitStrategy = "tag"  // A file for every tag, with the paths whose operations have the tag first (i.e. paths/pets.yaml).
+)
+
+const (
+	// tempSplitOutputDir is the temporary directory in the .astra directory that the split specification is written to.
+	tempSplitOutputDir = "openapi"
+	// splitRootFile is the file of the split specification that references the others.
+	splitRootFile = "openapi.yaml"
+	// splitPathsDir is the directory of the files of the paths of the split specification.
+	splitPathsDir = "paths"
+	// splitSchemasDir is the directory of the files of the schemas of the split specification.
+	splitSchemasDir = "schemas"
+	// untaggedPathsFile is the name of the file of the paths without tags, when they are split by tag.
+	untaggedPathsFile = "default"
+
+	schemaRefPrefix    = "#/components/schemas/"
+	componentRefPrefix = "#/components/"
+)
+
+// invalidFileNameCharacters are the characters that aren't kept in the names of the split files.
+var invalidFileNameCharacters = regexp.MustCompile(`[^a-zA-Z0-9.\-_]+`)
+
+// GenerateSplit generates the OpenAPI output split into many YAML files in the directory.
+// The specification is written to openapi.yaml, which references the paths in paths/*.yaml and the schemas in schemas/*.yaml with relative $refs.
+// The paths are split by path or tag (i.e. WithSplitStrategy(SplitByTag)).
+func GenerateSplit(directoryPath string, options Options) astra.ServiceFunction {
+	return generateOutput(options, func(s *astra.Service, output OpenAPISchema) error {
+		s.Log.Debug().Str("splitStrategy", string(options.SplitStrategy)).Msg("Splitting OpenAPI schema")
+		files := splitOutput(output, options.SplitStrategy)
+
+		tempOutputDirectoryPath, err := s.SetupTempOutputDir(tempSplitOutputDir)
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to create directory")
+			return err
+		}
+
+		for _, dir := range []string{splitPathsDir, splitSchemasDir} {
+			err := os.Mkdir(path.Join(tempOutputDirectoryPath, dir), 0755)
+			if err != nil {
+				s.Log.Error().Err(err).Msg("Failed to create directory")
+				return err
+			}
+		}
+
+		for filePath, contents := range files {
+			file, err := marshalYAML(contents)
+			if err != nil {
+				s.Log.Error().Err(err).Str("filePath", filePath).Msg("Failed to marshal OpenAPI schema")
+				return err
+			}
+
+			err = os.WriteFile(path.Join(tempOutputDirectoryPath, filePath), file, 0644)
+			if err != nil {
+				s.Log.Error().Err(err).Str("filePath", filePath).Msg("Failed to write OpenAPI schema file")
+				return err
+			}
+		}
+
+		err = s.MoveTempOutputDir(tempSplitOutputDir, directoryPath)
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to move temporary output directory to final location")
+			return err
+		}
+
+		s.Log.Debug().Str("directoryPath", directoryPath).Msg("Successfully generated split OpenAPI schema files")
+
+		return nil
+	})
+}
+
+// splitOutput splits the OpenAPI specification into the contents of its files, by their paths relative to the output directory.
+// The references to the schemas and other components are rewritten to be relative to the file they are in.
+func splitOutput(output OpenAPISchema, strategy SplitStrategy) map[string]any {
+	files := make(map[string]any)
+
+	// The schemas are in the same directory as each other, and the other components are in the root file
+	schemaFileNames := make(map[string]string)
+	for _, name := range sortedKeys(output.Components.Schemas) {
+		schemaFileNames[name] = uniqueFileName(schemaFileNames, name)
+	}
+	schemaRef := func(prefix string) func(Schema) Schema {
+		return func(schema Schema) Schema {
+			if name, ok := strings.CutPrefix(schema.Ref, schemaRefPrefix); ok {
+				schema.Ref = prefix + schemaFileNames[name] + ".yaml"
+			}
+
+			return schema
+		}
+	}
+
+	splitSchemas := make(map[string]Schema, len(output.Components.Schemas))
+	for name, schema := range output.Components.Schemas {
+		files[path.Join(splitSchemasDir, schemaFileNames[name]+".yaml")] = mapSchema(schema, schemaRef(""))
+		splitSchemas[name] = Schema{Ref: path.Join(splitSchemasDir, schemaFileNames[name]+".yaml")}
+	}
+
+	pathFileNames := make(map[string]string)
+	pathFiles := make(map[string]Paths)
+	splitPaths := make(Paths, len(output.Paths))
+	for _, pathName := range sortedKeys(output.Paths) {
+		pathItem := output.Paths[pathName]
+
+		// The path item is moved to its own specification, so its references can be rewritten like those of the root
+		pathOutput := OpenAPISchema{Paths: Paths{pathName: pathItem}}
+		mapSchemas(&pathOutput, schemaRef("../"+splitSchemasDir+"/"))
+		mapComponentRefs(pathItem, "../"+splitRootFile)
+
+		if strategy == SplitByTag {
+			fileName, ok := pathFileNames[pathTag(pathItem)]
+			if !ok {
+				fileName = uniqueFileName(pathFileNames, pathTag(pathItem))
+				pathFileNames[pathTag(pathItem)] = fileName
+				pathFiles[fileName] = make(Paths)
+			}
+			pathFiles[fileName][pathName] = pathItem
+
+			// The path item is found in the file of the tag by its JSON pointer, in which slashes are escaped
+			pointer := strings.ReplaceAll(strings.ReplaceAll(pathName, "~", "~0"), "/", "~1")
+			splitPaths[pathName] = Path{Ref: path.Join(splitPathsDir, fileName+".yaml") + "#/" + url.PathEscape(pointer)}
+		} else {
+			fileName := uniqueFileName(pathFileNames, pathName)
+			pathFileNames[pathName] = fileName
+			files[path.Join(splitPathsDir, fileName+".yaml")] = pathItem
+			splitPaths[pathName] = Path{Ref: path.Join(splitPathsDir, fileName+".yaml")}
+		}
+	}
+	for fileName, paths := range pathFiles {
+		files[path.Join(splitPathsDir, fileName+".yaml")] = paths
+	}
+
+	output.Paths = splitPaths
+	output.Components.Schemas = splitSchemas
+	mapSchemas(&output, schemaRef(splitSchemasDir+"/"))
+	files[splitRootFile] = output
+
+	return files
+}
+
+// mapComponentRefs rewrites the references to the components in the root file (i.e. parameters and responses) in a path item.
+// The path item is in another file, so they reference the components of the root file by its path.
+func mapComponentRefs(pathItem Path, rootFile string) {
+	mapRef := func(ref string) string {
+		if strings.HasPrefix(ref, componentRefPrefix) {
+			return rootFile + ref
+		}
+
+		return ref
+	}
+	mapHeaderRefs := func(headers map[string]Header) {
+		for name, header := range headers {
+			header.Ref = mapRef(header.Ref)
+			headers[name] = header
+		}
+	}
+
+	for i := range pathItem.Parameters {
+		pathItem.Parameters[i].Ref = mapRef(pathItem.Parameters[i].Ref)
+	}
+	for _, operation := range []*Operation{pathItem.Get, pathItem.Put, pathItem.Post, pathItem.Delete, pathItem.Options, pathItem.Head, pathItem.Patch, pathItem.Trace} {
+		if operation == nil {
+			continue
+		}
+
+		for i := range operation.Parameters {
+			operation.Parameters[i].Ref = mapRef(operation.Parameters[i].Ref)
+		}
+		if operation.RequestBody != nil {
+			operation.RequestBody.Ref = mapRef(operation.RequestBody.Ref)
+		}
+		for status, response := range operation.Responses {
+			response.Ref = mapRef(response.Ref)
+			mapHeaderRefs(response.Headers)
+			operation.Responses[status] = response
+		}
+	}
+}
+
+// pathTag returns the first tag of the first operation of a path item that has tags, which is the tag it is split by.
+func pathTag(pathItem Path) string {
+	for _, operation := range []*Operation{pathItem.Get, pathItem.Put, pathItem.Post, pathItem.Delete, pathItem.Options, pathItem.Head, pathItem.Patch, pathItem.Trace} {
+		if operation != nil && len(operation.Tags) > 0 {
+			return operation.Tags[0]
+		}
+	}
+
+	return untaggedPathsFile
+}
+
+// uniqueFileName makes a file name from a name (i.e. pets_id for /pets/{id}) that isn't already one of the file names.
+func uniqueFileName(fileNames map[string]string, name string) string {
+	fileName := strings.Trim(invalidFileNameCharacters.ReplaceAllString(name, "_"), "_")
+	if fileName == "" {
+		fileName = "root"
+	}
+
+	taken := make(map[string]bool, len(fileNames))
+	for _, existing := range fileNames {
+		taken[existing] = true
+	}
+
+	uniqueName := fileName
+	for i := 2; taken[uniqueName]; i++ {
+		uniqueName = fmt.Sprintf("%s_%d", fileName, i)
+	}
+
+	return uniqueName
+}
@@ -0,0 +1,114 @@
+package openapi
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSplitOutput(t *testing.T) {
+	newOutput := func() OpenAPISchema {
+		return OpenAPISchema{
+			Paths: Paths{
+				"/pets": {
+					Get: &Operation{
+						Tags:       []string{"pets"},
+						Parameters: []Parameter{{Ref: "#/components/parameters/LimitQuery"}},
+						Responses: Responses{
+							"200": {
+								Description: "OK",
+								Content: map[string]MediaType{
+									"application/json": {Schema: Schema{Type: "array", Items: &Schema{Ref: "#/components/schemas/Pet"}}},
+								},
+							},
+						},
+					},
+				},
+				"/pets/{id}": {
+					Get: &Operation{
+						Tags:      []string{"pets"},
+						Responses: Responses{"404": {Ref: "#/components/responses/NotFound"}},
+					},
+				},
+				"/health": {
+					Get: &Operation{},
+				},
+			},
+			Components: Components{
+				Schemas: map[string]Schema{
+					"Pet":   {Type: "object", Properties: map[string]Schema{"owner": {Ref: "#/components/schemas/Owner"}}},
+					"Owner": {Type: "object"},
+				},
+				Parameters: map[string]Parameter{
+					"LimitQuery": {Name: "limit", In: "query", Schema: Schema{Type: "integer"}},
+				},
+				Responses: map[string]Response{
+					"NotFound": {
+						Description: "Not Found",
+						Content: map[string]MediaType{
+							"application/json": {Schema: Schema{Ref: "#/components/schemas/Owner"}},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("it splits the schemas into files", func(t *testing.T) {
+		files := splitOutput(newOutput(), SplitByPath)
+
+		root := files["openapi.yaml"].(OpenAPISchema)
+		require.Equal(t, map[string]Schema{
+			"Pet":   {Ref: "schemas/Pet.yaml"},
+			"Owner": {Ref: "schemas/Owner.yaml"},
+		}, root.Components.Schemas)
+		require.Equal(t, Schema{Type: "object", Properties: map[string]Schema{"owner": {Ref: "Owner.yaml"}}}, files["schemas/Pet.yaml"])
+		require.Equal(t, Schema{Type: "object"}, files["schemas/Owner.yaml"])
+
+		// The other components stay in the root file
+		require.Equal(t, "schemas/Owner.yaml", root.Components.Responses["NotFound"].Content["application/json"].Schema.Ref)
+		require.Equal(t, "limit", root.Components.Parameters["LimitQuery"].Name)
+	})
+
+	t.Run("it splits the paths by path", func(t *testing.T) {
+		files := splitOutput(newOutput(), SplitByPath)
+
+		root := files["openapi.yaml"].(OpenAPISchema)
+		require.Equal(t, Paths{
+			"/pets":      {Ref: "paths/pets.yaml"},
+			"/pets/{id}": {Ref: "paths/pets_id.yaml"},
+			"/health":    {Ref: "paths/health.yaml"},
+		}, root.Paths)
+
+		pets := files["paths/pets.yaml"].(Path)
+		require.Equal(t, "../openapi.yaml#/components/parameters/LimitQuery", pets.Get.Parameters[0].Ref)
+		require.Equal(t, "../schemas/Pet.yaml", pets.Get.Responses["200"].Content["application/json"].Schema.Items.Ref)
+
+		pet := files["paths/pets_id.yaml"].(Path)
+		require.Equal(t, "../openapi.yaml#/components/responses/NotFound", pet.Get.Responses["404"].Ref)
+	})
+
+	t.Run("it splits the paths by tag", func(t *testing.T) {
+		files := splitOutput(newOutput(), SplitByTag)
+
+		root := files["openapi.yaml"].(OpenAPISchema)
+		require.Equal(t, Paths{
+			"/pets":      {Ref: "paths/pets.yaml#/~1pets"},
+			"/pets/{id}": {Ref: "paths/pets.yaml#/~1pets~1%7Bid%7D"},
+			"/health":    {Ref: "paths/default.yaml#/~1health"},
+		}, root.Paths)
+
+		pets := files["paths/pets.yaml"].(Paths)
+		require.Len(t, pets, 2)
+		require.Equal(t, "../schemas/Pet.yaml", pets["/pets"].Get.Responses["200"].Content["application/json"].Schema.Items.Ref)
+		require.Contains(t, files["paths/default.yaml"].(Paths), "/health")
+	})
+}
+
+func TestUniqueFileName(t *testing.T) {
+	fileNames := map[string]string{"/pets": "pets"}
+
+	require.Equal(t, "pets_id", uniqueFileName(fileNames, "/pets/{id}"))
+	require.Equal(t, "pets_2", uniqueFileName(fileNames, "/pets/"))
+	require.Equal(t, "root", uniqueFileName(fileNames, "/"))
+	require.Equal(t, "petstore.Pet", uniqueFileName(fileNames, "petstore.Pet"))
+}
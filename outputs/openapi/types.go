@@ -22,6 +22,8 @@ type Info struct {
 	Contact        Contact `json:"contact" yaml:"contact"`
 	License        License `json:"license" yaml:"license"`
 	Version        string  `json:"version" yaml:"version"`
+
+	Extensions map[string]any `json:"-" yaml:",inline"` // the vendor extensions, written by MarshalJSON.
 }
 
 // Contact is the OpenAPI contact.
@@ -38,8 +40,16 @@ type License struct {
 }
 
 type Server struct {
-	URL         string `json:"url,omitempty" yaml:"url,omitempty"`
-	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	URL         string                    `json:"url,omitempty" yaml:"url,omitempty"`
+	Description string                    `json:"description,omitempty" yaml:"description,omitempty"`
+	Variables   map[string]ServerVariable `json:"variables,omitempty" yaml:"variables,omitempty"`
+}
+
+// ServerVariable is a variable in the URL of an OpenAPI server.
+type ServerVariable struct {
+	Enum        []string `json:"enum,omitempty" yaml:"enum,omitempty"`
+	Default     string   `json:"default" yaml:"default"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
 }
 
 // Paths is the OpenAPI paths.
@@ -77,6 +87,8 @@ type Operation struct {
 	Deprecated   bool          `json:"deprecated,omitempty" yaml:"deprecated,omitempty"`
 	Security     []Security    `json:"security,omitempty" yaml:"security,omitempty"`
 	Servers      []Server      `json:"servers,omitempty" yaml:"servers,omitempty"`
+
+	Extensions map[string]any `json:"-" yaml:",inline"` // the vendor extensions, written by MarshalJSON.
 }
 
 // Parameter is the OpenAPI parameter.
@@ -91,6 +103,8 @@ type Parameter struct {
 	Style       string `json:"style,omitempty" yaml:"style,omitempty"`
 	Explode     bool   `json:"explode,omitempty" yaml:"explode,omitempty"`
 	Schema      Schema `json:"schema,omitempty" yaml:"schema,omitempty"`
+
+	Extensions map[string]any `json:"-" yaml:",inline"` // the vendor extensions, written by MarshalJSON.
 }
 
 // RequestBody is the OpenAPI request body.
@@ -128,6 +142,7 @@ type Responses map[string]Response
 
 // Response is the OpenAPI response.
 type Response struct {
+	Ref         string               `json:"$ref,omitempty" yaml:"$ref,omitempty"`
 	Description string               `json:"description" yaml:"description"`
 	Headers     map[string]Header    `json:"headers,omitempty" yaml:"headers,omitempty"`
 	Content     map[string]MediaType `json:"content,omitempty" yaml:"content,omitempty"`
@@ -164,14 +179,15 @@ type Components struct {
 }
 
 // Schema is JSON Schema utilised by OpenAPI.
+// The keywords that changed type in OpenAPI 3.1 (type, exclusiveMaximum and exclusiveMinimum) are written by MarshalJSON and MarshalYAML.
 type Schema struct {
 	Ref                  string            `json:"$ref,omitempty" yaml:"$ref,omitempty"`
 	Title                string            `json:"title,omitempty" yaml:"title,omitempty"`
 	MultipleOf           float64           `json:"multipleOf,omitempty" yaml:"multipleOf,omitempty"`
 	Maximum              float64           `json:"maximum,omitempty" yaml:"maximum,omitempty"`
-	ExclusiveMaximum     bool              `json:"exclusiveMaximum,omitempty" yaml:"exclusiveMaximum,omitempty"`
+	ExclusiveMaximum     bool              `json:"-" yaml:"-"`
 	Minimum              float64           `json:"minimum,omitempty" yaml:"minimum,omitempty"`
-	ExclusiveMinimum     bool              `json:"exclusiveMinimum,omitempty" yaml:"exclusiveMinimum,omitempty"`
+	ExclusiveMinimum     bool              `json:"-" yaml:"-"`
 	MaxLength            int               `json:"maxLength,omitempty" yaml:"maxLength,omitempty"`
 	MinLength            int               `json:"minLength,omitempty" yaml:"minLength,omitempty"`
 	Pattern              string            `json:"pattern,omitempty" yaml:"pattern,omitempty"`
@@ -183,7 +199,7 @@ type Schema struct {
 	Required             []string          `json:"required,omitempty" yaml:"required,omitempty"`
 	Enum                 []interface{}     `json:"enum,omitempty" yaml:"enum,omitempty"`
 	XEnumVarNames        []string          `json:"x-enum-varnames,omitempty" yaml:"x-enum-varnames,omitempty"`
-	Type                 string            `json:"type,omitempty" yaml:"type,omitempty"`
+	Type                 string            `json:"-" yaml:"-"`
 	Format               string            `json:"format,omitempty" yaml:"format,omitempty"`
 	AllOf                []Schema          `json:"allOf,omitempty" yaml:"allOf,omitempty"`
 	OneOf                []Schema          `json:"oneOf,omitempty" yaml:"oneOf,omitempty"`
@@ -194,16 +210,45 @@ type Schema struct {
 	PatternProperties    map[string]Schema `json:"patternProperties,omitempty" yaml:"patternProperties,omitempty"`
 	AdditionalProperties *Schema           `json:"additionalProperties,omitempty" yaml:"additionalProperties,omitempty"`
 	Description          string            `json:"description,omitempty" yaml:"description,omitempty"`
+	Nullable             bool              `json:"nullable,omitempty" yaml:"nullable,omitempty"`
+	Example              any               `json:"example,omitempty" yaml:"example,omitempty"`
+
+	// OpenAPI 3.1 (JSON Schema 2020-12) keywords, set when the schema is converted to the version
+	Types                 []string `json:"-" yaml:"-"`
+	ExclusiveMaximumValue *float64 `json:"-" yaml:"-"`
+	ExclusiveMinimumValue *float64 `json:"-" yaml:"-"`
+	Examples              []any    `json:"examples,omitempty" yaml:"examples,omitempty"`
+
+	Extensions map[string]any `json:"-" yaml:"-"` // the vendor extensions, written by MarshalJSON and MarshalYAML.
 }
 
 // SecurityScheme is the OpenAPI security scheme.
 type SecurityScheme struct {
-	Ref         string `json:"$ref,omitempty" yaml:"$ref,omitempty"`
-	Type        string `json:"type,omitempty" yaml:"type,omitempty"`
-	Description string `json:"description,omitempty" yaml:"description,omitempty"`
-	Name        string `json:"name,omitempty" yaml:"name,omitempty"`
-	In          string `json:"in,omitempty" yaml:"in,omitempty"`
-	Scheme      string `json:"scheme,omitempty" yaml:"scheme,omitempty"`
+	Ref              string      `json:"$ref,omitempty" yaml:"$ref,omitempty"`
+	Type             string      `json:"type,omitempty" yaml:"type,omitempty"`
+	Description      string      `json:"description,omitempty" yaml:"description,omitempty"`
+	Name             string      `json:"name,omitempty" yaml:"name,omitempty"`
+	In               string      `json:"in,omitempty" yaml:"in,omitempty"`
+	Scheme           string      `json:"scheme,omitempty" yaml:"scheme,omitempty"`
+	BearerFormat     string      `json:"bearerFormat,omitempty" yaml:"bearerFormat,omitempty"`
+	Flows            *OAuthFlows `json:"flows,omitempty" yaml:"flows,omitempty"`
+	OpenIDConnectURL string      `json:"openIdConnectUrl,omitempty" yaml:"openIdConnectUrl,omitempty"`
+}
+
+// OAuthFlows is the OpenAPI OAuth flows.
+type OAuthFlows struct {
+	Implicit          *OAuthFlow `json:"implicit,omitempty" yaml:"implicit,omitempty"`
+	Password          *OAuthFlow `json:"password,omitempty" yaml:"password,omitempty"`
+	ClientCredentials *OAuthFlow `json:"clientCredentials,omitempty" yaml:"clientCredentials,omitempty"`
+	AuthorizationCode *OAuthFlow `json:"authorizationCode,omitempty" yaml:"authorizationCode,omitempty"`
+}
+
+// OAuthFlow is the OpenAPI OAuth flow.
+type OAuthFlow struct {
+	AuthorizationURL string            `json:"authorizationUrl,omitempty" yaml:"authorizationUrl,omitempty"`
+	TokenURL         string            `json:"tokenUrl,omitempty" yaml:"tokenUrl,omitempty"`
+	RefreshURL       string            `json:"refreshUrl,omitempty" yaml:"refreshUrl,omitempty"`
+	Scopes           map[string]string `json:"scopes" yaml:"scopes"`
 }
 
 // Security is the OpenAPI security.
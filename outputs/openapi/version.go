@@ -0,0 +1,253 @@
+package openapi
+
+// Version is the version of the OpenAPI specification that is generated.
+type Version string
+
+const (
+	Version30 Version = "3.0.0" // OpenAPI 3.0, the default version.
+	Version31 Version = "3.1.0" // OpenAPI 3.1, which uses JSON Schema 2020-12 for its schemas and supports webhooks.
+)
+
+// jsonSchemaDialect31 is the default JSON Schema dialect of the schemas in OpenAPI 3.1.
+const jsonSchemaDialect31 = "https://spec.openapis.org/oas/3.1/dialect/base"
+
+// nullType is the JSON Schema type of null, which OpenAPI 3.1 uses instead of nullable.
+const nullType = "null"
+
+// schemaKeywords are the keywords of a schema that changed type in OpenAPI 3.1, written by the version the schema is in.
+// The type is a string in OpenAPI 3.0, and can be an array of strings in OpenAPI 3.1.
+// The exclusive bounds are booleans modifying the bounds in OpenAPI 3.0, and are the bounds themselves in OpenAPI 3.1.
+type schemaKeywords struct {
+	Type             any `json:"type,omitempty" yaml:"type,omitempty"`
+	ExclusiveMaximum any `json:"exclusiveMaximum,omitempty" yaml:"exclusiveMaximum,omitempty"`
+	ExclusiveMinimum any `json:"exclusiveMinimum,omitempty" yaml:"exclusiveMinimum,omitempty"`
+}
+
+// keywords returns the keywords of the schema that changed type in OpenAPI 3.1, by the version the schema is in.
+func (s Schema) keywords() schemaKeywords {
+	var keywords schemaKeywords
+	if len(s.Types) > 0 {
+		keywords.Type = s.Types
+	} else if s.Type != "" {
+		keywords.Type = s.Type
+	}
+
+	if s.ExclusiveMaximumValue != nil {
+		keywords.ExclusiveMaximum = *s.ExclusiveMaximumValue
+	} else if s.ExclusiveMaximum {
+		keywords.ExclusiveMaximum = true
+	}
+
+	if s.ExclusiveMinimumValue != nil {
+		keywords.ExclusiveMinimum = *s.ExclusiveMinimumValue
+	} else if s.ExclusiveMinimum {
+		keywords.ExclusiveMinimum = true
+	}
+
+	return keywords
+}
+
+// MarshalJSON writes the schema with its keywords that changed type in OpenAPI 3.1.
+func (s Schema) MarshalJSON() ([]byte, error) {
+	// The alias doesn't have the methods of the schema, so it doesn't call MarshalJSON again
+	type schema Schema
+	return marshalJSONWithExtensions(struct {
+		schema
+		schemaKeywords
+	}{schema(s), s.keywords()}, s.Extensions)
+}
+
+// MarshalYAML writes the schema with its keywords that changed type in OpenAPI 3.1.
+// The extensions are inlined here, as an inline map in the embedded schema isn't inlined into the struct embedding it.
+func (s Schema) MarshalYAML() (any, error) {
+	type schema Schema
+	return struct {
+		schema         `yaml:",inline"`
+		schemaKeywords `yaml:",inline"`
+		Extensions     map[string]any `yaml:",inline"`
+	}{schema(s), s.keywords(), s.Extensions}, nil
+}
+
+// convertToVersion converts the schemas of the OpenAPI specification to the version.
+// The schemas are generated for OpenAPI 3.0, so they are converted to JSON Schema 2020-12 for OpenAPI 3.1.
+func convertToVersion(output *OpenAPISchema, version Version) {
+	switch version {
+	case Version31:
+		output.JSONSchemaDialect = jsonSchemaDialect31
+		mapSchemas(output, convertSchemaToVersion31)
+	default:
+		mapSchemas(output, convertSchemaToVersion30)
+	}
+}
+
+// convertSchemaToVersion30 removes the keywords of a schema that OpenAPI 3.0 doesn't support.
+// Pointer fields are only nullable in OpenAPI 3.1, so the schemas of OpenAPI 3.0 stay as they were before it.
+// A reference can't be combined with sibling keywords in OpenAPI 3.0, and a reference with extensions is wrapped in allOf, so the extensions are kept beside it.
+func convertSchemaToVersion30(schema Schema) Schema {
+	schema.Nullable = false
+
+	if schema.Ref != "" {
+		schema.Example = nil
+
+		if len(schema.Extensions) > 0 {
+			return Schema{
+				AllOf:      []Schema{{Ref: schema.Ref}},
+				Extensions: schema.Extensions,
+			}
+		}
+	}
+
+	return schema
+}
+
+// convertSchemaToVersion31 converts a schema to JSON Schema 2020-12.
+// Nullable types become type arrays including null, exclusive bounds become numbers, and the example becomes an array of examples.
+func convertSchemaToVersion31(schema Schema) Schema {
+	if schema.ExclusiveMaximum {
+		maximum := schema.Maximum
+		schema.ExclusiveMaximumValue = &maximum
+		schema.ExclusiveMaximum = false
+		schema.Maximum = 0
+	}
+	if schema.ExclusiveMinimum {
+		minimum := schema.Minimum
+		schema.ExclusiveMinimumValue = &minimum
+		schema.ExclusiveMinimum = false
+		schema.Minimum = 0
+	}
+
+	if schema.Example != nil {
+		schema.Examples = []any{schema.Example}
+		schema.Example = nil
+	}
+
+	if schema.Nullable {
+		schema.Nullable = false
+		if schema.Type != "" {
+			schema.Types = []string{schema.Type, nullType}
+			schema.Type = ""
+		} else {
+			// A schema without a type (i.e. a reference) is either the schema or null
+			schema = Schema{
+				AnyOf: []Schema{schema, {Type: nullType}},
+			}
+		}
+	}
+
+	return schema
+}
+
+// mapSchemas replaces every schema in the OpenAPI specification with the result of the function, including the schemas within them.
+func mapSchemas(output *OpenAPISchema, f func(Schema) Schema) {
+	mapPaths := func(paths Paths) {
+		for name, pathItem := range paths {
+			for _, operation := range []*Operation{pathItem.Get, pathItem.Put, pathItem.Post, pathItem.Delete, pathItem.Options, pathItem.Head, pathItem.Patch, pathItem.Trace} {
+				if operation == nil {
+					continue
+				}
+
+				for i := range operation.Parameters {
+					operation.Parameters[i] = mapParameterSchemas(operation.Parameters[i], f)
+				}
+				if operation.RequestBody != nil {
+					operation.RequestBody.Content = mapContentSchemas(operation.RequestBody.Content, f)
+				}
+				for status, response := range operation.Responses {
+					operation.Responses[status] = mapResponseSchemas(response, f)
+				}
+			}
+
+			for i := range pathItem.Parameters {
+				pathItem.Parameters[i] = mapParameterSchemas(pathItem.Parameters[i], f)
+			}
+			paths[name] = pathItem
+		}
+	}
+	mapPaths(output.Paths)
+	mapPaths(output.Webhooks)
+
+	for name, schema := range output.Components.Schemas {
+		output.Components.Schemas[name] = mapSchema(schema, f)
+	}
+	for name, response := range output.Components.Responses {
+		output.Components.Responses[name] = mapResponseSchemas(response, f)
+	}
+	for name, parameter := range output.Components.Parameters {
+		output.Components.Parameters[name] = mapParameterSchemas(parameter, f)
+	}
+	for name, requestBody := range output.Components.RequestBodies {
+		requestBody.Content = mapContentSchemas(requestBody.Content, f)
+		output.Components.RequestBodies[name] = requestBody
+	}
+	output.Components.Headers = mapHeaderSchemas(output.Components.Headers, f)
+}
+
+// mapParameterSchemas replaces the schema of a parameter with the result of the function.
+func mapParameterSchemas(parameter Parameter, f func(Schema) Schema) Parameter {
+	parameter.Schema = mapSchema(parameter.Schema, f)
+	return parameter
+}
+
+// mapResponseSchemas replaces the schemas of the content and headers of a response with the result of the function.
+func mapResponseSchemas(response Response, f func(Schema) Schema) Response {
+	response.Content = mapContentSchemas(response.Content, f)
+	response.Headers = mapHeaderSchemas(response.Headers, f)
+	return response
+}
+
+// mapContentSchemas replaces the schemas of the media types of content with the result of the function.
+func mapContentSchemas(content map[string]MediaType, f func(Schema) Schema) map[string]MediaType {
+	for contentType, mediaType := range content {
+		mediaType.Schema = mapSchema(mediaType.Schema, f)
+		content[contentType] = mediaType
+	}
+
+	return content
+}
+
+// mapHeaderSchemas replaces the schemas of headers with the result of the function.
+func mapHeaderSchemas(headers map[string]Header, f func(Schema) Schema) map[string]Header {
+	for name, header := range headers {
+		header.Schema = mapSchema(header.Schema, f)
+		headers[name] = header
+	}
+
+	return headers
+}
+
+// mapSchema replaces the schemas within a schema with the result of the function, and then the schema itself.
+func mapSchema(schema Schema, f func(Schema) Schema) Schema {
+	mapSchemaPointer := func(schema *Schema) *Schema {
+		if schema == nil {
+			return nil
+		}
+
+		mapped := mapSchema(*schema, f)
+		return &mapped
+	}
+	mapSchemaSlice := func(schemas []Schema) []Schema {
+		for i := range schemas {
+			schemas[i] = mapSchema(schemas[i], f)
+		}
+
+		return schemas
+	}
+	mapSchemaMap := func(schemas map[string]Schema) map[string]Schema {
+		for name, schema := range schemas {
+			schemas[name] = mapSchema(schema, f)
+		}
+
+		return schemas
+	}
+
+	schema.Items = mapSchemaPointer(schema.Items)
+	schema.Not = mapSchemaPointer(schema.Not)
+	schema.AdditionalProperties = mapSchemaPointer(schema.AdditionalProperties)
+	schema.AllOf = mapSchemaSlice(schema.AllOf)
+	schema.OneOf = mapSchemaSlice(schema.OneOf)
+	schema.AnyOf = mapSchemaSlice(schema.AnyOf)
+	schema.Properties = mapSchemaMap(schema.Properties)
+	schema.PatternProperties = mapSchemaMap(schema.PatternProperties)
+
+	return f(schema)
+}
@@ -0,0 +1,126 @@
+package openapi
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+func TestSchema_MarshalJSON(t *testing.T) {
+	t.Run("OpenAPI 3.0 keywords", func(t *testing.T) {
+		data, err := json.Marshal(Schema{Type: "integer", Minimum: 1, ExclusiveMinimum: true})
+		require.NoError(t, err)
+		require.JSONEq(t, `{"type": "integer", "minimum": 1, "exclusiveMinimum": true}`, string(data))
+	})
+
+	t.Run("OpenAPI 3.1 keywords", func(t *testing.T) {
+		minimum := 1.0
+		data, err := json.Marshal(Schema{Types: []string{"integer", "null"}, ExclusiveMinimumValue: &minimum})
+		require.NoError(t, err)
+		require.JSONEq(t, `{"type": ["integer", "null"], "exclusiveMinimum": 1}`, string(data))
+	})
+
+	t.Run("Nested schemas", func(t *testing.T) {
+		data, err := json.Marshal(Schema{Type: "array", Items: &Schema{Types: []string{"string", "null"}}})
+		require.NoError(t, err)
+		require.JSONEq(t, `{"type": "array", "items": {"type": ["string", "null"]}}`, string(data))
+	})
+}
+
+func TestSchema_MarshalYAML(t *testing.T) {
+	maximum := 10.0
+	data, err := yaml.Marshal(Schema{Types: []string{"number", "null"}, ExclusiveMaximumValue: &maximum, Description: "the weight"})
+	require.NoError(t, err)
+
+	var decoded map[string]any
+	err = yaml.Unmarshal(data, &decoded)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"type":             []any{"number", "null"},
+		"exclusiveMaximum": 10,
+		"description":      "the weight",
+	}, decoded)
+}
+
+func TestConvertSchemaToVersion30(t *testing.T) {
+	// Pointer fields are only nullable in OpenAPI 3.1
+	require.Equal(t, Schema{Type: "string"}, convertSchemaToVersion30(Schema{Type: "string", Nullable: true}))
+	// A reference can't have sibling keywords in OpenAPI 3.0
+	require.Equal(t, Schema{Ref: "#/components/schemas/Pet"}, convertSchemaToVersion30(Schema{Ref: "#/components/schemas/Pet", Nullable: true, Example: "Rex"}))
+	// Extensions are kept beside a reference by wrapping it in allOf
+	require.Equal(t, Schema{
+		AllOf:      []Schema{{Ref: "#/components/schemas/Pet"}},
+		Extensions: map[string]any{"x-go-type": "Pet"},
+	}, convertSchemaToVersion30(Schema{Ref: "#/components/schemas/Pet", Extensions: map[string]any{"x-go-type": "Pet"}}))
+}
+
+func TestConvertSchemaToVersion31(t *testing.T) {
+	t.Run("Nullable type", func(t *testing.T) {
+		schema := convertSchemaToVersion31(Schema{Type: "string", Nullable: true})
+		require.Equal(t, Schema{Types: []string{"string", "null"}}, schema)
+	})
+
+	t.Run("Nullable reference", func(t *testing.T) {
+		schema := convertSchemaToVersion31(Schema{Ref: "#/components/schemas/Pet", Nullable: true})
+		require.Equal(t, Schema{AnyOf: []Schema{{Ref: "#/components/schemas/Pet"}, {Type: "null"}}}, schema)
+	})
+
+	t.Run("Exclusive bounds", func(t *testing.T) {
+		schema := convertSchemaToVersion31(Schema{Type: "integer", Minimum: 1, ExclusiveMinimum: true, Maximum: 10})
+		require.Equal(t, 1.0, *schema.ExclusiveMinimumValue)
+		require.Zero(t, schema.Minimum)
+		require.False(t, schema.ExclusiveMinimum)
+		require.Nil(t, schema.ExclusiveMaximumValue)
+		require.Equal(t, 10.0, schema.Maximum)
+	})
+
+	t.Run("Examples", func(t *testing.T) {
+		schema := convertSchemaToVersion31(Schema{Type: "string", Example: "Rex"})
+		require.Nil(t, schema.Example)
+		require.Equal(t, []any{"Rex"}, schema.Examples)
+	})
+}
+
+func TestConvertToVersion(t *testing.T) {
+	newOutput := func() OpenAPISchema {
+		return OpenAPISchema{
+			Paths: Paths{
+				"/pets": Path{
+					Get: &Operation{
+						Responses: Responses{
+							"200": Response{
+								Content: map[string]MediaType{
+									"application/json": {Schema: Schema{Type: "array", Items: &Schema{Type: "string", Nullable: true}}},
+								},
+							},
+						},
+					},
+				},
+			},
+			Components: Components{
+				Schemas: map[string]Schema{
+					"Pet": {Type: "object", Properties: map[string]Schema{"owner": {Ref: "#/components/schemas/Owner", Nullable: true}}},
+				},
+			},
+		}
+	}
+
+	t.Run("OpenAPI 3.0", func(t *testing.T) {
+		output := newOutput()
+		convertToVersion(&output, Version30)
+
+		require.Empty(t, output.JSONSchemaDialect)
+		require.False(t, output.Paths["/pets"].Get.Responses["200"].Content["application/json"].Schema.Items.Nullable)
+		require.False(t, output.Components.Schemas["Pet"].Properties["owner"].Nullable)
+	})
+
+	t.Run("OpenAPI 3.1", func(t *testing.T) {
+		output := newOutput()
+		convertToVersion(&output, Version31)
+
+		require.Equal(t, jsonSchemaDialect31, output.JSONSchemaDialect)
+		require.Equal(t, []string{"string", "null"}, output.Paths["/pets"].Get.Responses["200"].Content["application/json"].Schema.Items.Types)
+		require.Len(t, output.Components.Schemas["Pet"].Properties["owner"].AnyOf, 2)
+	})
+}
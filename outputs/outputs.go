@@ -3,14 +3,32 @@ package outputs
 import (
 	"github.com/ls6-events/astra"
 	"github.com/ls6-events/astra/outputs/azureFunctions"
+	"github.com/ls6-events/astra/outputs/goclient"
+	"github.com/ls6-events/astra/outputs/html"
+	"github.com/ls6-events/astra/outputs/httpfile"
 	"github.com/ls6-events/astra/outputs/json"
+	"github.com/ls6-events/astra/outputs/markdown"
 	"github.com/ls6-events/astra/outputs/openapi"
+	"github.com/ls6-events/astra/outputs/postman"
+	"github.com/ls6-events/astra/outputs/protobuf"
+	"github.com/ls6-events/astra/outputs/typescript"
 )
 
 const (
-	OutputModeAzureFunctions astra.OutputMode = "azureFunctions" // Azure Functions HTTP Trigger Bindings.
-	OutputModeJSON           astra.OutputMode = "json"           // JSON file - primarily used for debugging.
-	OutputModeOpenAPI        astra.OutputMode = "openapi"        // OpenAPI 3.0 file.
+	OutputModeAzureFunctions   astra.OutputMode = "azureFunctions"   // Azure Functions HTTP Trigger Bindings.
+	OutputModeGoClient         astra.OutputMode = "goClient"         // Go client package, which sends the requests with net/http.
+	OutputModeHTML             astra.OutputMode = "html"             // Self-contained HTML documentation file, which can be viewed offline.
+	OutputModeHTTP             astra.OutputMode = "http"             // HTTP request files (.http), one for every tag or package.
+	OutputModeJSON             astra.OutputMode = "json"             // JSON file - primarily used for debugging.
+	OutputModeJSONSchema       astra.OutputMode = "jsonSchema"       // JSON Schema 2020-12 files, one for every component and a bundle.
+	OutputModeMarkdown         astra.OutputMode = "markdown"         // Markdown API reference file, written with a text/template.
+	OutputModeOpenAPI          astra.OutputMode = "openapi"          // OpenAPI 3.0 or 3.1 file.
+	OutputModeOpenAPISplit     astra.OutputMode = "openapiSplit"     // OpenAPI 3.0 or 3.1 YAML files, split by path or tag.
+	OutputModePostman          astra.OutputMode = "postman"          // Postman Collection v2.1 file, with a request for every route.
+	OutputModeProtobuf         astra.OutputMode = "protobuf"         // Protocol Buffers file, with a message for every component and a service with an rpc for every route.
+	OutputModeTypeScript       astra.OutputMode = "typescript"       // TypeScript type definitions file.
+	OutputModeTypeScriptClient astra.OutputMode = "typescriptClient" // TypeScript client file, which sends the requests with fetch.
+	OutputModeZod              astra.OutputMode = "zod"              // TypeScript file of zod schemas, for runtime validation.
 )
 
 func addOutput(mode astra.OutputMode, generate astra.ServiceFunction, configuration astra.IOConfiguration) astra.Option {
@@ -36,13 +54,37 @@ func WithAzureFunctionsOutput(directoryPath string) astra.Option {
 
 // WithOpenAPIOutput adds an OpenAPI specification as an output to the service.
 // It will generate a JSON/YAML file (based on file path [default JSON]) with the routes and components.
-// It should also contain the configuration for the file path to store in the cache for CLI usage.
-func WithOpenAPIOutput(filePath string) astra.Option {
+// The version of the specification (i.e. openapi.Version31) and other options (i.e. openapi.WithComponentThreshold(2)) can be picked, which is OpenAPI 3.0 by default.
+// It should also contain the configuration for the file path and options to store in the cache for CLI usage.
+func WithOpenAPIOutput(filePath string, options ...openapi.Option) astra.Option {
+	openAPIOptions := openapi.NewOptions(options...)
+
 	return addOutput(
 		OutputModeOpenAPI,
-		openapi.Generate(filePath),
+		openapi.Generate(filePath, openAPIOptions),
 		astra.IOConfiguration{
-			astra.IOConfigurationKeyFilePath: filePath,
+			astra.IOConfigurationKeyFilePath:           filePath,
+			astra.IOConfigurationKeyVersion:            string(openAPIOptions.Version),
+			astra.IOConfigurationKeyComponentThreshold: openAPIOptions.ComponentThreshold,
+		},
+	)
+}
+
+// WithOpenAPISplitOutput adds an OpenAPI specification split into many YAML files as an output to the service.
+// It will generate an openapi.yaml file in the directory, which references the paths and schemas in the paths and schemas directories.
+// The paths are split by path by default, or by tag (i.e. openapi.WithSplitStrategy(openapi.SplitByTag)).
+// It should also contain the configuration for the directory path and options to store in the cache for CLI usage.
+func WithOpenAPISplitOutput(directoryPath string, options ...openapi.Option) astra.Option {
+	openAPIOptions := openapi.NewOptions(options...)
+
+	return addOutput(
+		OutputModeOpenAPISplit,
+		openapi.GenerateSplit(directoryPath, openAPIOptions),
+		astra.IOConfiguration{
+			astra.IOConfigurationKeyDirectoryPath:      directoryPath,
+			astra.IOConfigurationKeyVersion:            string(openAPIOptions.Version),
+			astra.IOConfigurationKeyComponentThreshold: openAPIOptions.ComponentThreshold,
+			astra.IOConfigurationKeySplitStrategy:      string(openAPIOptions.SplitStrategy),
 		},
 	)
 }
@@ -59,3 +101,154 @@ func WithJSONOutput(filePath string) astra.Option {
 		},
 	)
 }
+
+// WithJSONSchemaOutput adds JSON Schema as an output to the service.
+// It will generate a JSON Schema 2020-12 file in the directory for every component, which reference each other with relative $refs, and a bundle.json file with all of them in its $defs.
+// It should also contain the configuration for the directory path to store in the cache for CLI usage.
+func WithJSONSchemaOutput(directoryPath string) astra.Option {
+	return addOutput(
+		OutputModeJSONSchema,
+		openapi.GenerateJSONSchema(directoryPath),
+		astra.IOConfiguration{
+			astra.IOConfigurationKeyDirectoryPath: directoryPath,
+		},
+	)
+}
+
+// WithTypeScriptOutput adds TypeScript type definitions as an output to the service.
+// It will generate a .ts or .d.ts file (based on file path [default .ts]) with the types of the components and the request and response types of the routes.
+// It should also contain the configuration for the file path to store in the cache for CLI usage.
+func WithTypeScriptOutput(filePath string) astra.Option {
+	return addOutput(
+		OutputModeTypeScript,
+		typescript.Generate(filePath),
+		astra.IOConfiguration{
+			astra.IOConfigurationKeyFilePath: filePath,
+		},
+	)
+}
+
+// WithTypeScriptClientOutput adds a TypeScript client as an output to the service.
+// It will generate a .ts file (based on file path [default .ts]) with the types of the TypeScript output and a function for every route, which sends its request with fetch.
+// It should also contain the configuration for the file path to store in the cache for CLI usage.
+func WithTypeScriptClientOutput(filePath string) astra.Option {
+	return addOutput(
+		OutputModeTypeScriptClient,
+		typescript.GenerateClient(filePath),
+		astra.IOConfiguration{
+			astra.IOConfigurationKeyFilePath: filePath,
+		},
+	)
+}
+
+// WithZodOutput adds zod schemas as an output to the service.
+// It will generate a .ts file (based on file path [default .ts]) with a zod schema for every component, with the constraints of the validation tags of its fields, and the type that is inferred from it.
+// It should also contain the configuration for the file path to store in the cache for CLI usage.
+func WithZodOutput(filePath string) astra.Option {
+	return addOutput(
+		OutputModeZod,
+		typescript.GenerateZod(filePath),
+		astra.IOConfiguration{
+			astra.IOConfigurationKeyFilePath: filePath,
+		},
+	)
+}
+
+// WithGoClientOutput adds a Go client as an output to the service.
+// It will generate a Go package in the directory with a Client that has a method for every route, and the types of its requests and responses.
+// The package is named after the directory by default (i.e. goclient.WithPackageName("petstore")), and can import the original Go types instead of generating them (i.e. goclient.WithOriginalTypes(true)).
+// It should also contain the configuration for the directory path and options to store in the cache for CLI usage.
+func WithGoClientOutput(directoryPath string, options ...goclient.Option) astra.Option {
+	goClientOptions := goclient.NewOptions(options...)
+
+	return addOutput(
+		OutputModeGoClient,
+		goclient.Generate(directoryPath, goClientOptions),
+		astra.IOConfiguration{
+			astra.IOConfigurationKeyDirectoryPath: directoryPath,
+			astra.IOConfigurationKeyPackageName:   goClientOptions.PackageName,
+			astra.IOConfigurationKeyOriginalTypes: goClientOptions.OriginalTypes,
+		},
+	)
+}
+
+// WithPostmanOutput adds a Postman collection as an output to the service.
+// It will generate a Postman Collection v2.1 JSON file with a request for every route, grouped into folders by their tags by default (i.e. postman.WithFolderStrategy(postman.FolderByPath)).
+// It should also contain the configuration for the file path and options to store in the cache for CLI usage.
+func WithPostmanOutput(filePath string, options ...postman.Option) astra.Option {
+	postmanOptions := postman.NewOptions(options...)
+
+	return addOutput(
+		OutputModePostman,
+		postman.Generate(filePath, postmanOptions),
+		astra.IOConfiguration{
+			astra.IOConfigurationKeyFilePath:       filePath,
+			astra.IOConfigurationKeyFolderStrategy: string(postmanOptions.FolderStrategy),
+		},
+	)
+}
+
+// WithHTTPOutput adds HTTP request files as an output to the service.
+// It will generate a .http file in the directory for every tag of the routes by default (i.e. httpfile.WithGroupStrategy(httpfile.GroupByPackage)), with a request for every route that can be sent by the HTTP clients of JetBrains IDEs and VS Code.
+// It should also contain the configuration for the directory path and options to store in the cache for CLI usage.
+func WithHTTPOutput(directoryPath string, options ...httpfile.Option) astra.Option {
+	httpOptions := httpfile.NewOptions(options...)
+
+	return addOutput(
+		OutputModeHTTP,
+		httpfile.Generate(directoryPath, httpOptions),
+		astra.IOConfiguration{
+			astra.IOConfigurationKeyDirectoryPath: directoryPath,
+			astra.IOConfigurationKeyGroupStrategy: string(httpOptions.GroupStrategy),
+		},
+	)
+}
+
+// WithMarkdownOutput adds a Markdown API reference as an output to the service.
+// It will generate a Markdown file with a table of contents by tag, a section for every route and a section for every component, written with markdown.DefaultTemplate unless it is overridden (i.e. markdown.WithTemplateFile("docs/reference.md.tmpl")).
+// It should also contain the configuration for the file path and options to store in the cache for CLI usage.
+func WithMarkdownOutput(filePath string, options ...markdown.Option) astra.Option {
+	markdownOptions := markdown.NewOptions(options...)
+
+	return addOutput(
+		OutputModeMarkdown,
+		markdown.Generate(filePath, markdownOptions),
+		astra.IOConfiguration{
+			astra.IOConfigurationKeyFilePath:     filePath,
+			astra.IOConfigurationKeyTemplateFile: markdownOptions.TemplateFile,
+		},
+	)
+}
+
+// WithHTMLOutput adds self-contained HTML documentation as an output to the service.
+// It will generate a single HTML file with a sidebar, a section for every route and component, collapsible schemas and example curl commands that can be copied, which doesn't load anything else so it can be viewed offline.
+// It should also contain the configuration for the file path to store in the cache for CLI usage.
+func WithHTMLOutput(filePath string) astra.Option {
+	return addOutput(
+		OutputModeHTML,
+		html.Generate(filePath),
+		astra.IOConfiguration{
+			astra.IOConfigurationKeyFilePath: filePath,
+		},
+	)
+}
+
+// WithProtobufOutput adds a Protocol Buffers file as an output to the service.
+// It will generate a .proto file with a message for every struct component, an enum for every enum component and a service with an rpc for every route.
+// The field numbers are generated once and stored in the configuration, so they stay the same when the cache is enabled (i.e. cache.WithCache()), and the numbers of removed fields are reserved.
+// It should also contain the configuration for the file path and options to store in the cache for CLI usage.
+func WithProtobufOutput(filePath string, options ...protobuf.Option) astra.Option {
+	protobufOptions := protobuf.NewOptions(options...)
+
+	// The field numbers are added to the configuration when they are generated, or restored from the cache before then
+	configuration := astra.IOConfiguration{
+		astra.IOConfigurationKeyFilePath:    filePath,
+		astra.IOConfigurationKeyPackageName: protobufOptions.PackageName,
+	}
+
+	return addOutput(
+		OutputModeProtobuf,
+		protobuf.Generate(filePath, protobufOptions, configuration),
+		configuration,
+	)
+}
@@ -2,6 +2,12 @@ package outputs
 
 import (
 	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/outputs/goclient"
+	"github.com/ls6-events/astra/outputs/httpfile"
+	"github.com/ls6-events/astra/outputs/markdown"
+	"github.com/ls6-events/astra/outputs/openapi"
+	"github.com/ls6-events/astra/outputs/postman"
+	"github.com/ls6-events/astra/outputs/protobuf"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -34,4 +40,182 @@ func TestWithOpenAPIOutput(t *testing.T) {
 	WithOpenAPIOutput("./")(service)
 
 	require.Len(t, service.Outputs, 1)
+	require.Equal(t, string(openapi.Version30), service.Outputs[0].Configuration[astra.IOConfigurationKeyVersion])
+	require.Equal(t, 0, service.Outputs[0].Configuration[astra.IOConfigurationKeyComponentThreshold])
+
+	WithOpenAPIOutput("./", openapi.Version31, openapi.WithComponentThreshold(2))(service)
+
+	require.Len(t, service.Outputs, 2)
+	require.Equal(t, string(openapi.Version31), service.Outputs[1].Configuration[astra.IOConfigurationKeyVersion])
+	require.Equal(t, 2, service.Outputs[1].Configuration[astra.IOConfigurationKeyComponentThreshold])
+}
+
+func TestWithOpenAPISplitOutput(t *testing.T) {
+	service := &astra.Service{}
+
+	require.Len(t, service.Outputs, 0)
+
+	WithOpenAPISplitOutput("./openapi")(service)
+
+	require.Len(t, service.Outputs, 1)
+	require.Equal(t, OutputModeOpenAPISplit, service.Outputs[0].Mode)
+	require.Equal(t, "./openapi", service.Outputs[0].Configuration[astra.IOConfigurationKeyDirectoryPath])
+	require.Equal(t, string(openapi.SplitByPath), service.Outputs[0].Configuration[astra.IOConfigurationKeySplitStrategy])
+
+	WithOpenAPISplitOutput("./openapi", openapi.WithSplitStrategy(openapi.SplitByTag))(service)
+
+	require.Len(t, service.Outputs, 2)
+	require.Equal(t, string(openapi.SplitByTag), service.Outputs[1].Configuration[astra.IOConfigurationKeySplitStrategy])
+}
+
+func TestWithJSONSchemaOutput(t *testing.T) {
+	service := &astra.Service{}
+
+	require.Len(t, service.Outputs, 0)
+
+	WithJSONSchemaOutput("./schemas")(service)
+
+	require.Len(t, service.Outputs, 1)
+	require.Equal(t, OutputModeJSONSchema, service.Outputs[0].Mode)
+	require.Equal(t, "./schemas", service.Outputs[0].Configuration[astra.IOConfigurationKeyDirectoryPath])
+}
+
+func TestWithTypeScriptOutput(t *testing.T) {
+	service := &astra.Service{}
+
+	require.Len(t, service.Outputs, 0)
+
+	WithTypeScriptOutput("./types.d.ts")(service)
+
+	require.Len(t, service.Outputs, 1)
+	require.Equal(t, OutputModeTypeScript, service.Outputs[0].Mode)
+	require.Equal(t, "./types.d.ts", service.Outputs[0].Configuration[astra.IOConfigurationKeyFilePath])
+}
+
+func TestWithTypeScriptClientOutput(t *testing.T) {
+	service := &astra.Service{}
+
+	require.Len(t, service.Outputs, 0)
+
+	WithTypeScriptClientOutput("./client.ts")(service)
+
+	require.Len(t, service.Outputs, 1)
+	require.Equal(t, OutputModeTypeScriptClient, service.Outputs[0].Mode)
+	require.Equal(t, "./client.ts", service.Outputs[0].Configuration[astra.IOConfigurationKeyFilePath])
+}
+
+func TestWithZodOutput(t *testing.T) {
+	service := &astra.Service{}
+
+	require.Len(t, service.Outputs, 0)
+
+	WithZodOutput("./schemas.ts")(service)
+
+	require.Len(t, service.Outputs, 1)
+	require.Equal(t, OutputModeZod, service.Outputs[0].Mode)
+	require.Equal(t, "./schemas.ts", service.Outputs[0].Configuration[astra.IOConfigurationKeyFilePath])
+}
+
+func TestWithGoClientOutput(t *testing.T) {
+	service := &astra.Service{}
+
+	require.Len(t, service.Outputs, 0)
+
+	WithGoClientOutput("./client")(service)
+
+	require.Len(t, service.Outputs, 1)
+	require.Equal(t, OutputModeGoClient, service.Outputs[0].Mode)
+	require.Equal(t, "./client", service.Outputs[0].Configuration[astra.IOConfigurationKeyDirectoryPath])
+	require.Equal(t, false, service.Outputs[0].Configuration[astra.IOConfigurationKeyOriginalTypes])
+
+	WithGoClientOutput("./client", goclient.WithPackageName("petstore"), goclient.WithOriginalTypes(true))(service)
+
+	require.Len(t, service.Outputs, 2)
+	require.Equal(t, "petstore", service.Outputs[1].Configuration[astra.IOConfigurationKeyPackageName])
+	require.Equal(t, true, service.Outputs[1].Configuration[astra.IOConfigurationKeyOriginalTypes])
+}
+
+func TestWithPostmanOutput(t *testing.T) {
+	service := &astra.Service{}
+
+	require.Len(t, service.Outputs, 0)
+
+	WithPostmanOutput("./collection.json")(service)
+
+	require.Len(t, service.Outputs, 1)
+	require.Equal(t, OutputModePostman, service.Outputs[0].Mode)
+	require.Equal(t, "./collection.json", service.Outputs[0].Configuration[astra.IOConfigurationKeyFilePath])
+	require.Equal(t, "tag", service.Outputs[0].Configuration[astra.IOConfigurationKeyFolderStrategy])
+
+	WithPostmanOutput("./collection.json", postman.WithFolderStrategy(postman.FolderByPath))(service)
+
+	require.Len(t, service.Outputs, 2)
+	require.Equal(t, "path", service.Outputs[1].Configuration[astra.IOConfigurationKeyFolderStrategy])
+}
+
+func TestWithHTTPOutput(t *testing.T) {
+	service := &astra.Service{}
+
+	require.Len(t, service.Outputs, 0)
+
+	WithHTTPOutput("./requests")(service)
+
+	require.Len(t, service.Outputs, 1)
+	require.Equal(t, OutputModeHTTP, service.Outputs[0].Mode)
+	require.Equal(t, "./requests", service.Outputs[0].Configuration[astra.IOConfigurationKeyDirectoryPath])
+	require.Equal(t, "tag", service.Outputs[0].Configuration[astra.IOConfigurationKeyGroupStrategy])
+
+	WithHTTPOutput("./requests", httpfile.WithGroupStrategy(httpfile.GroupByPackage))(service)
+
+	require.Len(t, service.Outputs, 2)
+	require.Equal(t, "package", service.Outputs[1].Configuration[astra.IOConfigurationKeyGroupStrategy])
+}
+
+func TestWithMarkdownOutput(t *testing.T) {
+	service := &astra.Service{}
+
+	require.Len(t, service.Outputs, 0)
+
+	WithMarkdownOutput("./reference.md")(service)
+
+	require.Len(t, service.Outputs, 1)
+	require.Equal(t, OutputModeMarkdown, service.Outputs[0].Mode)
+	require.Equal(t, "./reference.md", service.Outputs[0].Configuration[astra.IOConfigurationKeyFilePath])
+	require.Equal(t, "", service.Outputs[0].Configuration[astra.IOConfigurationKeyTemplateFile])
+
+	WithMarkdownOutput("./reference.md", markdown.WithTemplateFile("docs/reference.md.tmpl"))(service)
+
+	require.Len(t, service.Outputs, 2)
+	require.Equal(t, "docs/reference.md.tmpl", service.Outputs[1].Configuration[astra.IOConfigurationKeyTemplateFile])
+}
+
+func TestWithHTMLOutput(t *testing.T) {
+	service := &astra.Service{}
+
+	require.Len(t, service.Outputs, 0)
+
+	WithHTMLOutput("./docs.html")(service)
+
+	require.Len(t, service.Outputs, 1)
+	require.Equal(t, OutputModeHTML, service.Outputs[0].Mode)
+	require.Equal(t, "./docs.html", service.Outputs[0].Configuration[astra.IOConfigurationKeyFilePath])
+}
+
+func TestWithProtobufOutput(t *testing.T) {
+	service := &astra.Service{}
+
+	require.Len(t, service.Outputs, 0)
+
+	WithProtobufOutput("./petstore.proto")(service)
+
+	require.Len(t, service.Outputs, 1)
+	require.Equal(t, OutputModeProtobuf, service.Outputs[0].Mode)
+	require.Equal(t, "./petstore.proto", service.Outputs[0].Configuration[astra.IOConfigurationKeyFilePath])
+	require.Equal(t, "", service.Outputs[0].Configuration[astra.IOConfigurationKeyPackageName])
+	require.NotContains(t, service.Outputs[0].Configuration, astra.IOConfigurationKeyFieldNumbers)
+
+	WithProtobufOutput("./petstore.proto", protobuf.WithPackageName("petstore.v1"))(service)
+
+	require.Len(t, service.Outputs, 2)
+	require.Equal(t, "petstore.v1", service.Outputs[1].Configuration[astra.IOConfigurationKeyPackageName])
 }
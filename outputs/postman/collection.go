@@ -0,0 +1,301 @@
+package postman
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/ls6-events/astra/outputs/openapi"
+)
+
+const (
+	// collectionSchema is the schema of the Postman Collection v2.1 format.
+	collectionSchema = "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"
+	// baseURLVariable is the variable of the collection that the URLs of the requests start with.
+	baseURLVariable = "baseUrl"
+	// defaultName is the name of the collection if the config has no title.
+	defaultName = "API"
+)
+
+// collection is a Postman collection.
+type collection struct {
+	Info     info       `json:"info"`
+	Item     []item     `json:"item"`
+	Variable []variable `json:"variable,omitempty"`
+}
+
+// info is the information of a Postman collection.
+type info struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Schema      string `json:"schema"`
+}
+
+// variable is a variable of a Postman collection.
+type variable struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+	Type  string `json:"type,omitempty"`
+}
+
+// item is a request, or a folder of requests if it has items.
+type item struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Item        []item   `json:"item,omitempty"`
+	Request     *request `json:"request,omitempty"`
+}
+
+// request is a request of a Postman collection.
+type request struct {
+	Method      string     `json:"method"`
+	Header      []keyValue `json:"header"`
+	Body        *body      `json:"body,omitempty"`
+	URL         url        `json:"url"`
+	Description string     `json:"description,omitempty"`
+}
+
+// url is the URL of a request, with its path variables and query params.
+type url struct {
+	Raw      string     `json:"raw"`
+	Host     []string   `json:"host"`
+	Path     []string   `json:"path,omitempty"`
+	Query    []keyValue `json:"query,omitempty"`
+	Variable []keyValue `json:"variable,omitempty"`
+}
+
+// keyValue is a header, query param, path variable or form field of a request.
+// The params that aren't required are disabled, so they aren't sent until they are enabled.
+type keyValue struct {
+	Key         string `json:"key"`
+	Value       string `json:"value,omitempty"`
+	Type        string `json:"type,omitempty"`
+	Description string `json:"description,omitempty"`
+	Disabled    bool   `json:"disabled,omitempty"`
+}
+
+// body is the body of a request, which is raw (i.e. JSON) or a form.
+type body struct {
+	Mode       string       `json:"mode"`
+	Raw        string       `json:"raw,omitempty"`
+	FormData   []keyValue   `json:"formdata,omitempty"`
+	URLEncoded []keyValue   `json:"urlencoded,omitempty"`
+	Options    *bodyOptions `json:"options,omitempty"`
+}
+
+// bodyOptions are the options of a raw body, so Postman highlights it and sets its content type.
+type bodyOptions struct {
+	Raw struct {
+		Language string `json:"language"`
+	} `json:"raw"`
+}
+
+// newCollection creates the Postman collection of the OpenAPI specification.
+// The URLs of the requests start with {{baseUrl}}, which is a variable of the collection set to the URL of the first server, so it can be changed for other environments.
+func newCollection(output openapi.OpenAPISchema, basePath string, options Options) collection {
+	name := output.Info.Title
+	if name == "" {
+		name = defaultName
+	}
+
+	baseURL, basePath := openapi.ServerURL(output.Servers, basePath)
+	examples := newExampleWriter(output.Components.Schemas)
+
+	folders := make(map[string]*item)
+	var folderNames []string
+	var items []item
+	for _, operation := range openapi.PathOperations(output) {
+		path := openapi.TrimBasePath(operation.Path, basePath)
+		requestItem := newRequestItem(operation, path, examples)
+
+		folderName := ""
+		switch options.FolderStrategy {
+		case FolderByPath:
+			folderName = strings.Split(strings.TrimPrefix(path, "/"), "/")[0]
+		default:
+			if len(operation.Tags) > 0 {
+				folderName = operation.Tags[0]
+			}
+		}
+
+		if folderName == "" {
+			items = append(items, requestItem)
+			continue
+		}
+
+		folder, ok := folders[folderName]
+		if !ok {
+			folder = &item{Name: folderName}
+			folders[folderName] = folder
+			folderNames = append(folderNames, folderName)
+		}
+		folder.Item = append(folder.Item, requestItem)
+	}
+
+	tagDescriptions := make(map[string]string, len(output.Tags))
+	if options.FolderStrategy != FolderByPath {
+		for _, tag := range output.Tags {
+			tagDescriptions[tag.Name] = tag.Description
+		}
+	}
+
+	sort.Strings(folderNames)
+	collectionItems := make([]item, 0, len(folderNames)+len(items))
+	for _, folderName := range folderNames {
+		folder := folders[folderName]
+		folder.Description = tagDescriptions[folderName]
+		collectionItems = append(collectionItems, *folder)
+	}
+	collectionItems = append(collectionItems, items...)
+
+	return collection{
+		Info: info{
+			Name:        name,
+			Description: output.Info.Description,
+			Schema:      collectionSchema,
+		},
+		Item: collectionItems,
+		Variable: []variable{
+			{Key: baseURLVariable, Value: baseURL, Type: "string"},
+		},
+	}
+}
+
+// newRequestItem creates the request of an operation, named by its summary or operation ID.
+// Its path variables, query params and headers are the parameters of the operation, and its body is an example synthesised from the schema of the request body.
+func newRequestItem(operation openapi.PathOperation, path string, examples *exampleWriter) item {
+	name := operation.Summary
+	if name == "" {
+		name = operation.OperationID
+	}
+	if name == "" {
+		name = operation.Method + " " + operation.Path
+	}
+
+	r := &request{
+		Method:      operation.Method,
+		Header:      []keyValue{},
+		Description: operation.Description,
+		URL: url{
+			Host: []string{"{{" + baseURLVariable + "}}"},
+		},
+	}
+
+	for _, segment := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		if segment == "" {
+			continue
+		}
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			// Catch-all params (i.e. {filepath*}) are variables of their names, like the other params
+			segment = ":" + strings.TrimSuffix(strings.TrimSuffix(strings.TrimPrefix(segment, "{"), "}"), "*")
+		}
+		r.URL.Path = append(r.URL.Path, segment)
+	}
+
+	for _, parameter := range operation.Parameters {
+		value := keyValue{
+			Key:         parameter.Name,
+			Value:       examples.exampleString(parameter.Schema),
+			Description: parameter.Description,
+			Disabled:    !parameter.Required,
+		}
+
+		switch parameter.In {
+		case "path":
+			value.Disabled = false
+			r.URL.Variable = append(r.URL.Variable, value)
+		case "query":
+			r.URL.Query = append(r.URL.Query, value)
+		case "header":
+			r.Header = append(r.Header, value)
+		}
+	}
+
+	if operation.RequestBody != nil {
+		r.Body = newBody(operation.RequestBody.Content, examples, r)
+	}
+
+	r.URL.Raw = rawURL(r.URL)
+
+	return item{
+		Name:    name,
+		Request: r,
+	}
+}
+
+// newBody creates the body of a request from the preferred content type of the request body.
+// Forms have a field for every property of their schema, and other content types have a raw example, with the content type in the headers of the request.
+func newBody(content map[string]openapi.MediaType, examples *exampleWriter, r *request) *body {
+	if mediaType, ok := content["application/json"]; ok {
+		b := &body{
+			Mode: "raw",
+			Raw:  examples.exampleJSON(mediaType.Schema),
+		}
+		b.Options = &bodyOptions{}
+		b.Options.Raw.Language = "json"
+		return b
+	}
+
+	if mediaType, ok := content["multipart/form-data"]; ok {
+		b := &body{Mode: "formdata"}
+		for _, p := range examples.properties(mediaType.Schema) {
+			if openapi.IsBinary(p.Schema) {
+				b.FormData = append(b.FormData, keyValue{Key: p.Name, Type: "file"})
+				continue
+			}
+			b.FormData = append(b.FormData, keyValue{Key: p.Name, Value: examples.exampleString(p.Schema), Type: "text"})
+		}
+		return b
+	}
+
+	if mediaType, ok := content["application/x-www-form-urlencoded"]; ok {
+		b := &body{Mode: "urlencoded"}
+		for _, p := range examples.properties(mediaType.Schema) {
+			b.URLEncoded = append(b.URLEncoded, keyValue{Key: p.Name, Value: examples.exampleString(p.Schema)})
+		}
+		return b
+	}
+
+	contentTypes := make([]string, 0, len(content))
+	for contentType := range content {
+		contentTypes = append(contentTypes, contentType)
+	}
+	if len(contentTypes) == 0 {
+		return nil
+	}
+	sort.Strings(contentTypes)
+
+	r.Header = append(r.Header, keyValue{Key: "Content-Type", Value: contentTypes[0]})
+	b := &body{
+		Mode: "raw",
+		Raw:  examples.exampleString(content[contentTypes[0]].Schema),
+	}
+	if strings.HasSuffix(contentTypes[0], "json") {
+		b.Raw = examples.exampleJSON(content[contentTypes[0]].Schema)
+	}
+
+	return b
+}
+
+// rawURL writes the URL of a request as Postman shows it, with the query params that are enabled.
+func rawURL(u url) string {
+	var b strings.Builder
+	b.WriteString(strings.Join(u.Host, ""))
+	for _, segment := range u.Path {
+		b.WriteString("/")
+		b.WriteString(segment)
+	}
+
+	separator := "?"
+	for _, query := range u.Query {
+		if query.Disabled {
+			continue
+		}
+		b.WriteString(separator)
+		b.WriteString(query.Key)
+		b.WriteString("=")
+		b.WriteString(query.Value)
+		separator = "&"
+	}
+
+	return b.String()
+}
@@ -0,0 +1,152 @@
+package postman
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra/outputs/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCollection(t *testing.T) {
+	output := openapi.OpenAPISchema{
+		Info:    openapi.Info{Title: "Petstore", Description: "The pets of the store."},
+		Servers: []openapi.Server{{URL: "http://localhost:8000/api"}},
+		Tags:    []openapi.Tag{{Name: "pets", Description: "The pets."}},
+		Paths: openapi.Paths{
+			"/api/pets/{id}": {
+				Get: &openapi.Operation{
+					Tags:        []string{"pets"},
+					Summary:     "Get pet",
+					OperationID: "getPet",
+					Parameters: []openapi.Parameter{
+						{Name: "id", In: "path", Required: true, Schema: openapi.Schema{Type: "integer"}},
+						{Name: "fields", In: "query", Schema: openapi.Schema{Type: "string"}},
+						{Name: "verbose", In: "query", Required: true, Schema: openapi.Schema{Type: "boolean"}},
+						{Name: "X-Request-ID", In: "header", Schema: openapi.Schema{Type: "string", Format: "uuid"}},
+					},
+				},
+				Put: &openapi.Operation{
+					Tags:        []string{"pets"},
+					OperationID: "updatePet",
+					RequestBody: &openapi.RequestBody{
+						Content: map[string]openapi.MediaType{
+							"application/json": {Schema: openapi.Schema{Ref: "#/components/schemas/Pet"}},
+						},
+					},
+				},
+			},
+			"/api/health": {
+				Get: &openapi.Operation{OperationID: "health"},
+			},
+			"/api/owners/{id}/photo": {
+				Post: &openapi.Operation{
+					OperationID: "uploadPhoto",
+					RequestBody: &openapi.RequestBody{
+						Content: map[string]openapi.MediaType{
+							"multipart/form-data": {Schema: openapi.Schema{
+								Type: "object",
+								Properties: map[string]openapi.Schema{
+									"photo":   {Type: "string", Format: "binary"},
+									"caption": {Type: "string"},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+		Components: openapi.Components{
+			Schemas: map[string]openapi.Schema{
+				"Pet": {
+					Type: "object",
+					Properties: map[string]openapi.Schema{
+						"name":   {Type: "string"},
+						"status": {Type: "string", Enum: []any{"available", "sold"}},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("it groups the requests into folders by their tags", func(t *testing.T) {
+		c := newCollection(output, "/api", NewOptions())
+
+		require.Equal(t, info{Name: "Petstore", Description: "The pets of the store.", Schema: collectionSchema}, c.Info)
+		require.Equal(t, []variable{{Key: "baseUrl", Value: "http://localhost:8000/api", Type: "string"}}, c.Variable)
+
+		require.Len(t, c.Item, 3)
+		require.Equal(t, "pets", c.Item[0].Name)
+		require.Equal(t, "The pets.", c.Item[0].Description)
+		require.Len(t, c.Item[0].Item, 2)
+		require.Equal(t, "health", c.Item[1].Name)
+		require.Equal(t, "uploadPhoto", c.Item[2].Name)
+	})
+
+	t.Run("it groups the requests into folders by their paths", func(t *testing.T) {
+		c := newCollection(output, "/api", NewOptions(WithFolderStrategy(FolderByPath)))
+
+		require.Len(t, c.Item, 3)
+		require.Equal(t, "health", c.Item[0].Name)
+		require.Equal(t, "owners", c.Item[1].Name)
+		require.Equal(t, "pets", c.Item[2].Name)
+		require.Empty(t, c.Item[2].Description)
+	})
+
+	t.Run("it adds the path variables, query params and headers of the operation", func(t *testing.T) {
+		c := newCollection(output, "/api", NewOptions())
+		getPet := c.Item[0].Item[0]
+
+		require.Equal(t, "Get pet", getPet.Name)
+		require.Equal(t, url{
+			Raw:  "{{baseUrl}}/pets/:id?verbose=false",
+			Host: []string{"{{baseUrl}}"},
+			Path: []string{"pets", ":id"},
+			Query: []keyValue{
+				{Key: "fields", Value: "string", Disabled: true},
+				{Key: "verbose", Value: "false"},
+			},
+			Variable: []keyValue{{Key: "id", Value: "0"}},
+		}, getPet.Request.URL)
+		require.Equal(t, []keyValue{{Key: "X-Request-ID", Value: "00000000-0000-0000-0000-000000000000", Disabled: true}}, getPet.Request.Header)
+	})
+
+	t.Run("it adds the catch-all params of wildcard routes as path variables", func(t *testing.T) {
+		c := newCollection(openapi.OpenAPISchema{
+			Paths: openapi.Paths{
+				"/files/{filepath*}": {
+					Get: &openapi.Operation{
+						Summary: "Get file",
+						Parameters: []openapi.Parameter{
+							{Name: "filepath", In: "path", Required: true, Schema: openapi.Schema{Type: "string"}},
+						},
+					},
+				},
+			},
+		}, "", NewOptions(WithFolderStrategy(FolderByPath)))
+
+		getFile := c.Item[0].Item[0]
+		require.Equal(t, []string{"files", ":filepath"}, getFile.Request.URL.Path)
+		require.Equal(t, []keyValue{{Key: "filepath", Value: "string"}}, getFile.Request.URL.Variable)
+		require.Equal(t, "{{baseUrl}}/files/:filepath", getFile.Request.URL.Raw)
+	})
+
+	t.Run("it synthesises an example of the JSON body", func(t *testing.T) {
+		c := newCollection(output, "/api", NewOptions())
+		updatePet := c.Item[0].Item[1]
+
+		require.Equal(t, "raw", updatePet.Request.Body.Mode)
+		require.Equal(t, "{\n  \"name\": \"string\",\n  \"status\": \"available\"\n}", updatePet.Request.Body.Raw)
+		require.Equal(t, "json", updatePet.Request.Body.Options.Raw.Language)
+	})
+
+	t.Run("it adds the fields of a form", func(t *testing.T) {
+		c := newCollection(output, "/api", NewOptions())
+		uploadPhoto := c.Item[2]
+
+		require.Equal(t, "formdata", uploadPhoto.Request.Body.Mode)
+		require.Equal(t, []keyValue{
+			{Key: "caption", Value: "string", Type: "text"},
+			{Key: "photo", Type: "file"},
+		}, uploadPhoto.Request.Body.FormData)
+	})
+}
@@ -0,0 +1,56 @@
+package postman
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/ls6-events/astra/outputs/openapi"
+)
+
+// exampleWriter writes the examples of the schemas, resolving their references to the components.
+type exampleWriter struct {
+	components map[string]openapi.Schema
+}
+
+// newExampleWriter creates an example writer for the components.
+func newExampleWriter(components map[string]openapi.Schema) *exampleWriter {
+	return &exampleWriter{
+		components: components,
+	}
+}
+
+// properties returns the properties of the schema of a form, resolving its reference, sorted by their names.
+func (w *exampleWriter) properties(schema openapi.Schema) []property {
+	schemas := openapi.FormProperties(schema, w.components)
+
+	properties := make([]property, 0, len(schemas))
+	for name, schema := range schemas {
+		properties = append(properties, property{Name: name, Schema: schema})
+	}
+	sort.Slice(properties, func(i, j int) bool {
+		return properties[i].Name < properties[j].Name
+	})
+
+	return properties
+}
+
+// property is a property of the schema of a form.
+type property struct {
+	Name   string
+	Schema openapi.Schema
+}
+
+// exampleJSON writes the example of the schema as indented JSON, for the raw body of a request.
+func (w *exampleWriter) exampleJSON(schema openapi.Schema) string {
+	example, err := json.MarshalIndent(openapi.Example(schema, w.components), "", "  ")
+	if err != nil {
+		return ""
+	}
+
+	return string(example)
+}
+
+// exampleString writes the example of the schema as the value of a param or form field.
+func (w *exampleWriter) exampleString(schema openapi.Schema) string {
+	return openapi.ExampleString(openapi.Example(schema, w.components))
+}
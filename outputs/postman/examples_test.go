@@ -0,0 +1,19 @@
+package postman
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra/outputs/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExampleWriter_exampleString(t *testing.T) {
+	w := newExampleWriter(map[string]openapi.Schema{
+		"Status": {Type: "string", Enum: []any{"available", "sold"}},
+	})
+
+	require.Equal(t, "available", w.exampleString(openapi.Schema{Ref: "#/components/schemas/Status"}))
+	require.Equal(t, "0", w.exampleString(openapi.Schema{Type: "integer"}))
+	require.Equal(t, "string", w.exampleString(openapi.Schema{Type: "array", Items: &openapi.Schema{Type: "string"}}))
+	require.Equal(t, "", w.exampleString(openapi.Schema{}))
+}
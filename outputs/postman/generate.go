@@ -0,0 +1,47 @@
+package postman
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/outputs/openapi"
+)
+
+// Generate generates the Postman collection output.
+// It writes a Postman Collection v2.1 file with a request for every operation, grouped into folders by their tags or paths, whose URLs start with the {{baseUrl}} variable of the collection.
+// The bodies of the requests are examples synthesised from their schemas, so they can be sent once they are filled in.
+func Generate(filePath string, options Options) astra.ServiceFunction {
+	return func(s *astra.Service) error {
+		s.Log.Info().Msg("Generating Postman collection output")
+		output, err := openapi.Build(s, openapi.NewOptions())
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to build OpenAPI schema")
+			return err
+		}
+
+		file, err := json.MarshalIndent(newCollection(output, s.Config.BasePath, options), "", "  ")
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to marshal Postman collection")
+			return err
+		}
+
+		if !strings.HasSuffix(filePath, ".json") {
+			s.Log.Debug().Str("filePath", filePath).Msg("Adding .json suffix to file path")
+			filePath += ".json"
+		}
+
+		s.Log.Debug().Str("filePath", filePath).Msg("Writing Postman collection output to file")
+		filePath = path.Join(s.WorkDir, filePath)
+		err = os.WriteFile(filePath, file, 0644)
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to write Postman collection output to file")
+			return err
+		}
+
+		s.Log.Info().Msg("Generated Postman collection output")
+		return nil
+	}
+}
@@ -0,0 +1,43 @@
+package postman
+
+// FolderStrategy is how the requests of the collection are grouped into folders.
+type FolderStrategy string
+
+const (
+	// FolderByTag groups the requests into a folder for every tag of their operations, and leaves the untagged requests at the root.
+	FolderByTag FolderStrategy = "tag"
+	// FolderByPath groups the requests into a folder for the first segment of their paths (i.e. /pets/{id} is in pets).
+	FolderByPath FolderStrategy = "path"
+)
+
+// Options are the options of the Postman collection output.
+type Options struct {
+	// FolderStrategy is how the requests are grouped into folders, which is FolderByTag by default.
+	FolderStrategy FolderStrategy
+}
+
+// Option is an option of the Postman collection output.
+type Option func(*Options)
+
+// NewOptions creates the options of the Postman collection output, with the defaults for those that aren't set.
+func NewOptions(options ...Option) Options {
+	o := Options{
+		FolderStrategy: FolderByTag,
+	}
+	for _, option := range options {
+		option(&o)
+	}
+
+	if o.FolderStrategy == "" {
+		o.FolderStrategy = FolderByTag
+	}
+
+	return o
+}
+
+// WithFolderStrategy is an option to pick how the requests are grouped into folders (i.e. FolderByPath).
+func WithFolderStrategy(folderStrategy FolderStrategy) Option {
+	return func(o *Options) {
+		o.FolderStrategy = folderStrategy
+	}
+}
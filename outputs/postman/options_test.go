@@ -0,0 +1,21 @@
+package postman
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOptions(t *testing.T) {
+	t.Run("it groups the requests by their tags by default", func(t *testing.T) {
+		require.Equal(t, Options{FolderStrategy: FolderByTag}, NewOptions())
+	})
+
+	t.Run("it applies the options", func(t *testing.T) {
+		require.Equal(t, Options{FolderStrategy: FolderByPath}, NewOptions(WithFolderStrategy(FolderByPath)))
+	})
+
+	t.Run("it keeps the default for an empty folder strategy", func(t *testing.T) {
+		require.Equal(t, Options{FolderStrategy: FolderByTag}, NewOptions(WithFolderStrategy("")))
+	})
+}
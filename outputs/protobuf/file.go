@@ -0,0 +1,264 @@
+package protobuf
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// generatedHeader is the comment at the top of the .proto file, so it isn't edited by hand.
+const generatedHeader = "// Code generated by astra. DO NOT EDIT.\n"
+
+// wellKnownTypeImports are the files that the well-known types of Protocol Buffers are imported from, by their names.
+var wellKnownTypeImports = map[string]string{
+	"google.protobuf.Duration":  "google/protobuf/duration.proto",
+	"google.protobuf.Empty":     "google/protobuf/empty.proto",
+	"google.protobuf.ListValue": "google/protobuf/struct.proto",
+	"google.protobuf.Struct":    "google/protobuf/struct.proto",
+	"google.protobuf.Timestamp": "google/protobuf/timestamp.proto",
+	"google.protobuf.Value":     "google/protobuf/struct.proto",
+}
+
+// file is a .proto file, with the service of the API and the messages and enums of its components.
+type file struct {
+	Package  string
+	Service  service
+	Messages []*message
+	Enums    []*enum
+}
+
+// service is the service of the API, with an rpc for every route.
+type service struct {
+	Name string
+	Doc  string
+	RPCs []rpc
+}
+
+// rpc is an rpc of the service, which is a route of the API.
+type rpc struct {
+	Name     string
+	Doc      string
+	Request  string
+	Response string
+}
+
+// message is a message, which is a struct component, the inline struct of a field or the request or response of an rpc.
+type message struct {
+	// Name is the name of the message in its scope, and FullName is its name in the package (i.e. Owner and Pet.Owner), which its field numbers are by.
+	Name     string
+	FullName string
+	Doc      string
+	Fields   []field
+	Messages []*message
+}
+
+// field is a field of a message.
+type field struct {
+	Name     string
+	JSONName string
+	Type     string
+	Number   int
+	Doc      string
+	Repeated bool
+	Optional bool
+}
+
+// enum is an enum, which is a component with enum values.
+type enum struct {
+	Name   string
+	Doc    string
+	Values []enumValue
+}
+
+// enumValue is a value of an enum.
+type enumValue struct {
+	Name   string
+	Number int
+}
+
+// fileWriter writes a .proto file, with the reserved numbers and names of the fields and values that were removed from its field numbers.
+type fileWriter struct {
+	b            strings.Builder
+	fieldNumbers FieldNumbers
+	imports      map[string]bool
+}
+
+// write writes the .proto file, with the imports of the well-known types that it uses.
+func (f file) write(fieldNumbers FieldNumbers) string {
+	w := &fileWriter{fieldNumbers: fieldNumbers, imports: make(map[string]bool)}
+
+	w.service(f.Service)
+	sort.Slice(f.Messages, func(i, j int) bool {
+		return f.Messages[i].Name < f.Messages[j].Name
+	})
+	for _, m := range f.Messages {
+		w.b.WriteString("\n")
+		w.message(m, "")
+	}
+	sort.Slice(f.Enums, func(i, j int) bool {
+		return f.Enums[i].Name < f.Enums[j].Name
+	})
+	for _, e := range f.Enums {
+		w.b.WriteString("\n")
+		w.enum(e)
+	}
+
+	var b strings.Builder
+	b.WriteString(generatedHeader)
+	b.WriteString("\nsyntax = \"proto3\";\n")
+	fmt.Fprintf(&b, "\npackage %s;\n", f.Package)
+	if len(w.imports) > 0 {
+		b.WriteString("\n")
+		for _, importPath := range sortedKeys(w.imports) {
+			fmt.Fprintf(&b, "import %s;\n", strconv.Quote(importPath))
+		}
+	}
+	b.WriteString(w.b.String())
+
+	return b.String()
+}
+
+// use adds the import of a type if it is a well-known type.
+func (w *fileWriter) use(typeName string) {
+	for wellKnownType, importPath := range wellKnownTypeImports {
+		if strings.Contains(typeName, wellKnownType) {
+			w.imports[importPath] = true
+		}
+	}
+}
+
+// doc writes a doc comment, with a // comment for every line.
+func (w *fileWriter) doc(doc string, indent string) {
+	doc = strings.TrimSpace(doc)
+	if doc == "" {
+		return
+	}
+
+	for _, line := range strings.Split(doc, "\n") {
+		w.b.WriteString(strings.TrimRight(indent+"// "+line, " ") + "\n")
+	}
+}
+
+// service writes the service, which isn't written if the API has no routes.
+func (w *fileWriter) service(s service) {
+	if len(s.RPCs) == 0 {
+		return
+	}
+
+	w.b.WriteString("\n")
+	w.doc(s.Doc, "")
+	fmt.Fprintf(&w.b, "service %s {\n", s.Name)
+	for i, r := range s.RPCs {
+		if i > 0 && r.Doc != "" {
+			w.b.WriteString("\n")
+		}
+		w.doc(r.Doc, "  ")
+		w.use(r.Request)
+		w.use(r.Response)
+		fmt.Fprintf(&w.b, "  rpc %s(%s) returns (%s);\n", r.Name, r.Request, r.Response)
+	}
+	w.b.WriteString("}\n")
+}
+
+// message writes a message, with its fields by their numbers and then its nested messages.
+// The numbers and names of the fields that it had before are reserved, so they aren't used by other fields.
+func (w *fileWriter) message(m *message, indent string) {
+	w.doc(m.Doc, indent)
+	fmt.Fprintf(&w.b, "%smessage %s {\n", indent, m.Name)
+
+	fields := make(map[string]bool, len(m.Fields))
+	for _, f := range m.Fields {
+		fields[f.Name] = true
+	}
+	if w.reserved(m.FullName, fields, indent+"  ") && (len(m.Fields) > 0 || len(m.Messages) > 0) {
+		w.b.WriteString("\n")
+	}
+
+	sort.Slice(m.Fields, func(i, j int) bool {
+		return m.Fields[i].Number < m.Fields[j].Number
+	})
+	for _, f := range m.Fields {
+		w.doc(f.Doc, indent+"  ")
+		w.use(f.Type)
+
+		w.b.WriteString(indent + "  ")
+		if f.Repeated {
+			w.b.WriteString("repeated ")
+		} else if f.Optional {
+			w.b.WriteString("optional ")
+		}
+		fmt.Fprintf(&w.b, "%s %s = %d", f.Type, f.Name, f.Number)
+		if f.JSONName != "" && f.JSONName != jsonName(f.Name) {
+			fmt.Fprintf(&w.b, " [json_name = %s]", strconv.Quote(f.JSONName))
+		}
+		w.b.WriteString(";\n")
+	}
+
+	sort.Slice(m.Messages, func(i, j int) bool {
+		return m.Messages[i].Name < m.Messages[j].Name
+	})
+	for i, nested := range m.Messages {
+		if i > 0 || len(m.Fields) > 0 {
+			w.b.WriteString("\n")
+		}
+		w.message(nested, indent+"  ")
+	}
+
+	fmt.Fprintf(&w.b, "%s}\n", indent)
+}
+
+// enum writes an enum, with its values by their numbers, so the value that is 0 is first.
+// The numbers and names of the values that it had before are reserved, so they aren't used by other values.
+func (w *fileWriter) enum(e *enum) {
+	w.doc(e.Doc, "")
+	fmt.Fprintf(&w.b, "enum %s {\n", e.Name)
+
+	values := make(map[string]bool, len(e.Values))
+	for _, value := range e.Values {
+		values[value.Name] = true
+	}
+	if w.reserved(e.Name, values, "  ") {
+		w.b.WriteString("\n")
+	}
+
+	sort.Slice(e.Values, func(i, j int) bool {
+		return e.Values[i].Number < e.Values[j].Number
+	})
+	for _, value := range e.Values {
+		fmt.Fprintf(&w.b, "  %s = %d;\n", value.Name, value.Number)
+	}
+	w.b.WriteString("}\n")
+}
+
+// reserved writes the reserved numbers and names of the fields or values that a message or enum doesn't have anymore, returning whether there are any.
+func (w *fileWriter) reserved(name string, fields map[string]bool, indent string) bool {
+	numbers, names := w.fieldNumbers.reserved(name, fields)
+	if len(numbers) == 0 {
+		return false
+	}
+
+	reservedNumbers := make([]string, 0, len(numbers))
+	for _, number := range numbers {
+		reservedNumbers = append(reservedNumbers, strconv.Itoa(number))
+	}
+	reservedNames := make([]string, 0, len(names))
+	for _, reservedName := range names {
+		reservedNames = append(reservedNames, strconv.Quote(reservedName))
+	}
+	fmt.Fprintf(&w.b, "%sreserved %s;\n", indent, strings.Join(reservedNumbers, ", "))
+	fmt.Fprintf(&w.b, "%sreserved %s;\n", indent, strings.Join(reservedNames, ", "))
+
+	return true
+}
+
+// sortedKeys returns the keys of a map, sorted.
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,95 @@
+package protobuf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite(t *testing.T) {
+	f := file{
+		Package: "petstore",
+		Service: service{
+			Name: "PetstoreService",
+			RPCs: []rpc{
+				{Name: "GetPet", Doc: "getPet gets a pet.\nGET /pets/:id", Request: "GetPetRequest", Response: "Pet"},
+				{Name: "DeletePet", Request: "GetPetRequest", Response: "google.protobuf.Empty"},
+			},
+		},
+		Messages: []*message{
+			{Name: "Pet", FullName: "Pet", Doc: "Pet is a pet.", Fields: []field{
+				{Name: "tags", JSONName: "tags", Type: "string", Number: 3, Repeated: true},
+				{Name: "id", JSONName: "ID", Type: "int64", Number: 1, Doc: "The ID of the pet."},
+				{Name: "born_at", JSONName: "bornAt", Type: "google.protobuf.Timestamp", Number: 4},
+				{Name: "nickname", Type: "string", Number: 5, Optional: true},
+				{Name: "owner", Type: "Owner", Number: 6},
+			}, Messages: []*message{
+				{Name: "Owner", FullName: "Pet.Owner", Fields: []field{{Name: "name", Type: "string", Number: 1}}},
+			}},
+			{Name: "GetPetRequest", FullName: "GetPetRequest", Fields: []field{{Name: "id", Type: "string", Number: 1}}},
+		},
+		Enums: []*enum{
+			{Name: "Status", Values: []enumValue{{Name: "STATUS_SOLD", Number: 2}, {Name: "STATUS_UNSPECIFIED", Number: 0}}},
+		},
+	}
+	fieldNumbers := FieldNumbers{
+		"Pet":    {"id": 1, "name": 2, "tags": 3, "born_at": 4, "nickname": 5, "owner": 6},
+		"Status": {"STATUS_UNSPECIFIED": 0, "STATUS_AVAILABLE": 1, "STATUS_SOLD": 2},
+	}
+
+	require.Equal(t, `// Code generated by astra. DO NOT EDIT.
+
+syntax = "proto3";
+
+package petstore;
+
+import "google/protobuf/empty.proto";
+import "google/protobuf/timestamp.proto";
+
+service PetstoreService {
+  // getPet gets a pet.
+  // GET /pets/:id
+  rpc GetPet(GetPetRequest) returns (Pet);
+  rpc DeletePet(GetPetRequest) returns (google.protobuf.Empty);
+}
+
+message GetPetRequest {
+  string id = 1;
+}
+
+// Pet is a pet.
+message Pet {
+  reserved 2;
+  reserved "name";
+
+  // The ID of the pet.
+  int64 id = 1 [json_name = "ID"];
+  repeated string tags = 3;
+  google.protobuf.Timestamp born_at = 4;
+  optional string nickname = 5;
+  Owner owner = 6;
+
+  message Owner {
+    string name = 1;
+  }
+}
+
+enum Status {
+  reserved 1;
+  reserved "STATUS_AVAILABLE";
+
+  STATUS_UNSPECIFIED = 0;
+  STATUS_SOLD = 2;
+}
+`, f.write(fieldNumbers))
+}
+
+func TestWriteWithoutRoutes(t *testing.T) {
+	f := file{
+		Package:  "api",
+		Service:  service{Name: "APIService"},
+		Messages: []*message{{Name: "Empty", FullName: "Empty"}},
+	}
+
+	require.Equal(t, "// Code generated by astra. DO NOT EDIT.\n\nsyntax = \"proto3\";\n\npackage api;\n\nmessage Empty {\n}\n", f.write(make(FieldNumbers)))
+}
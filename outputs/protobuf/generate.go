@@ -0,0 +1,62 @@
+package protobuf
+
+import (
+	"os"
+	"path"
+	"strings"
+
+	"github.com/ls6-events/astra"
+)
+
+const (
+	// fileSuffix is the suffix of the .proto file.
+	fileSuffix = ".proto"
+	// defaultPackageName is the package of the .proto file if the name of the file can't be a package.
+	defaultPackageName = "api"
+)
+
+// Generate generates the Protocol Buffers output.
+// It writes a .proto file with a message for every struct component and an enum for every enum component, and a service with an rpc for every route.
+// The fields keep their numbers across generations, as the numbers are stored in the configuration of the output, which is cached, and the numbers of removed fields are reserved.
+func Generate(filePath string, options Options, configuration astra.IOConfiguration) astra.ServiceFunction {
+	return func(s *astra.Service) error {
+		s.Log.Info().Msg("Generating Protocol Buffers output")
+		if !strings.HasSuffix(filePath, fileSuffix) {
+			filePath += fileSuffix
+		}
+
+		if options.PackageName == "" {
+			options.PackageName = packageName(strings.TrimSuffix(path.Base(filePath), fileSuffix), defaultPackageName)
+		}
+
+		// The field numbers of the options are kept over those that were generated before, so they can be picked
+		fieldNumbers := ParseFieldNumbers(configuration[astra.IOConfigurationKeyFieldNumbers])
+		for message, fields := range options.FieldNumbers {
+			if _, ok := fieldNumbers[message]; !ok {
+				fieldNumbers[message] = make(map[string]int, len(fields))
+			}
+			for field, number := range fields {
+				fieldNumbers[message][field] = number
+			}
+		}
+
+		b := newBuilder(s, fieldNumbers)
+		b.build()
+		b.buildService()
+		b.file.Package = options.PackageName
+		content := b.file.write(fieldNumbers)
+
+		// The field numbers are stored in the configuration, so they are cached with it and read again the next time the output is generated
+		configuration[astra.IOConfigurationKeyFieldNumbers] = fieldNumbers
+
+		s.Log.Debug().Str("filePath", filePath).Msg("Writing Protocol Buffers file")
+		err := os.WriteFile(path.Join(s.WorkDir, filePath), []byte(content), 0644)
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to write Protocol Buffers file")
+			return err
+		}
+
+		s.Log.Info().Msg("Generated Protocol Buffers output")
+		return nil
+	}
+}
@@ -0,0 +1,521 @@
+package protobuf
+
+import (
+	"fmt"
+	"math"
+	"path"
+	"strings"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
+)
+
+const (
+	// emptyType is the type of the request or response of an rpc that has none.
+	emptyType = "google.protobuf.Empty"
+	// listValueType is the type of a list that can't be a repeated field (i.e. the items of a slice of slices).
+	listValueType = "google.protobuf.ListValue"
+	// structType is the type of an object that has no message (i.e. the values of a map of maps).
+	structType = "google.protobuf.Struct"
+	// valueType is the type of a value that could be anything.
+	valueType = "google.protobuf.Value"
+	// timestampType is the type of a time.Time.
+	timestampType = "google.protobuf.Timestamp"
+	// durationType is the type of a time.Duration.
+	durationType = "google.protobuf.Duration"
+)
+
+// scalarTypes are the scalar types of Protocol Buffers of the Go types.
+// The types that can't be their own scalar types are the next largest type (i.e. int32 for int8), and int is int64.
+var scalarTypes = map[string]string{
+	"string":  "string",
+	"bool":    "bool",
+	"int":     "int64",
+	"int8":    "int32",
+	"int16":   "int32",
+	"int32":   "int32",
+	"int64":   "int64",
+	"rune":    "int32",
+	"uint":    "uint64",
+	"uint8":   "uint32",
+	"uint16":  "uint32",
+	"uint32":  "uint32",
+	"uint64":  "uint64",
+	"byte":    "uint32",
+	"float":   "float",
+	"float32": "float",
+	"float64": "double",
+	"file":    "bytes",
+}
+
+// mapKeyTypes are the scalar types that can be the keys of a map, as the keys of other types are strings.
+var mapKeyTypes = map[string]bool{
+	"string": true, "bool": true, "int32": true, "int64": true, "uint32": true, "uint64": true,
+}
+
+// protoType is the type of a field of a message, which can be repeated.
+type protoType struct {
+	Name     string
+	Repeated bool
+}
+
+// isMap returns whether the type is a map.
+func (t protoType) isMap() bool {
+	return strings.HasPrefix(t.Name, "map<")
+}
+
+// element returns the type as the items of a repeated field or the values of a map, which can't be repeated fields or maps themselves, so they are lists and objects.
+func (t protoType) element() string {
+	switch {
+	case t.Repeated:
+		return listValueType
+	case t.isMap():
+		return structType
+	}
+
+	return t.Name
+}
+
+// structField is a field of a struct, by its name in a binding (i.e. its JSON name).
+type structField struct {
+	Name  string
+	Field astra.Field
+}
+
+// builder builds the messages and enums of the components of a service, and the service of its routes.
+type builder struct {
+	service *astra.Service
+	numbers FieldNumbers
+	// names are the names that are taken in the package.
+	names *uniqueNames
+	// components are the components that are messages or enums, and types are their names, by the packages and names of the components (i.e. main.Pet).
+	components map[string]astra.Field
+	types      map[string]string
+	// messages and enums are the names of the messages and enums of the components.
+	messages map[string]bool
+	enums    map[string]bool
+	// resolving are the components that are named types (i.e. type Tags []string) that are being resolved, so they can't resolve to themselves.
+	resolving map[string]bool
+
+	file file
+}
+
+// newBuilder creates the builder of a service, naming the messages and enums of its components.
+// Components of different packages that have the same name are named by their packages too (i.e. PetsPet and UsersPet).
+func newBuilder(s *astra.Service, numbers FieldNumbers) *builder {
+	b := &builder{
+		service:    s,
+		numbers:    numbers,
+		names:      newUniqueNames(),
+		components: make(map[string]astra.Field),
+		types:      make(map[string]string),
+		messages:   make(map[string]bool),
+		enums:      make(map[string]bool),
+		resolving:  make(map[string]bool),
+	}
+
+	counts := make(map[string]int)
+	for _, component := range s.Components {
+		if !b.isMessage(component) && !b.isEnum(component) {
+			continue
+		}
+		b.components[componentKey(component.Package, component.Name)] = component
+		counts[protoName(component.Name)]++
+	}
+
+	for _, key := range sortedKeys(b.components) {
+		component := b.components[key]
+		name := protoName(component.Name)
+		if counts[name] > 1 {
+			name = protoName(path.Base(component.Package) + "_" + component.Name)
+		}
+		b.types[key] = b.names.unique(name)
+	}
+
+	return b
+}
+
+// componentKey is the key of a component in the types of the builder, by its package and name.
+func componentKey(pkg string, name string) string {
+	return pkg + "." + name
+}
+
+// isMessage returns whether a component is a message, which are the structs that aren't mapped to other types (i.e. time.Time).
+func (b *builder) isMessage(component astra.Field) bool {
+	return component.Type == "struct" && !b.isMapped(component)
+}
+
+// isEnum returns whether a component is an enum, which are the types that have enum values and aren't mapped to other types.
+func (b *builder) isEnum(component astra.Field) bool {
+	return len(component.EnumValues) > 0 && !b.isMapped(component)
+}
+
+// isMapped returns whether a component is mapped to another type, by the predefined and custom type mappings or as a well-known type.
+func (b *builder) isMapped(component astra.Field) bool {
+	if _, ok := wellKnownType(component.Package, component.Name); ok {
+		return true
+	}
+	_, ok := b.service.GetTypeMapping(component.Name, component.Package)
+	return ok
+}
+
+// wellKnownType returns the well-known type of a Go type, which are those of time.Time and time.Duration.
+func wellKnownType(pkg string, name string) (string, bool) {
+	if pkg != "time" {
+		return "", false
+	}
+
+	switch name {
+	case "Time":
+		return timestampType, true
+	case "Duration":
+		return durationType, true
+	}
+
+	return "", false
+}
+
+// component returns the component of a type by its package and name.
+func (b *builder) component(pkg string, name string) (astra.Field, bool) {
+	for _, component := range b.service.Components {
+		if component.Package == pkg && component.Name == name {
+			return component, true
+		}
+	}
+
+	return astra.Field{}, false
+}
+
+// build builds the messages and enums of the components, by their names.
+func (b *builder) build() {
+	for _, key := range sortedKeys(b.components) {
+		component := b.components[key]
+		if b.isEnum(component) {
+			b.enums[b.types[key]] = true
+			b.file.Enums = append(b.file.Enums, b.enum(b.types[key], component))
+		} else {
+			b.messages[b.types[key]] = true
+		}
+	}
+
+	for _, key := range sortedKeys(b.components) {
+		component := b.components[key]
+		if !b.messages[b.types[key]] {
+			continue
+		}
+
+		b.file.Messages = append(b.file.Messages, b.structMessage(b.types[key], b.types[key], component.Doc, component.StructFields, astTraversal.JSONBindingTag))
+	}
+}
+
+// enum builds the enum of a component, with a value for every enum value that is named by its name in Go if it has one (i.e. StatusAvailable).
+// The values of int enums are their numbers if they are free, and enums that have no value that is 0 have an UNSPECIFIED value that is, as the first value of an enum is its default.
+func (b *builder) enum(name string, component astra.Field) *enum {
+	e := &enum{Name: name, Doc: component.Doc}
+
+	valueNames := newUniqueNames()
+	var zero string
+	labels := make([]string, len(component.EnumValues))
+	for i, value := range component.EnumValues {
+		labels[i] = fmt.Sprint(value)
+		if i < len(component.EnumNames) && component.EnumNames[i] != "" {
+			labels[i] = component.EnumNames[i]
+		}
+		if number, ok := enumNumber(value); ok && number == 0 {
+			zero = valueNames.unique(enumValueName(name, labels[i]))
+		}
+	}
+	if zero == "" {
+		zero = valueNames.unique(enumValueName(name, "unspecified"))
+		e.Values = append(e.Values, enumValue{Name: zero})
+	}
+	b.numbers.zero(name, zero)
+
+	for i, value := range component.EnumValues {
+		preferred := -1
+		if number, ok := enumNumber(value); ok {
+			preferred = number
+		}
+
+		var valueName string
+		if preferred == 0 {
+			valueName = zero
+		} else {
+			valueName = valueNames.unique(enumValueName(name, labels[i]))
+		}
+		e.Values = append(e.Values, enumValue{Name: valueName, Number: b.numbers.preferredNumber(name, valueName, preferred)})
+	}
+
+	return e
+}
+
+// enumNumber returns the number of an int enum value, which is a float64 if the components are loaded from the cache.
+func enumNumber(value any) (int, bool) {
+	switch value := value.(type) {
+	case int:
+		return value, true
+	case int8:
+		return int(value), true
+	case int16:
+		return int(value), true
+	case int32:
+		return int(value), true
+	case int64:
+		return int(value), true
+	case uint:
+		return int(value), true
+	case uint8:
+		return int(value), true
+	case uint16:
+		return int(value), true
+	case uint32:
+		return int(value), true
+	case uint64:
+		return int(value), true
+	case float64:
+		if value == math.Trunc(value) {
+			return int(value), true
+		}
+	}
+
+	return 0, false
+}
+
+// structMessage builds the message of a struct, with a field for every struct field that is shown in the binding.
+// The fields of the structs that it embeds are its fields too, unless it has fields of their names.
+func (b *builder) structMessage(name string, fullName string, doc string, fields map[string]astra.Field, bindingType astTraversal.BindingTagType) *message {
+	m := &message{Name: name, FullName: fullName, Doc: doc}
+
+	fieldNames := newUniqueNames()
+	nestedNames := newUniqueNames()
+	for _, structField := range b.structFields(fields, bindingType, make(map[string]bool), make(map[string]bool)) {
+		b.addField(m, fieldNames, nestedNames, structField.Name, structField.Field, structField.Field.Doc)
+	}
+
+	return m
+}
+
+// addField adds a field to a message, numbering it by its name.
+func (b *builder) addField(m *message, fieldNames *uniqueNames, nestedNames *uniqueNames, name string, f astra.Field, doc string) {
+	protoFieldName := fieldNames.unique(fieldName(name))
+	t := b.typeOf(f, m, protoFieldName, nestedNames)
+	b.addTypedField(m, protoFieldName, name, t, f.IsNullable, doc)
+}
+
+// addTypedField adds a field of a type to a message, numbering it by its name.
+// Nullable scalars and enums are optional, so whether they are set is known, which it already is for messages.
+func (b *builder) addTypedField(m *message, protoFieldName string, name string, t protoType, nullable bool, doc string) {
+	m.Fields = append(m.Fields, field{
+		Name:     protoFieldName,
+		JSONName: name,
+		Type:     t.Name,
+		Number:   b.numbers.number(m.FullName, protoFieldName),
+		Doc:      doc,
+		Repeated: t.Repeated,
+		Optional: nullable && !t.Repeated && !t.isMap() && (isScalar(t.Name) || b.enums[t.Name]),
+	})
+}
+
+// isScalar returns whether a type is a scalar type.
+func isScalar(typeName string) bool {
+	if typeName == "bytes" {
+		return true
+	}
+	for _, scalarType := range scalarTypes {
+		if scalarType == typeName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// structFields returns the fields of a struct that are shown in a binding, by their names in it, and then those of the structs that it embeds.
+func (b *builder) structFields(fields map[string]astra.Field, bindingType astTraversal.BindingTagType, names map[string]bool, embedded map[string]bool) []structField {
+	var result []structField
+	var embeddedFields []astra.Field
+	for _, goName := range sortedKeys(fields) {
+		f := fields[goName]
+		if f.IsEmbedded {
+			embeddedFields = append(embeddedFields, f)
+			continue
+		}
+
+		bindingTag := f.StructFieldBindingTags[bindingType]
+		if bindingTag == (astTraversal.BindingTag{}) {
+			bindingTag = f.StructFieldBindingTags[astTraversal.NoBindingTag]
+		}
+		if bindingTag.NotShown {
+			continue
+		}
+
+		name := bindingTag.Name
+		if name == "" {
+			name = goName
+		}
+		if names[name] {
+			continue
+		}
+		names[name] = true
+
+		result = append(result, structField{Name: name, Field: f})
+	}
+
+	for _, f := range embeddedFields {
+		key := componentKey(f.Package, f.Type)
+		component, ok := b.component(f.Package, f.Type)
+		if !ok || component.Type != "struct" || embedded[key] {
+			continue
+		}
+		embedded[key] = true
+
+		result = append(result, b.structFields(component.StructFields, bindingType, names, embedded)...)
+	}
+
+	return result
+}
+
+// typeOf returns the type of a field, adding the message of an inline struct to the scope that it is in, named by the field.
+// Slices and arrays are repeated fields, except those of bytes, which are bytes.
+func (b *builder) typeOf(f astra.Field, scope *message, name string, nestedNames *uniqueNames) protoType {
+	switch f.Type {
+	case "slice":
+		if isByte(f.SliceType) {
+			return protoType{Name: "bytes"}
+		}
+		return protoType{Name: b.namedType(f.SliceType, f.Package).element(), Repeated: true}
+	case "array":
+		if isByte(f.ArrayType) {
+			return protoType{Name: "bytes"}
+		}
+		return protoType{Name: b.namedType(f.ArrayType, f.Package).element(), Repeated: true}
+	case "map":
+		return protoType{Name: fmt.Sprintf("map<%s, %s>", b.mapKeyType(f), b.mapValueType(f))}
+	case "struct":
+		if len(f.StructFields) == 0 || scope == nil {
+			return protoType{Name: structType}
+		}
+
+		nestedName := nestedNames.unique(protoName(name))
+		scope.Messages = append(scope.Messages, b.structMessage(nestedName, scope.FullName+"."+nestedName, "", f.StructFields, astTraversal.JSONBindingTag))
+		return protoType{Name: nestedName}
+	}
+
+	return b.namedType(f.Type, f.Package)
+}
+
+// isByte returns whether a type is a byte, so slices of it are bytes.
+func isByte(typeName string) bool {
+	return typeName == "byte" || typeName == "uint8"
+}
+
+// mapKeyType returns the type of the keys of a map, which are strings unless they are integers or booleans.
+func (b *builder) mapKeyType(f astra.Field) string {
+	pkg := f.MapKeyPackage
+	if pkg == "" {
+		pkg = f.Package
+	}
+
+	keyType := b.namedType(f.MapKeyType, pkg).Name
+	if !mapKeyTypes[keyType] {
+		return "string"
+	}
+
+	return keyType
+}
+
+// mapValueType returns the type of the values of a map, which are lists if they are slices or arrays.
+func (b *builder) mapValueType(f astra.Field) string {
+	pkg := f.MapValuePackage
+	if pkg == "" {
+		pkg = f.Package
+	}
+
+	switch f.MapValueType {
+	case "slice":
+		if isByte(f.MapValueSliceType) {
+			return "bytes"
+		}
+		return listValueType
+	case "array":
+		if isByte(f.MapValueArrayType) {
+			return "bytes"
+		}
+		return listValueType
+	}
+
+	return b.namedType(f.MapValueType, pkg).element()
+}
+
+// namedType returns the type of a named type (i.e. string, time.Time or a component), by its package and name.
+// Components that aren't messages or enums are the types that they are of (i.e. type Tags []string is repeated string), and types that aren't known could be anything.
+func (b *builder) namedType(name string, pkg string) protoType {
+	if wellKnown, ok := wellKnownType(pkg, name); ok {
+		return protoType{Name: wellKnown}
+	}
+	if scalarType, ok := scalarTypes[name]; ok {
+		return protoType{Name: scalarType}
+	}
+
+	switch name {
+	case "slice":
+		return protoType{Name: listValueType}
+	case "map", "struct":
+		return protoType{Name: structType}
+	case "any", "nil", "":
+		return protoType{Name: valueType}
+	}
+
+	key := componentKey(pkg, name)
+	if typeName, ok := b.types[key]; ok {
+		return protoType{Name: typeName}
+	}
+	if typeFormat, ok := b.service.GetTypeMapping(name, pkg); ok {
+		return protoType{Name: typeFormatType(typeFormat)}
+	}
+	if component, ok := b.component(pkg, name); ok && !b.resolving[key] {
+		b.resolving[key] = true
+		defer delete(b.resolving, key)
+
+		return b.typeOf(component, nil, name, nil)
+	}
+
+	return protoType{Name: valueType}
+}
+
+// typeFormatType returns the type of a type mapping (i.e. string for uuid.UUID, or google.protobuf.Timestamp for a date-time).
+func typeFormatType(typeFormat astra.TypeFormat) string {
+	switch typeFormat.Type {
+	case "string":
+		switch typeFormat.Format {
+		case "date-time":
+			return timestampType
+		case "binary":
+			return "bytes"
+		}
+		return "string"
+	case "integer":
+		switch typeFormat.Format {
+		case "int8", "int16", "int32":
+			return "int32"
+		case "uint8", "uint16", "uint32":
+			return "uint32"
+		case "uint", "uint64":
+			return "uint64"
+		}
+		return "int64"
+	case "number":
+		if typeFormat.Format == "float" || typeFormat.Format == "float32" {
+			return "float"
+		}
+		return "double"
+	case "boolean":
+		return "bool"
+	case "object":
+		return structType
+	case "array":
+		return listValueType
+	}
+
+	return valueType
+}
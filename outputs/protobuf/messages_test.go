@@ -0,0 +1,173 @@
+package protobuf
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
+	"github.com/stretchr/testify/require"
+)
+
+// jsonField is a struct field with a JSON name.
+func jsonField(name string, field astra.Field) astra.Field {
+	field.StructFieldBindingTags = astTraversal.BindingTagMap{
+		astTraversal.JSONBindingTag: {Name: name},
+	}
+
+	return field
+}
+
+// findField finds a field of a message by its name.
+func findField(t *testing.T, m *message, name string) field {
+	t.Helper()
+
+	for _, f := range m.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	require.Failf(t, "field not found", "message %s has no field %s", m.Name, name)
+
+	return field{}
+}
+
+// findMessage finds a message of a file by its name.
+func findMessage(t *testing.T, f file, name string) *message {
+	t.Helper()
+
+	for _, m := range f.Messages {
+		if m.Name == name {
+			return m
+		}
+	}
+	require.Failf(t, "message not found", "file has no message %s", name)
+
+	return nil
+}
+
+func TestBuild(t *testing.T) {
+	s := &astra.Service{
+		Components: []astra.Field{
+			{Package: "main", Name: "Animal", Type: "struct", StructFields: map[string]astra.Field{
+				"Legs": jsonField("legs", astra.Field{Type: "int"}),
+				"Name": jsonField("animalName", astra.Field{Type: "string"}),
+			}},
+			{Package: "main", Name: "Pet", Type: "struct", Doc: "Pet is a pet in the store.", StructFields: map[string]astra.Field{
+				"Animal":   {Package: "main", Type: "Animal", IsEmbedded: true},
+				"Name":     jsonField("name", astra.Field{Type: "string", Doc: "The name of the pet."}),
+				"Age":      jsonField("age", astra.Field{Type: "uint8", IsNullable: true}),
+				"Tags":     jsonField("tags", astra.Field{Package: "main", Type: "Tags"}),
+				"Grid":     jsonField("grid", astra.Field{Package: "main", Type: "slice", SliceType: "slice"}),
+				"Scores":   jsonField("scores", astra.Field{Package: "main", Type: "map", MapKeyType: "int", MapValueType: "float64"}),
+				"Extra":    jsonField("extra", astra.Field{Package: "main", Type: "map", MapKeyType: "Status", MapValueType: "map"}),
+				"Friends":  jsonField("friends", astra.Field{Package: "main", Type: "slice", SliceType: "Pet"}),
+				"Status":   jsonField("status", astra.Field{Package: "main", Type: "Status", IsNullable: true}),
+				"Owner":    jsonField("owner", astra.Field{Package: "main", Type: "Pet", IsNullable: true}),
+				"ID":       jsonField("id", astra.Field{Package: "github.com/google/uuid", Type: "UUID"}),
+				"Metadata": jsonField("metadata", astra.Field{Type: "any"}),
+				"Hidden": {Type: "string", StructFieldBindingTags: astTraversal.BindingTagMap{
+					astTraversal.JSONBindingTag: {NotShown: true},
+				}},
+				"Untagged": {Type: "bool", StructFieldBindingTags: astTraversal.BindingTagMap{
+					astTraversal.NoBindingTag: {Name: "Untagged"},
+				}},
+			}},
+			{Package: "main", Name: "Tags", Type: "slice", SliceType: "string"},
+			{Package: "main", Name: "Status", Type: "string", EnumValues: []any{"available", "sold"}, EnumNames: []string{"StatusAvailable", ""}},
+			{Package: "main", Name: "Priority", Type: "int", EnumValues: []any{float64(0), float64(5)}, EnumNames: []string{"PriorityNone", "PriorityHigh"}},
+			{Package: "github.com/example/users", Name: "User", Type: "struct"},
+			{Package: "github.com/example/admins", Name: "User", Type: "struct"},
+			{Package: "time", Name: "Time", Type: "struct"},
+		},
+	}
+
+	b := newBuilder(s, make(FieldNumbers))
+	b.build()
+
+	t.Run("it builds a message for every struct component", func(t *testing.T) {
+		names := make([]string, 0, len(b.file.Messages))
+		for _, m := range b.file.Messages {
+			names = append(names, m.Name)
+		}
+		require.ElementsMatch(t, []string{"Animal", "Pet", "AdminsUser", "UsersUser"}, names)
+	})
+
+	t.Run("it maps the types of the fields", func(t *testing.T) {
+		pet := findMessage(t, b.file, "Pet")
+		require.Equal(t, "Pet is a pet in the store.", pet.Doc)
+		require.Equal(t, field{Name: "name", JSONName: "name", Type: "string", Number: findField(t, pet, "name").Number, Doc: "The name of the pet."}, findField(t, pet, "name"))
+		require.Equal(t, "uint32", findField(t, pet, "age").Type)
+		require.True(t, findField(t, pet, "age").Optional)
+		require.Equal(t, "string", findField(t, pet, "tags").Type)
+		require.True(t, findField(t, pet, "tags").Repeated)
+		require.Equal(t, listValueType, findField(t, pet, "grid").Type)
+		require.True(t, findField(t, pet, "grid").Repeated)
+		require.Equal(t, "map<int64, double>", findField(t, pet, "scores").Type)
+		require.Equal(t, "map<string, google.protobuf.Struct>", findField(t, pet, "extra").Type)
+		require.Equal(t, "Pet", findField(t, pet, "friends").Type)
+		require.Equal(t, "Status", findField(t, pet, "status").Type)
+		require.True(t, findField(t, pet, "status").Optional)
+		require.Equal(t, "Pet", findField(t, pet, "owner").Type)
+		require.False(t, findField(t, pet, "owner").Optional)
+		require.Equal(t, "string", findField(t, pet, "id").Type)
+		require.Equal(t, valueType, findField(t, pet, "metadata").Type)
+		require.Equal(t, "bool", findField(t, pet, "untagged").Type)
+	})
+
+	t.Run("it flattens embedded structs", func(t *testing.T) {
+		pet := findMessage(t, b.file, "Pet")
+		require.Equal(t, "int64", findField(t, pet, "legs").Type)
+		require.Equal(t, field{Name: "animal_name", JSONName: "animalName", Type: "string", Number: findField(t, pet, "animal_name").Number}, findField(t, pet, "animal_name"))
+	})
+
+	t.Run("it skips fields that aren't shown", func(t *testing.T) {
+		pet := findMessage(t, b.file, "Pet")
+		require.Len(t, pet.Fields, 14)
+	})
+
+	t.Run("it builds an enum for every enum component", func(t *testing.T) {
+		require.ElementsMatch(t, []*enum{
+			{Name: "Priority", Values: []enumValue{{Name: "PRIORITY_NONE", Number: 0}, {Name: "PRIORITY_HIGH", Number: 5}}},
+			{Name: "Status", Values: []enumValue{{Name: "STATUS_UNSPECIFIED", Number: 0}, {Name: "STATUS_AVAILABLE", Number: 1}, {Name: "STATUS_SOLD", Number: 2}}},
+		}, b.file.Enums)
+	})
+}
+
+func TestInlineStruct(t *testing.T) {
+	s := &astra.Service{
+		Components: []astra.Field{
+			{Package: "main", Name: "Pet", Type: "struct", StructFields: map[string]astra.Field{
+				"Owner": jsonField("owner", astra.Field{Type: "struct", StructFields: map[string]astra.Field{
+					"Name": jsonField("name", astra.Field{Type: "string"}),
+				}}),
+				"Created": jsonField("created", astra.Field{Package: "time", Type: "Time"}),
+				"Timeout": jsonField("timeout", astra.Field{Package: "time", Type: "Duration"}),
+				"Photo":   jsonField("photo", astra.Field{Type: "slice", SliceType: "byte"}),
+			}},
+		},
+	}
+
+	b := newBuilder(s, make(FieldNumbers))
+	b.build()
+
+	pet := findMessage(t, b.file, "Pet")
+	require.Equal(t, "Owner", findField(t, pet, "owner").Type)
+	require.Equal(t, []*message{{Name: "Owner", FullName: "Pet.Owner", Fields: []field{{Name: "name", JSONName: "name", Type: "string", Number: 1}}}}, pet.Messages)
+	require.Equal(t, timestampType, findField(t, pet, "created").Type)
+	require.Equal(t, durationType, findField(t, pet, "timeout").Type)
+	require.Equal(t, "bytes", findField(t, pet, "photo").Type)
+	require.False(t, findField(t, pet, "photo").Repeated)
+}
+
+func TestTypeFormatType(t *testing.T) {
+	require.Equal(t, timestampType, typeFormatType(astra.TypeFormat{Type: "string", Format: "date-time"}))
+	require.Equal(t, "string", typeFormatType(astra.TypeFormat{Type: "string", Format: "uuid"}))
+	require.Equal(t, "bytes", typeFormatType(astra.TypeFormat{Type: "string", Format: "binary"}))
+	require.Equal(t, "int32", typeFormatType(astra.TypeFormat{Type: "integer", Format: "int32"}))
+	require.Equal(t, "uint64", typeFormatType(astra.TypeFormat{Type: "integer", Format: "uint"}))
+	require.Equal(t, "int64", typeFormatType(astra.TypeFormat{Type: "integer"}))
+	require.Equal(t, "float", typeFormatType(astra.TypeFormat{Type: "number", Format: "float32"}))
+	require.Equal(t, "double", typeFormatType(astra.TypeFormat{Type: "number"}))
+	require.Equal(t, "bool", typeFormatType(astra.TypeFormat{Type: "boolean"}))
+	require.Equal(t, valueType, typeFormatType(astra.TypeFormat{}))
+}
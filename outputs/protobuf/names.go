@@ -0,0 +1,122 @@
+package protobuf
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+)
+
+// invalidIdentifierCharacters are the characters that can't be in a Protocol Buffers identifier.
+var invalidIdentifierCharacters = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// words splits a name (i.e. petID, X-Request-ID or Page[main.Pet]) into its lower case words (i.e. pet and id).
+func words(name string) []string {
+	var result []string
+	for _, word := range strings.Split(strcase.ToSnake(invalidIdentifierCharacters.ReplaceAllString(name, "_")), "_") {
+		if word != "" {
+			result = append(result, word)
+		}
+	}
+
+	return result
+}
+
+// protoName makes a name into the name of a message, enum, service or rpc, which is in upper camel case (i.e. PetStatus or GetPetRequest).
+// A name that starts with a digit has an X added to it, so it is still an identifier.
+func protoName(name string) string {
+	var b strings.Builder
+	for _, word := range words(name) {
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+
+	identifier := b.String()
+	if identifier == "" || (identifier[0] >= '0' && identifier[0] <= '9') {
+		identifier = "X" + identifier
+	}
+
+	return identifier
+}
+
+// fieldName makes a name (i.e. the JSON name of a struct field) into the name of a field of a message, which is in lower snake case (i.e. pet_id).
+func fieldName(name string) string {
+	identifier := strings.Join(words(name), "_")
+	if identifier == "" || (identifier[0] >= '0' && identifier[0] <= '9') {
+		identifier = "x_" + identifier
+	}
+
+	return strings.TrimSuffix(identifier, "_")
+}
+
+// enumValueName makes a value of an enum into the name of the value, which is in upper snake case and starts with the name of the enum, as the values of the enums of a package share their scope (i.e. PET_STATUS_AVAILABLE).
+func enumValueName(enumName string, value string) string {
+	prefix := strings.ToUpper(fieldName(enumName))
+	name := strings.ToUpper(strings.Join(words(value), "_"))
+	if name == prefix || strings.HasPrefix(name, prefix+"_") {
+		return name
+	}
+	if name == "" {
+		return prefix + "_VALUE"
+	}
+
+	return prefix + "_" + name
+}
+
+// jsonName returns the name that a field of a message has in JSON by default, which is its name in lower camel case (i.e. petId for pet_id).
+// The JSON name of a field is only written if it is different, so the JSON of a message is the same as that of the API.
+func jsonName(name string) string {
+	var b strings.Builder
+	upper := false
+	for _, r := range name {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper && r >= 'a' && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		upper = false
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// packageName makes a name (i.e. the name of the file) into the package of the .proto file, which is in lower snake case.
+// A name that isn't left with a letter to start with is the fallback name.
+func packageName(name string, fallback string) string {
+	name = strings.Join(words(name), "_")
+	name = strings.TrimLeft(name, "0123456789_")
+	if name == "" {
+		return fallback
+	}
+
+	return name
+}
+
+// uniqueNames are the names that are taken in a scope of the .proto file, so that the names that are added don't replace each other.
+type uniqueNames struct {
+	taken map[string]bool
+}
+
+// newUniqueNames creates the names of a scope, with the names that are already taken in it.
+func newUniqueNames(taken ...string) *uniqueNames {
+	n := &uniqueNames{taken: make(map[string]bool, len(taken))}
+	for _, name := range taken {
+		n.taken[name] = true
+	}
+
+	return n
+}
+
+// unique takes a name that isn't already taken, adding a number to it if it is (i.e. GetPetRequest2).
+func (n *uniqueNames) unique(name string) string {
+	uniqueName := name
+	for i := 2; n.taken[uniqueName]; i++ {
+		uniqueName = fmt.Sprintf("%s%d", name, i)
+	}
+	n.taken[uniqueName] = true
+
+	return uniqueName
+}
@@ -0,0 +1,67 @@
+package protobuf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtoName(t *testing.T) {
+	t.Run("it makes names into upper camel case identifiers", func(t *testing.T) {
+		require.Equal(t, "GetPet", protoName("getPet"))
+		require.Equal(t, "PetForm", protoName("Pet_form"))
+		require.Equal(t, "PetstoreApi", protoName("Petstore API"))
+		require.Equal(t, "PageMainPet", protoName("Page[main.Pet]"))
+	})
+
+	t.Run("it starts names with a letter", func(t *testing.T) {
+		require.Equal(t, "X2Fa", protoName("2fa"))
+		require.Equal(t, "X", protoName("-"))
+	})
+}
+
+func TestFieldName(t *testing.T) {
+	require.Equal(t, "pet_id", fieldName("petID"))
+	require.Equal(t, "x_request_id", fieldName("X-Request-ID"))
+	require.Equal(t, "http_server", fieldName("HTTPServer"))
+	require.Equal(t, "x_2_fa", fieldName("2fa"))
+	require.Equal(t, "x", fieldName("-"))
+}
+
+func TestEnumValueName(t *testing.T) {
+	t.Run("it starts the names of the values with the name of the enum", func(t *testing.T) {
+		require.Equal(t, "PET_STATUS_AVAILABLE", enumValueName("PetStatus", "available"))
+		require.Equal(t, "PET_STATUS_IN_STOCK", enumValueName("PetStatus", "in-stock"))
+		require.Equal(t, "PRIORITY_1", enumValueName("Priority", "1"))
+	})
+
+	t.Run("it doesn't repeat the name of the enum", func(t *testing.T) {
+		require.Equal(t, "PRIORITY_LOW", enumValueName("Priority", "PriorityLow"))
+		require.Equal(t, "PRIORITY", enumValueName("Priority", "priority"))
+	})
+
+	t.Run("it names values that have no name", func(t *testing.T) {
+		require.Equal(t, "PRIORITY_VALUE", enumValueName("Priority", ""))
+	})
+}
+
+func TestJSONName(t *testing.T) {
+	require.Equal(t, "petId", jsonName("pet_id"))
+	require.Equal(t, "bornAt", jsonName("born_at"))
+	require.Equal(t, "x2Fa", jsonName("x_2_fa"))
+	require.Equal(t, "name", jsonName("name"))
+}
+
+func TestPackageName(t *testing.T) {
+	require.Equal(t, "petstore", packageName("petstore", "api"))
+	require.Equal(t, "pet_store", packageName("pet-store", "api"))
+	require.Equal(t, "protobuf", packageName("38-protobuf", "api"))
+	require.Equal(t, "api", packageName("123", "api"))
+}
+
+func TestUniqueNames(t *testing.T) {
+	names := newUniqueNames("Pet")
+	require.Equal(t, "Pet2", names.unique("Pet"))
+	require.Equal(t, "Pet3", names.unique("Pet"))
+	require.Equal(t, "Owner", names.unique("Owner"))
+}
@@ -0,0 +1,133 @@
+package protobuf
+
+import (
+	"math"
+	"sort"
+)
+
+const (
+	// firstReservedNumber and lastReservedNumber are the field numbers that are reserved by Protocol Buffers for its implementation.
+	firstReservedNumber = 19000
+	lastReservedNumber  = 19999
+)
+
+// FieldNumbers are the numbers of the fields of the messages and the values of the enums, by their names, by the full names of the messages and enums (i.e. Pet.Owner).
+// They are stored in the configuration of the output, which is kept in the cache, so the fields keep their numbers when the file is generated again and the numbers of removed fields aren't reused.
+type FieldNumbers map[string]map[string]int
+
+// ParseFieldNumbers parses the field numbers in the configuration of the output, which are decoded as maps of any values if they are loaded from a JSON or YAML cache.
+func ParseFieldNumbers(value any) FieldNumbers {
+	numbers := make(FieldNumbers)
+	switch value := value.(type) {
+	case FieldNumbers:
+		for message, fields := range value {
+			numbers[message] = make(map[string]int, len(fields))
+			for field, number := range fields {
+				numbers[message][field] = number
+			}
+		}
+	case map[string]map[string]int:
+		return ParseFieldNumbers(FieldNumbers(value))
+	case map[string]any:
+		for message, fields := range value {
+			fields, ok := fields.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			numbers[message] = make(map[string]int, len(fields))
+			for field, number := range fields {
+				switch number := number.(type) {
+				case int:
+					numbers[message][field] = number
+				case int64:
+					numbers[message][field] = int(number)
+				case uint64:
+					numbers[message][field] = int(number)
+				case float64:
+					numbers[message][field] = int(number)
+				}
+			}
+		}
+	}
+
+	return numbers
+}
+
+// number returns the number of a field of a message, which is the next number after those of the message if the field has none yet.
+func (n FieldNumbers) number(message string, field string) int {
+	return n.preferredNumber(message, field, -1)
+}
+
+// preferredNumber returns the number of a field of a message, which is the preferred number (i.e. the value of an int enum) if the field has none yet and it is free.
+// Otherwise, it is the next number after those of the message, so the numbers of removed fields aren't reused.
+func (n FieldNumbers) preferredNumber(message string, field string, preferred int) int {
+	fields, ok := n[message]
+	if !ok {
+		fields = make(map[string]int)
+		n[message] = fields
+	}
+
+	if number, ok := fields[field]; ok {
+		return number
+	}
+
+	taken := make(map[int]bool, len(fields))
+	last := 0
+	for _, number := range fields {
+		taken[number] = true
+		if number > last {
+			last = number
+		}
+	}
+
+	number := preferred
+	if number < 0 || number > math.MaxInt32 || taken[number] || isReservedNumber(number) {
+		number = last + 1
+		if isReservedNumber(number) {
+			number = lastReservedNumber + 1
+		}
+	}
+	fields[field] = number
+
+	return number
+}
+
+// reserved returns the numbers and names of the fields of a message that it doesn't have anymore, sorted, so they aren't reused.
+func (n FieldNumbers) reserved(message string, fields map[string]bool) ([]int, []string) {
+	var numbers []int
+	var names []string
+	for field, number := range n[message] {
+		if fields[field] {
+			continue
+		}
+		numbers = append(numbers, number)
+		names = append(names, field)
+	}
+	sort.Ints(numbers)
+	sort.Strings(names)
+
+	return numbers, names
+}
+
+// isReservedNumber returns whether a field number is reserved by Protocol Buffers.
+func isReservedNumber(number int) bool {
+	return number >= firstReservedNumber && number <= lastReservedNumber
+}
+
+// zero gives the value of an enum that is its default value the number 0, as the first value of an enum has to be 0.
+// A value that was 0 before is numbered again if the enum still has it.
+func (n FieldNumbers) zero(enum string, value string) {
+	values, ok := n[enum]
+	if !ok {
+		values = make(map[string]int)
+		n[enum] = values
+	}
+
+	for name, number := range values {
+		if number == 0 && name != value {
+			delete(values, name)
+		}
+	}
+	values[value] = 0
+}
@@ -0,0 +1,66 @@
+package protobuf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFieldNumbers(t *testing.T) {
+	t.Run("it copies field numbers", func(t *testing.T) {
+		fieldNumbers := FieldNumbers{"Pet": {"id": 1}}
+		parsed := ParseFieldNumbers(fieldNumbers)
+		require.Equal(t, fieldNumbers, parsed)
+
+		parsed["Pet"]["name"] = 2
+		require.NotContains(t, fieldNumbers["Pet"], "name")
+	})
+
+	t.Run("it parses the field numbers of a cache", func(t *testing.T) {
+		require.Equal(t, FieldNumbers{"Pet": {"id": 1, "name": 2}}, ParseFieldNumbers(map[string]any{
+			"Pet": map[string]any{"id": float64(1), "name": 2},
+		}))
+		require.Equal(t, FieldNumbers{"Pet": {"id": 1}}, ParseFieldNumbers(map[string]map[string]int{"Pet": {"id": 1}}))
+	})
+
+	t.Run("it ignores anything else", func(t *testing.T) {
+		require.Equal(t, FieldNumbers{}, ParseFieldNumbers(nil))
+		require.Equal(t, FieldNumbers{}, ParseFieldNumbers("Pet"))
+		require.Equal(t, FieldNumbers{}, ParseFieldNumbers(map[string]any{"Pet": 1}))
+	})
+}
+
+func TestFieldNumbers(t *testing.T) {
+	t.Run("it numbers fields after the numbers of the message", func(t *testing.T) {
+		fieldNumbers := FieldNumbers{"Pet": {"id": 1, "legacy_name": 3}}
+		require.Equal(t, 1, fieldNumbers.number("Pet", "id"))
+		require.Equal(t, 4, fieldNumbers.number("Pet", "name"))
+		require.Equal(t, 4, fieldNumbers.number("Pet", "name"))
+		require.Equal(t, 1, fieldNumbers.number("Owner", "name"))
+	})
+
+	t.Run("it skips the numbers that are reserved by Protocol Buffers", func(t *testing.T) {
+		fieldNumbers := FieldNumbers{"Pet": {"id": 18999}}
+		require.Equal(t, 20000, fieldNumbers.number("Pet", "name"))
+	})
+
+	t.Run("it numbers fields by their preferred numbers if they are free", func(t *testing.T) {
+		fieldNumbers := FieldNumbers{"Priority": {"PRIORITY_LOW": 1}}
+		require.Equal(t, 5, fieldNumbers.preferredNumber("Priority", "PRIORITY_HIGH", 5))
+		require.Equal(t, 6, fieldNumbers.preferredNumber("Priority", "PRIORITY_MEDIUM", 1))
+		require.Equal(t, 7, fieldNumbers.preferredNumber("Priority", "PRIORITY_UNKNOWN", 19500))
+	})
+
+	t.Run("it returns the fields that were removed", func(t *testing.T) {
+		fieldNumbers := FieldNumbers{"Pet": {"id": 1, "name": 2, "tag": 3, "legacy_name": 4}}
+		numbers, names := fieldNumbers.reserved("Pet", map[string]bool{"id": true, "name": true})
+		require.Equal(t, []int{3, 4}, numbers)
+		require.Equal(t, []string{"legacy_name", "tag"}, names)
+	})
+
+	t.Run("it numbers the default value of an enum 0", func(t *testing.T) {
+		fieldNumbers := FieldNumbers{"Priority": {"PRIORITY_UNSPECIFIED": 0, "PRIORITY_LOW": 1}}
+		fieldNumbers.zero("Priority", "PRIORITY_NONE")
+		require.Equal(t, map[string]int{"PRIORITY_NONE": 0, "PRIORITY_LOW": 1}, fieldNumbers["Priority"])
+	})
+}
@@ -0,0 +1,42 @@
+package protobuf
+
+// Options are the options of the Protocol Buffers output.
+type Options struct {
+	// PackageName is the package of the .proto file, which is the name of the file by default (i.e. petstore for petstore.proto).
+	PackageName string
+	// FieldNumbers are the numbers that the fields of the messages and the values of the enums start with, which are kept in the cache once they are generated.
+	FieldNumbers FieldNumbers
+}
+
+// Option is an option of the Protocol Buffers output.
+type Option func(*Options)
+
+// NewOptions creates the options of the Protocol Buffers output, with the defaults for those that aren't set.
+// The default package name depends on the file of the output, so it is set when the file is generated.
+func NewOptions(options ...Option) Options {
+	var o Options
+	for _, option := range options {
+		option(&o)
+	}
+
+	if o.FieldNumbers == nil {
+		o.FieldNumbers = make(FieldNumbers)
+	}
+
+	return o
+}
+
+// WithPackageName is an option to pick the package of the .proto file (i.e. petstore.v1).
+func WithPackageName(packageName string) Option {
+	return func(o *Options) {
+		o.PackageName = packageName
+	}
+}
+
+// WithFieldNumbers is an option to pick the numbers of the fields of the messages and the values of the enums (i.e. to keep those of an existing .proto file).
+// The fields and values that aren't in them are numbered after them.
+func WithFieldNumbers(fieldNumbers FieldNumbers) Option {
+	return func(o *Options) {
+		o.FieldNumbers = fieldNumbers
+	}
+}
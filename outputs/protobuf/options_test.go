@@ -0,0 +1,18 @@
+package protobuf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOptions(t *testing.T) {
+	t.Run("it leaves the package name to the file by default", func(t *testing.T) {
+		require.Equal(t, Options{FieldNumbers: FieldNumbers{}}, NewOptions())
+	})
+
+	t.Run("it applies the options", func(t *testing.T) {
+		fieldNumbers := FieldNumbers{"Pet": {"id": 1}}
+		require.Equal(t, Options{PackageName: "petstore.v1", FieldNumbers: fieldNumbers}, NewOptions(WithPackageName("petstore.v1"), WithFieldNumbers(fieldNumbers)))
+	})
+}
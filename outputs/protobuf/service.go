@@ -0,0 +1,182 @@
+package protobuf
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
+)
+
+// defaultServiceName is the name of the service if the API has no title.
+const defaultServiceName = "API"
+
+// buildService builds the service of the routes, with an rpc for every route that is named by its operation ID.
+// Webhooks aren't rpcs, as they are requests that the API sends.
+func (b *builder) buildService() {
+	name := defaultServiceName
+	if b.service.Config != nil && b.service.Config.Title != "" {
+		name = protoName(b.service.Config.Title)
+	}
+	b.file.Service.Name = b.names.unique(name + "Service")
+	if b.service.Config != nil {
+		b.file.Service.Doc = b.service.Config.Description
+	}
+
+	routes := make([]astra.Route, 0, len(b.service.Routes))
+	for _, route := range b.service.Routes {
+		if route.Webhook == "" {
+			routes = append(routes, route)
+		}
+	}
+	sort.SliceStable(routes, func(i, j int) bool {
+		if routes[i].Path != routes[j].Path {
+			return routes[i].Path < routes[j].Path
+		}
+		return routes[i].Method < routes[j].Method
+	})
+
+	rpcNames := newUniqueNames()
+	for _, route := range routes {
+		operationID := route.OperationID
+		if operationID == "" {
+			operationID = defaultOperationID(route.Method, route.Path)
+		}
+		name := rpcNames.unique(protoName(operationID))
+
+		doc := strings.TrimSpace(route.Doc)
+		if doc == "" {
+			doc = route.Summary
+		}
+		if doc != "" {
+			doc += "\n"
+		}
+		doc += route.Method + " " + route.Path
+
+		b.file.Service.RPCs = append(b.file.Service.RPCs, rpc{
+			Name:     name,
+			Doc:      doc,
+			Request:  b.request(name, route),
+			Response: b.response(name, route),
+		})
+	}
+}
+
+// defaultOperationID is the operation ID of a route that has none, as it is in the OpenAPI specification (i.e. getPetsId).
+func defaultOperationID(method string, endpointPath string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return ' '
+	}, strings.ToLower(method)+" "+endpointPath)
+}
+
+// request returns the request message of an rpc, which is google.protobuf.Empty if the route has no params or body.
+// A route that only has a struct body has it as its request, otherwise the request has a field for every param, one for every field of a bound params struct, and one for every body.
+func (b *builder) request(name string, route astra.Route) string {
+	if len(route.PathParams) == 0 && len(route.QueryParams) == 0 && len(route.Body) == 0 {
+		return emptyType
+	}
+	if len(route.PathParams) == 0 && len(route.QueryParams) == 0 && len(route.Body) == 1 {
+		if typeName, ok := b.messageOf(route.Body[0].Field); ok && route.Body[0].IsBound && !route.Body[0].IsArray && !route.Body[0].IsMap {
+			return typeName
+		}
+	}
+
+	requestName := b.names.unique(name + "Request")
+	m := &message{Name: requestName, FullName: requestName}
+	fieldNames := newUniqueNames()
+	nestedNames := newUniqueNames()
+	for _, param := range route.PathParams {
+		b.addParamFields(m, fieldNames, nestedNames, param, astTraversal.URIBindingTag)
+	}
+	for _, param := range route.QueryParams {
+		b.addParamFields(m, fieldNames, nestedNames, param, astTraversal.FormBindingTag)
+	}
+	for _, body := range route.Body {
+		// A bound body has no name, so its field is named by its type (i.e. pet)
+		bodyName := body.Name
+		if bodyName == "" {
+			bodyName = body.Field.Type
+		}
+		protoFieldName := fieldNames.unique(fieldName(bodyName))
+		t := b.typeOf(body.Field, m, protoFieldName, nestedNames)
+		b.addTypedField(m, protoFieldName, body.Name, wrap(t, body.IsArray, body.IsMap), false, "")
+	}
+	b.file.Messages = append(b.file.Messages, m)
+
+	return requestName
+}
+
+// addParamFields adds the fields of a param to a request, which are those of its struct if it is bound.
+// Params that aren't required are optional, so whether they are set is known.
+func (b *builder) addParamFields(m *message, fieldNames *uniqueNames, nestedNames *uniqueNames, param astra.Param, bindingType astTraversal.BindingTagType) {
+	if param.IsBound {
+		component, ok := b.component(param.Field.Package, param.Field.Type)
+		if ok && component.Type == "struct" {
+			for _, structField := range b.structFields(component.StructFields, bindingType, make(map[string]bool), make(map[string]bool)) {
+				b.addField(m, fieldNames, nestedNames, structField.Name, structField.Field, structField.Field.Doc)
+			}
+			return
+		}
+	}
+
+	protoFieldName := fieldNames.unique(fieldName(param.Name))
+	t := b.typeOf(param.Field, m, protoFieldName, nestedNames)
+	b.addTypedField(m, protoFieldName, param.Name, wrap(t, param.IsArray, param.IsMap), !param.IsRequired, param.Doc)
+}
+
+// wrap returns the type of a param or body that is an array or a map of a type.
+func wrap(t protoType, isArray bool, isMap bool) protoType {
+	switch {
+	case isArray:
+		return protoType{Name: t.element(), Repeated: true}
+	case isMap:
+		return protoType{Name: "map<string, " + t.element() + ">"}
+	}
+
+	return t
+}
+
+// response returns the response message of an rpc, which is that of its first successful return type.
+// A route that returns a struct has it as its response, otherwise the response has an items field if it is a list or a value field if it isn't, and it is google.protobuf.Empty if there is nothing.
+func (b *builder) response(name string, route astra.Route) string {
+	var returnType *astra.ReturnType
+	for i := range route.ReturnTypes {
+		if route.ReturnTypes[i].StatusCode >= 200 && route.ReturnTypes[i].StatusCode < 300 {
+			returnType = &route.ReturnTypes[i]
+			break
+		}
+	}
+	if returnType == nil || returnType.Field.Type == "" || returnType.Field.Type == "nil" {
+		return emptyType
+	}
+	if typeName, ok := b.messageOf(returnType.Field); ok {
+		return typeName
+	}
+
+	responseName := b.names.unique(name + "Response")
+	m := &message{Name: responseName, FullName: responseName}
+	t := b.typeOf(returnType.Field, m, "value", newUniqueNames())
+	protoFieldName := "value"
+	if t.Repeated {
+		protoFieldName = "items"
+	}
+	b.addTypedField(m, protoFieldName, protoFieldName, t, false, returnType.Description)
+	b.file.Messages = append(b.file.Messages, m)
+
+	return responseName
+}
+
+// messageOf returns the message of a field if it is a struct component, so it can be a request or response itself.
+func (b *builder) messageOf(f astra.Field) (string, bool) {
+	switch f.Type {
+	case "slice", "array", "map", "struct":
+		return "", false
+	}
+
+	t := b.namedType(f.Type, f.Package)
+	return t.Name, !t.Repeated && b.messages[t.Name]
+}
@@ -0,0 +1,93 @@
+package protobuf
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/astTraversal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildService(t *testing.T) {
+	s := &astra.Service{
+		Config: &astra.Config{Title: "Petstore", Description: "The petstore API."},
+		Components: []astra.Field{
+			{Package: "main", Name: "Pet", Type: "struct", StructFields: map[string]astra.Field{
+				"Name": jsonField("name", astra.Field{Type: "string"}),
+			}},
+			{Package: "main", Name: "PetQuery", Type: "struct", StructFields: map[string]astra.Field{
+				"Limit": {Type: "int", StructFieldBindingTags: astTraversal.BindingTagMap{
+					astTraversal.FormBindingTag: {Name: "limit"},
+				}},
+			}},
+		},
+		Routes: []astra.Route{
+			{
+				Method:      "GET",
+				Path:        "/pets",
+				OperationID: "listPets",
+				Doc:         "listPets lists the pets.",
+				QueryParams: []astra.Param{{Field: astra.Field{Package: "main", Type: "PetQuery"}, IsBound: true}},
+				ReturnTypes: []astra.ReturnType{{StatusCode: 200, Field: astra.Field{Package: "main", Type: "slice", SliceType: "Pet"}}},
+			},
+			{
+				Method:      "POST",
+				Path:        "/pets",
+				Body:        []astra.BodyParam{{Field: astra.Field{Package: "main", Type: "Pet"}, IsBound: true}},
+				ReturnTypes: []astra.ReturnType{{StatusCode: 400, Field: astra.Field{Type: "string"}}, {StatusCode: 201, Field: astra.Field{Package: "main", Type: "Pet"}}},
+			},
+			{
+				Method:      "PUT",
+				Path:        "/pets/:id",
+				PathParams:  []astra.Param{{Name: "id", Field: astra.Field{Type: "string"}, IsRequired: true}},
+				Body:        []astra.BodyParam{{Field: astra.Field{Package: "main", Type: "Pet"}, IsBound: true}},
+				ReturnTypes: []astra.ReturnType{{StatusCode: 200, Field: astra.Field{Type: "string"}}},
+			},
+			{
+				Method: "DELETE",
+				Path:   "/pets/:id",
+			},
+			{
+				Method:  "POST",
+				Path:    "/webhooks/pet",
+				Webhook: "petAdded",
+			},
+		},
+	}
+
+	b := newBuilder(s, make(FieldNumbers))
+	b.build()
+	b.buildService()
+
+	t.Run("it names the service by the title of the API", func(t *testing.T) {
+		require.Equal(t, "PetstoreService", b.file.Service.Name)
+		require.Equal(t, "The petstore API.", b.file.Service.Doc)
+	})
+
+	t.Run("it builds an rpc for every route", func(t *testing.T) {
+		require.Equal(t, []rpc{
+			{Name: "ListPets", Doc: "listPets lists the pets.\nGET /pets", Request: "ListPetsRequest", Response: "ListPetsResponse"},
+			{Name: "PostPets", Doc: "POST /pets", Request: "Pet", Response: "Pet"},
+			{Name: "DeletePetsId", Doc: "DELETE /pets/:id", Request: emptyType, Response: emptyType},
+			{Name: "PutPetsId", Doc: "PUT /pets/:id", Request: "PutPetsIdRequest", Response: "PutPetsIdResponse"},
+		}, b.file.Service.RPCs)
+	})
+
+	t.Run("it builds the requests and responses of the rpcs", func(t *testing.T) {
+		require.Equal(t, []field{{Name: "limit", JSONName: "limit", Type: "int64", Number: 1}}, findMessage(t, b.file, "ListPetsRequest").Fields)
+		require.Equal(t, []field{{Name: "items", JSONName: "items", Type: "Pet", Number: 1, Repeated: true}}, findMessage(t, b.file, "ListPetsResponse").Fields)
+		require.Equal(t, []field{
+			{Name: "id", JSONName: "id", Type: "string", Number: 1},
+			{Name: "pet", Type: "Pet", Number: 2},
+		}, findMessage(t, b.file, "PutPetsIdRequest").Fields)
+		require.Equal(t, []field{{Name: "value", JSONName: "value", Type: "string", Number: 1}}, findMessage(t, b.file, "PutPetsIdResponse").Fields)
+	})
+}
+
+func TestBuildServiceWithoutTitle(t *testing.T) {
+	b := newBuilder(&astra.Service{}, make(FieldNumbers))
+	b.buildService()
+
+	require.Equal(t, "APIService", b.file.Service.Name)
+	require.Empty(t, b.file.Service.RPCs)
+}
@@ -0,0 +1,196 @@
+package typescript
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/outputs/openapi"
+)
+
+// clientRuntime is the code of the client that sends the requests of the operations with fetch, so the client has no dependencies.
+// The requests are objects of the path, query and header params and the body, and the responses are objects of the status code and the decoded body.
+var clientRuntime = template.Must(template.New("client").Parse(`
+/** The signature of fetch, so that any implementation of it can send the requests (i.e. node-fetch or a mock). */
+export type {{.Fetch}} = (input: string, init: RequestInit) => Promise<Response>;
+
+/** The options of the client. */
+export interface {{.Options}} {
+  /** The URL of the server that the requests are sent to (i.e. https://api.example.com), which is relative to the current origin if it is empty. */
+  baseUrl?: string;
+  /** The fetch implementation that sends the requests, which is the global fetch by default. */
+  fetch?: {{.Fetch}};
+  /** The headers that are sent with every request (i.e. Authorization). */
+  headers?: Record<string, string>;
+}
+
+/** The base path of the routes of the API. */
+const basePath = {{.BasePath}};
+
+type {{.Request}} = {
+  path?: Record<string, unknown>;
+  query?: Record<string, unknown>;
+  headers?: Record<string, unknown>;
+  body?: unknown;
+};
+
+function forEachValue(values: Record<string, unknown> | undefined, f: (name: string, value: unknown) => void): void {
+  for (const [name, value] of Object.entries(values ?? {})) {
+    for (const item of Array.isArray(value) ? value : [value]) {
+      if (item !== undefined && item !== null) {
+        f(name, item);
+      }
+    }
+  }
+}
+
+function buildUrl(baseUrl: string, path: string, request: {{.Request}}): string {
+  // The value of a catch-all param (i.e. {filepath*}) is a path itself, so its slashes aren't escaped
+  const url = baseUrl.replace(/\/+$/, "") + basePath + path.replace(/\{([^}*]+)(\*?)\}/g, (_, name: string, catchAll: string) => {
+    const value = encodeURIComponent(String(request.path?.[name] ?? ""));
+    return catchAll === "" ? value : value.replace(/%2F/gi, "/");
+  });
+
+  const query = new URLSearchParams();
+  forEachValue(request.query, (name, value) => query.append(name, String(value)));
+  const queryString = query.toString();
+
+  return queryString === "" ? url : url + "?" + queryString;
+}
+
+function encodeBody(contentType: string, body: unknown): BodyInit {
+  switch (contentType) {
+    case "multipart/form-data": {
+      const form = new FormData();
+      forEachValue(body as Record<string, unknown>, (name, value) => form.append(name, value instanceof Blob ? value : String(value)));
+      return form;
+    }
+    case "application/x-www-form-urlencoded": {
+      const form = new URLSearchParams();
+      forEachValue(body as Record<string, unknown>, (name, value) => form.append(name, String(value)));
+      return form;
+    }
+    default:
+      return JSON.stringify(body);
+  }
+}
+
+async function decodeBody(response: Response): Promise<unknown> {
+  const contentType = response.headers.get("Content-Type") ?? "";
+  if (contentType !== "" && !contentType.includes("json") && !contentType.startsWith("text/")) {
+    return response.blob();
+  }
+
+  const text = await response.text();
+  if (text === "") {
+    return undefined;
+  }
+
+  return contentType.includes("json") ? JSON.parse(text) : text;
+}
+
+async function send<T>(options: {{.Options}}, method: string, path: string, contentType: string, request: {{.Request}}): Promise<T> {
+  const headers: Record<string, string> = { ...options.headers };
+  forEachValue(request.headers, (name, value) => {
+    headers[name] = String(value);
+  });
+
+  let body: BodyInit | undefined;
+  if (request.body !== undefined) {
+    body = encodeBody(contentType, request.body);
+    // The boundary of multipart form data is added to its content type by fetch
+    if (contentType !== "multipart/form-data") {
+      headers["Content-Type"] = contentType;
+    }
+  }
+
+  const fetchImplementation = options.fetch ?? globalThis.fetch;
+  const response = await fetchImplementation(buildUrl(options.baseUrl ?? "", path, request), { method, headers, body });
+
+  return { status: response.status, body: await decodeBody(response) } as T;
+}
+`))
+
+// clientNames are the names of the types of the client, which are unique so they don't replace the components.
+type clientNames struct {
+	Fetch    string
+	Options  string
+	Request  string
+	Client   string
+	BasePath string
+}
+
+// GenerateClient generates the TypeScript client output.
+// It writes the types of the TypeScript output, and a client with a function for every operation, named by its operation ID.
+// The client sends the requests with fetch, which can be replaced, so it has no dependencies.
+func GenerateClient(filePath string) astra.ServiceFunction {
+	return generate(filePath, "TypeScript client", func(s *astra.Service, output openapi.OpenAPISchema, goTypes openapi.GoTypes) string {
+		return client(output, goTypes, s.Config.BasePath)
+	})
+}
+
+// client writes the types of the OpenAPI specification and the client of its operations.
+// The paths of the operations are relative to the base path, which is added to the URL of the server.
+func client(output openapi.OpenAPISchema, goTypes openapi.GoTypes, basePath string) string {
+	var b strings.Builder
+	b.WriteString(generatedHeader)
+
+	names := newUniqueNames(output.Components.Schemas)
+	runtimeNames := clientNames{
+		Fetch:    names.unique("Fetch"),
+		Options:  names.unique("ClientOptions"),
+		Request:  names.unique("ClientRequest"),
+		Client:   names.unique("Client"),
+		BasePath: quote(strings.TrimSuffix(basePath, "/")),
+	}
+
+	operations, typeNames := writeTypes(&b, output, goTypes, names)
+
+	// The template can't fail, as its data always has the names it uses
+	_ = clientRuntime.Execute(&b, runtimeNames)
+
+	b.WriteString("\n/** Creates a client of the API, with a function for every operation by its operation ID. */\n")
+	fmt.Fprintf(&b, "export function createClient(options: %s = {}) {\n", runtimeNames.Options)
+	fmt.Fprintf(&b, "%sreturn {\n", indent)
+	for _, operation := range operations {
+		writeClientFunction(&b, operation, typeNames[operation.OperationID], basePath)
+	}
+	fmt.Fprintf(&b, "%s};\n", indent)
+	b.WriteString("}\n")
+
+	b.WriteString("\n/** A client of the API, created by createClient. */\n")
+	fmt.Fprintf(&b, "export type %s = ReturnType<typeof createClient>;\n", runtimeNames.Client)
+
+	return b.String()
+}
+
+// writeClientFunction writes the function of the client that sends the request of an operation.
+// The request can be left out if everything in it is optional.
+func writeClientFunction(b *strings.Builder, operation openapi.PathOperation, names operationTypes, basePath string) {
+	prefix := indent + indent
+
+	description := operation.Method + " " + operation.Path
+	if operation.Summary != "" {
+		description = operation.Summary + "\n\n" + description
+	}
+	writeDocComment(b, prefix, description)
+
+	requestDefault := ""
+	sections := requestSections(operation)
+	optional := true
+	for _, section := range sections {
+		optional = optional && section.Optional
+	}
+	if optional {
+		requestDefault = " = {}"
+	}
+
+	contentType := ""
+	if operation.RequestBody != nil {
+		contentType, _ = openapi.PreferredContentType(operation.RequestBody.Content)
+	}
+
+	fmt.Fprintf(b, "%s%s: (request: %s%s): Promise<%s> =>\n", prefix, propertyName(operation.OperationID), names.Request, requestDefault, names.Response)
+	fmt.Fprintf(b, "%s%ssend<%s>(options, %s, %s, %s, request),\n", prefix, indent, names.Response, quote(operation.Method), quote(openapi.TrimBasePath(operation.Path, basePath)), quote(contentType))
+}
@@ -0,0 +1,66 @@
+package typescript
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ls6-events/astra/outputs/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient(t *testing.T) {
+	output := openapi.OpenAPISchema{
+		Paths: openapi.Paths{
+			"/api/pets/{id}": {
+				Get: &openapi.Operation{
+					OperationID: "getPet",
+					Parameters: []openapi.Parameter{
+						{Name: "id", In: "path", Required: true, Schema: openapi.Schema{Type: "integer"}},
+					},
+					Responses: map[string]openapi.Response{
+						"200": {Content: map[string]openapi.MediaType{"application/json": {Schema: openapi.Schema{Ref: "#/components/schemas/Client"}}}},
+					},
+				},
+			},
+			"/api/pets": {
+				Post: &openapi.Operation{
+					OperationID: "createPet",
+					RequestBody: &openapi.RequestBody{
+						Content: map[string]openapi.MediaType{"multipart/form-data": {Schema: openapi.Schema{Type: "object"}}},
+					},
+				},
+			},
+		},
+		Components: openapi.Components{
+			Schemas: map[string]openapi.Schema{
+				"Client": {Type: "object", Properties: map[string]openapi.Schema{"name": {Type: "string"}}},
+			},
+		},
+	}
+
+	contents := client(output, nil, "/api/")
+
+	t.Run("Runtime", func(t *testing.T) {
+		require.Contains(t, contents, "const basePath = \"/api\";\n")
+		require.Contains(t, contents, "export interface ClientOptions {\n")
+		require.Contains(t, contents, "fetch?: Fetch;\n")
+	})
+
+	t.Run("Functions", func(t *testing.T) {
+		require.Contains(t, contents, `  return {
+    /** POST /api/pets */
+    createPet: (request: CreatePetRequest = {}): Promise<CreatePetResponse> =>
+      send<CreatePetResponse>(options, "POST", "/pets", "multipart/form-data", request),
+    /** GET /api/pets/{id} */
+    getPet: (request: GetPetRequest): Promise<GetPetResponse> =>
+      send<GetPetResponse>(options, "GET", "/pets/{id}", "", request),
+  };
+`)
+	})
+
+	t.Run("Unique names", func(t *testing.T) {
+		require.Contains(t, contents, "export interface Client {\n")
+		require.Contains(t, contents, "export type Client2 = ReturnType<typeof createClient>;\n")
+		require.Equal(t, 1, strings.Count(contents, "export interface Client {"))
+	})
+}
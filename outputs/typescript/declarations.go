@@ -0,0 +1,88 @@
+package typescript
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ls6-events/astra/outputs/openapi"
+)
+
+// indent is the indentation of the TypeScript output, which is the conventional two spaces.
+const indent = "  "
+
+// writeDocComment writes a description as a JSDoc comment, if there is one.
+func writeDocComment(b *strings.Builder, prefix string, description string) {
+	description = strings.TrimSpace(strings.ReplaceAll(description, "*/", "*\\/"))
+	if description == "" {
+		return
+	}
+
+	lines := strings.Split(description, "\n")
+	if len(lines) == 1 {
+		fmt.Fprintf(b, "%s/** %s */\n", prefix, lines[0])
+		return
+	}
+
+	fmt.Fprintf(b, "%s/**\n", prefix)
+	for _, line := range lines {
+		fmt.Fprintf(b, "%s *%s\n", prefix, strings.TrimRight(" "+line, " "))
+	}
+	fmt.Fprintf(b, "%s */\n", prefix)
+}
+
+// writeComponent writes the declaration of a component.
+// Objects are interfaces, extending the components they embed, and anything else is a type alias.
+// The fields are the struct fields of the properties of the component.
+func writeComponent(b *strings.Builder, name string, schema openapi.Schema, fields openapi.Fields) {
+	writeDocComment(b, "", schema.Description)
+
+	extends, body, ok := interfaceOf(schema)
+	if !ok {
+		fmt.Fprintf(b, "export type %s = %s;\n", typeName(name), typeOf(schema, fields))
+		return
+	}
+
+	fmt.Fprintf(b, "export interface %s ", typeName(name))
+	if len(extends) > 0 {
+		fmt.Fprintf(b, "extends %s ", strings.Join(extends, ", "))
+	}
+	writeObject(b, "", properties(body, fields))
+	b.WriteString("\n")
+}
+
+// interfaceOf returns the components that an object schema extends and the schema of its own properties.
+// It returns false if the schema isn't an object that can be an interface (i.e. an enum, or a nullable object).
+func interfaceOf(schema openapi.Schema) ([]string, openapi.Schema, bool) {
+	if schema.Nullable {
+		return nil, openapi.Schema{}, false
+	}
+	if len(schema.AllOf) == 0 {
+		return nil, schema, schema.Type == "object" && len(schema.Properties) > 0
+	}
+
+	// Embedded structs are references followed by the schema of the properties of the struct
+	var extends []string
+	body := openapi.Schema{}
+	for i, part := range schema.AllOf {
+		switch {
+		case part.Ref != "" && !part.Nullable:
+			extends = append(extends, typeName(strings.TrimPrefix(part.Ref, schemaRefPrefix)))
+		case i == len(schema.AllOf)-1 && part.Ref == "" && len(part.Properties) > 0:
+			body = part
+		default:
+			return nil, openapi.Schema{}, false
+		}
+	}
+
+	return extends, body, true
+}
+
+// writeObject writes the properties of an object type on their own lines, with their descriptions.
+func writeObject(b *strings.Builder, prefix string, props []property) {
+	b.WriteString("{\n")
+	for _, prop := range props {
+		writeDocComment(b, prefix+indent, prop.Description)
+		fmt.Fprintf(b, "%s%s%s;\n", prefix, indent, prop.declaration())
+	}
+	fmt.Fprintf(b, "%s}", prefix)
+}
@@ -0,0 +1,82 @@
+package typescript
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ls6-events/astra/outputs/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteComponent(t *testing.T) {
+	t.Run("Interface", func(t *testing.T) {
+		var b strings.Builder
+		writeComponent(&b, "Pet", openapi.Schema{
+			Type:        "object",
+			Description: "Pet is a pet in the store.",
+			Properties: map[string]openapi.Schema{
+				"id":    {Type: "integer", Description: "The ID of the pet."},
+				"name":  {Type: "string"},
+				"owner": {Ref: "#/components/schemas/Owner", Nullable: true},
+			},
+		}, openapi.Fields{"owner": {OmitEmpty: true}})
+
+		require.Equal(t, `/** Pet is a pet in the store. */
+export interface Pet {
+  /** The ID of the pet. */
+  id: number;
+  name: string;
+  owner?: Owner | null;
+}
+`, b.String())
+	})
+
+	t.Run("Embedded structs", func(t *testing.T) {
+		var b strings.Builder
+		writeComponent(&b, "Dog", openapi.Schema{
+			AllOf: []openapi.Schema{
+				{Ref: "#/components/schemas/Animal"},
+				{Type: "object", Properties: map[string]openapi.Schema{"breed": {Type: "string"}}},
+			},
+		}, nil)
+
+		require.Equal(t, `export interface Dog extends Animal {
+  breed: string;
+}
+`, b.String())
+	})
+
+	t.Run("Enum", func(t *testing.T) {
+		var b strings.Builder
+		writeComponent(&b, "Status", openapi.Schema{Type: "string", Enum: []any{"available", "sold"}}, nil)
+
+		require.Equal(t, "export type Status = \"available\" | \"sold\";\n", b.String())
+	})
+
+	t.Run("Nullable object", func(t *testing.T) {
+		var b strings.Builder
+		writeComponent(&b, "Tag", openapi.Schema{
+			Type:       "object",
+			Nullable:   true,
+			Properties: map[string]openapi.Schema{"name": {Type: "string"}},
+		}, nil)
+
+		require.Equal(t, "export type Tag = { name: string } | null;\n", b.String())
+	})
+}
+
+func TestWriteDocComment(t *testing.T) {
+	var b strings.Builder
+	writeDocComment(&b, "  ", "The first line.\n\nThe second line with */ in it.")
+
+	require.Equal(t, `  /**
+   * The first line.
+   *
+   * The second line with *\/ in it.
+   */
+`, b.String())
+
+	b.Reset()
+	writeDocComment(&b, "", "  ")
+	require.Empty(t, b.String())
+}
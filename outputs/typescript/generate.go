@@ -0,0 +1,94 @@
+package typescript
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/outputs/openapi"
+)
+
+// generatedHeader is the comment at the top of the generated files, so they aren't edited by hand.
+const generatedHeader = "// Code generated by astra. DO NOT EDIT.\n"
+
+// Generate generates the TypeScript type definitions output.
+// It writes a type for every component, named the same as in the OpenAPI specification, and the request and response types of every operation, named by its operation ID.
+// The file can be a .ts or .d.ts file, as it only contains types.
+func Generate(filePath string) astra.ServiceFunction {
+	return generate(filePath, "TypeScript", func(s *astra.Service, output openapi.OpenAPISchema, goTypes openapi.GoTypes) string {
+		return typeDefinitions(output, goTypes)
+	})
+}
+
+// generate generates a TypeScript output from the OpenAPI specification and the Go types of its components, and writes its contents to the file.
+func generate(filePath string, name string, contents func(s *astra.Service, output openapi.OpenAPISchema, goTypes openapi.GoTypes) string) astra.ServiceFunction {
+	return func(s *astra.Service) error {
+		s.Log.Info().Msgf("Generating %s output", name)
+		output, err := openapi.Build(s, openapi.NewOptions())
+		if err != nil {
+			s.Log.Error().Err(err).Msg("Failed to build OpenAPI schema")
+			return err
+		}
+
+		if !strings.HasSuffix(filePath, ".ts") {
+			s.Log.Debug().Str("filePath", filePath).Msg("Adding .ts suffix to file path")
+			filePath += ".ts"
+		}
+
+		s.Log.Debug().Str("filePath", filePath).Msgf("Writing %s output to file", name)
+		filePath = path.Join(s.WorkDir, filePath)
+		err = os.WriteFile(filePath, []byte(contents(s, output, openapi.BuildGoTypes(s))), 0644)
+		if err != nil {
+			s.Log.Error().Err(err).Msgf("Failed to write %s output to file", name)
+			return err
+		}
+
+		s.Log.Info().Msgf("Generated %s output", name)
+		return nil
+	}
+}
+
+// typeDefinitions writes the types of the components and operations of the OpenAPI specification.
+func typeDefinitions(output openapi.OpenAPISchema, goTypes openapi.GoTypes) string {
+	var b strings.Builder
+	b.WriteString(generatedHeader)
+	writeTypes(&b, output, goTypes, newUniqueNames(output.Components.Schemas))
+
+	return b.String()
+}
+
+// writeTypes writes the types of the components and operations of the OpenAPI specification, and returns the operations with the names of their types.
+// The operations are also in the Operations interface by their operation IDs, with their request and response types.
+func writeTypes(b *strings.Builder, output openapi.OpenAPISchema, goTypes openapi.GoTypes, names *uniqueNames) ([]openapi.PathOperation, map[string]operationTypes) {
+	componentNames := make([]string, 0, len(output.Components.Schemas))
+	for name := range output.Components.Schemas {
+		componentNames = append(componentNames, name)
+	}
+	sort.Strings(componentNames)
+	for _, name := range componentNames {
+		b.WriteString("\n")
+		writeComponent(b, name, output.Components.Schemas[name], goTypes[name].Fields)
+	}
+
+	operations := clientOperations(output)
+	typeNames := operationTypeNames(operations, names)
+	for _, operation := range operations {
+		b.WriteString("\n")
+		writeOperation(b, operation, typeNames[operation.OperationID])
+	}
+
+	if len(operations) > 0 {
+		operationsName := names.unique("Operations")
+		b.WriteString("\n/** The request and response types of the operations, by their operation IDs. */\n")
+		fmt.Fprintf(b, "export interface %s {\n", operationsName)
+		for _, operation := range operations {
+			fmt.Fprintf(b, "%s%s: { request: %s; response: %s };\n", indent, propertyName(operation.OperationID), typeNames[operation.OperationID].Request, typeNames[operation.OperationID].Response)
+		}
+		b.WriteString("}\n")
+	}
+
+	return operations, typeNames
+}
@@ -0,0 +1,189 @@
+package typescript
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/ls6-events/astra/outputs/openapi"
+)
+
+// The sections of the request of an operation, by where its parameters are.
+const (
+	requestPathSection   = "path"
+	requestQuerySection  = "query"
+	requestHeaderSection = "headers"
+	requestBodySection   = "body"
+)
+
+// clientOperations returns the operations of the OpenAPI specification that have operation IDs, as the types and client are keyed by them.
+func clientOperations(output openapi.OpenAPISchema) []openapi.PathOperation {
+	var operations []openapi.PathOperation
+	for _, operation := range openapi.PathOperations(output) {
+		if operation.OperationID != "" {
+			operations = append(operations, operation)
+		}
+	}
+
+	return operations
+}
+
+// operationTypes are the names of the request and response types of an operation.
+type operationTypes struct {
+	Request  string
+	Response string
+}
+
+// operationTypeNames names the request and response types of the operations by their operation IDs (i.e. GetPetRequest for getPet).
+// The names that are already taken have a number added to them.
+func operationTypeNames(operations []openapi.PathOperation, names *uniqueNames) map[string]operationTypes {
+	operationNames := make(map[string]operationTypes, len(operations))
+	for _, operation := range operations {
+		name := typeName(strcase.ToCamel(operation.OperationID))
+		operationNames[operation.OperationID] = operationTypes{
+			Request:  names.unique(name + "Request"),
+			Response: names.unique(name + "Response"),
+		}
+	}
+
+	return operationNames
+}
+
+// requestSections returns the sections of the request of an operation: its path, query and header params, and its body.
+// A section is optional if everything in it is.
+func requestSections(operation openapi.PathOperation) []property {
+	params := map[string][]property{}
+	for _, parameter := range operation.Parameters {
+		section := ""
+		switch parameter.In {
+		case "path":
+			section = requestPathSection
+		case "query":
+			section = requestQuerySection
+		case "header":
+			section = requestHeaderSection
+		default:
+			continue
+		}
+
+		params[section] = append(params[section], property{
+			Name:        parameter.Name,
+			Type:        typeOf(parameter.Schema, nil),
+			Optional:    !parameter.Required,
+			Description: parameter.Description,
+		})
+	}
+
+	var sections []property
+	for _, section := range []string{requestPathSection, requestQuerySection, requestHeaderSection} {
+		sectionParams, ok := params[section]
+		if !ok {
+			continue
+		}
+		sort.SliceStable(sectionParams, func(i, j int) bool {
+			return sectionParams[i].Name < sectionParams[j].Name
+		})
+
+		optional := true
+		for _, param := range sectionParams {
+			optional = optional && param.Optional
+		}
+		sections = append(sections, property{
+			Name:     section,
+			Type:     inlineObject(sectionParams),
+			Optional: optional,
+		})
+	}
+
+	if operation.RequestBody != nil {
+		if schema, ok := contentSchema(operation.RequestBody.Content); ok {
+			sections = append(sections, property{
+				Name:        requestBodySection,
+				Type:        typeOf(schema, nil),
+				Optional:    !operation.RequestBody.Required,
+				Description: operation.RequestBody.Description,
+			})
+		}
+	}
+
+	return sections
+}
+
+// statusResponse is a response of an operation, by its status code and the type of its body.
+type statusResponse struct {
+	Status string
+	Body   string
+}
+
+// responses returns the responses of an operation, sorted by their status codes.
+// A response without content has no body (void), and a status code that isn't a number (i.e. default) is any status.
+func responses(operation openapi.PathOperation) []statusResponse {
+	statusCodes := make([]string, 0, len(operation.Responses))
+	for statusCode := range operation.Responses {
+		statusCodes = append(statusCodes, statusCode)
+	}
+	sort.Strings(statusCodes)
+
+	responses := make([]statusResponse, 0, len(statusCodes))
+	for _, statusCode := range statusCodes {
+		status := "number"
+		if _, err := strconv.Atoi(statusCode); err == nil {
+			status = statusCode
+		}
+
+		body := "void"
+		if schema, ok := contentSchema(operation.Responses[statusCode].Content); ok {
+			body = typeOf(schema, nil)
+		}
+
+		responses = append(responses, statusResponse{
+			Status: status,
+			Body:   body,
+		})
+	}
+
+	return responses
+}
+
+// contentSchema returns the schema of the content of a request or response, which is JSON if it can be.
+func contentSchema(content map[string]openapi.MediaType) (openapi.Schema, bool) {
+	contentType, ok := openapi.PreferredContentType(content)
+	if !ok {
+		return openapi.Schema{}, false
+	}
+
+	return content[contentType].Schema, true
+}
+
+// writeOperation writes the request and response types of an operation.
+// The request is an object of its params and body, and the response is a union of the responses discriminated by their status codes.
+func writeOperation(b *strings.Builder, operation openapi.PathOperation, names operationTypes) {
+	description := operation.Method + " " + operation.Path
+	if operation.Summary != "" {
+		description = operation.Summary + "\n\n" + description
+	}
+
+	writeDocComment(b, "", description)
+	sections := requestSections(operation)
+	if len(sections) == 0 {
+		fmt.Fprintf(b, "export type %s = Record<string, never>;\n\n", names.Request)
+	} else {
+		fmt.Fprintf(b, "export type %s = ", names.Request)
+		writeObject(b, "", sections)
+		b.WriteString(";\n\n")
+	}
+
+	writeDocComment(b, "", description)
+	fmt.Fprintf(b, "export type %s =", names.Response)
+	operationResponses := responses(operation)
+	if len(operationResponses) == 0 {
+		b.WriteString(" never;\n")
+		return
+	}
+	for _, response := range operationResponses {
+		fmt.Fprintf(b, "\n%s| { status: %s; body: %s }", indent, response.Status, response.Body)
+	}
+	b.WriteString(";\n")
+}
@@ -0,0 +1,107 @@
+package typescript
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ls6-events/astra/outputs/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientOperations(t *testing.T) {
+	operations := clientOperations(openapi.OpenAPISchema{
+		Paths: openapi.Paths{
+			"/pets/{id}": {
+				Get:    &openapi.Operation{OperationID: "getPet"},
+				Delete: &openapi.Operation{OperationID: "deletePet"},
+			},
+			"/pets": {
+				Post: &openapi.Operation{OperationID: "createPet"},
+				Get:  &openapi.Operation{},
+			},
+		},
+	})
+
+	operationIDs := make([]string, 0, len(operations))
+	for _, operation := range operations {
+		operationIDs = append(operationIDs, operation.OperationID)
+	}
+	require.Equal(t, []string{"createPet", "getPet", "deletePet"}, operationIDs)
+}
+
+func TestOperationTypeNames(t *testing.T) {
+	names := operationTypeNames([]openapi.PathOperation{
+		{Operation: &openapi.Operation{OperationID: "getPet"}},
+		{Operation: &openapi.Operation{OperationID: "get_pets"}},
+	}, newUniqueNames(map[string]openapi.Schema{"GetPetResponse": {}}))
+
+	require.Equal(t, operationTypes{Request: "GetPetRequest", Response: "GetPetResponse2"}, names["getPet"])
+	require.Equal(t, operationTypes{Request: "GetPetsRequest", Response: "GetPetsResponse"}, names["get_pets"])
+}
+
+func TestWriteOperation(t *testing.T) {
+	t.Run("Params, body and responses", func(t *testing.T) {
+		var b strings.Builder
+		writeOperation(&b, openapi.PathOperation{
+			Method: "PUT",
+			Path:   "/pets/{id}",
+			Operation: &openapi.Operation{
+				OperationID: "updatePet",
+				Summary:     "Update pet",
+				Parameters: []openapi.Parameter{
+					{Name: "id", In: "path", Required: true, Schema: openapi.Schema{Type: "integer"}},
+					{Name: "X-Request-ID", In: "header", Schema: openapi.Schema{Type: "string"}},
+				},
+				RequestBody: &openapi.RequestBody{
+					Required: true,
+					Content: map[string]openapi.MediaType{
+						"application/json": {Schema: openapi.Schema{Ref: "#/components/schemas/Pet"}},
+					},
+				},
+				Responses: map[string]openapi.Response{
+					"200":     {Content: map[string]openapi.MediaType{"application/json": {Schema: openapi.Schema{Ref: "#/components/schemas/Pet"}}}},
+					"204":     {},
+					"default": {Content: map[string]openapi.MediaType{"application/json": {Schema: openapi.Schema{Ref: "#/components/schemas/Error"}}}},
+				},
+			},
+		}, operationTypes{Request: "UpdatePetRequest", Response: "UpdatePetResponse"})
+
+		require.Equal(t, `/**
+ * Update pet
+ *
+ * PUT /pets/{id}
+ */
+export type UpdatePetRequest = {
+  path: { id: number };
+  headers?: { "X-Request-ID"?: string };
+  body: Pet;
+};
+
+/**
+ * Update pet
+ *
+ * PUT /pets/{id}
+ */
+export type UpdatePetResponse =
+  | { status: 200; body: Pet }
+  | { status: 204; body: void }
+  | { status: number; body: Error };
+`, b.String())
+	})
+
+	t.Run("Nothing", func(t *testing.T) {
+		var b strings.Builder
+		writeOperation(&b, openapi.PathOperation{
+			Method:    "GET",
+			Path:      "/health",
+			Operation: &openapi.Operation{OperationID: "getHealth"},
+		}, operationTypes{Request: "GetHealthRequest", Response: "GetHealthResponse"})
+
+		require.Equal(t, `/** GET /health */
+export type GetHealthRequest = Record<string, never>;
+
+/** GET /health */
+export type GetHealthResponse = never;
+`, b.String())
+	})
+}
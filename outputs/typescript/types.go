@@ -0,0 +1,260 @@
+package typescript
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/ls6-events/astra/outputs/openapi"
+)
+
+// schemaRefPrefix is the prefix of the references to the schemas of the components.
+const schemaRefPrefix = "#/components/schemas/"
+
+// unknownType is the type of a schema that could be anything.
+const unknownType = "unknown"
+
+var (
+	// identifierRegex matches the names that can be written without quotes as TypeScript identifiers and property names.
+	identifierRegex = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+	// invalidIdentifierCharacters are the characters that can't be in a TypeScript identifier.
+	invalidIdentifierCharacters = regexp.MustCompile(`[^A-Za-z0-9_$]`)
+	// reservedTypeNames are the global types that the outputs use, which the components can't replace.
+	reservedTypeNames = []string{"Array", "Blob", "BodyInit", "Promise", "Record", "RequestInit", "Response", "ReturnType"}
+)
+
+// typeName makes the name of a component (i.e. Pet_form) into a valid TypeScript identifier.
+// A name of a global type that the outputs use (i.e. Response) has an underscore added to it, so it doesn't replace the global type.
+func typeName(name string) string {
+	name = invalidIdentifierCharacters.ReplaceAllString(name, "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+	if slices.Contains(reservedTypeNames, name) {
+		name += "_"
+	}
+
+	return name
+}
+
+// uniqueNames are the type names that are taken in the output, so that the types that are added don't replace the components.
+type uniqueNames struct {
+	taken map[string]bool
+}
+
+// newUniqueNames creates the type names that are taken by the components.
+func newUniqueNames(components map[string]openapi.Schema) *uniqueNames {
+	taken := make(map[string]bool, len(components))
+	for name := range components {
+		taken[typeName(name)] = true
+	}
+
+	return &uniqueNames{taken: taken}
+}
+
+// unique takes a name that isn't already taken, adding a number to it if it is (i.e. GetPetResponse2).
+func (n *uniqueNames) unique(name string) string {
+	uniqueName := name
+	for i := 2; n.taken[uniqueName]; i++ {
+		uniqueName = fmt.Sprintf("%s%d", name, i)
+	}
+	n.taken[uniqueName] = true
+
+	return uniqueName
+}
+
+// propertyName writes the name of a property, quoting it if it isn't a valid identifier (i.e. "X-Request-ID").
+func propertyName(name string) string {
+	if identifierRegex.MatchString(name) {
+		return name
+	}
+
+	return quote(name)
+}
+
+// quote writes a string as a TypeScript string literal.
+func quote(s string) string {
+	quoted, _ := json.Marshal(s)
+	return string(quoted)
+}
+
+// typeOf writes the TypeScript type of a schema, which is a union with null if it is nullable.
+// The fields are the struct fields of the properties of the schema, if it is an object.
+func typeOf(schema openapi.Schema, fields openapi.Fields) string {
+	t := nonNullableTypeOf(schema, fields)
+	if schema.Nullable && t != unknownType {
+		return union([]string{t, "null"})
+	}
+
+	return t
+}
+
+// nonNullableTypeOf writes the TypeScript type of a schema, ignoring whether it is nullable.
+// Enums are unions of their values, and objects without properties are records of their additional properties.
+func nonNullableTypeOf(schema openapi.Schema, fields openapi.Fields) string {
+	switch {
+	case schema.Ref != "":
+		return typeName(strings.TrimPrefix(schema.Ref, schemaRefPrefix))
+	case len(schema.AllOf) > 0:
+		return intersection(typesOf(schema.AllOf, fields))
+	case len(schema.OneOf) > 0:
+		return union(typesOf(schema.OneOf, nil))
+	case len(schema.AnyOf) > 0:
+		return union(typesOf(schema.AnyOf, nil))
+	case len(schema.Enum) > 0:
+		return union(enumLiterals(schema.Enum))
+	}
+
+	switch schema.Type {
+	case "string":
+		if schema.Format == "binary" {
+			return "Blob"
+		}
+		return "string"
+	case "integer", "number":
+		return "number"
+	case "boolean":
+		return "boolean"
+	case "array":
+		if schema.Items == nil {
+			return arrayOf(unknownType)
+		}
+		return arrayOf(typeOf(*schema.Items, nil))
+	case "object":
+		if len(schema.Properties) > 0 {
+			return inlineObject(properties(schema, fields))
+		}
+		if schema.AdditionalProperties != nil {
+			return fmt.Sprintf("Record<string, %s>", typeOf(*schema.AdditionalProperties, nil))
+		}
+		return fmt.Sprintf("Record<string, %s>", unknownType)
+	}
+
+	return unknownType
+}
+
+// typesOf writes the TypeScript types of schemas, which share the struct fields of their properties (i.e. the parts of an intersection).
+func typesOf(schemas []openapi.Schema, fields openapi.Fields) []string {
+	types := make([]string, 0, len(schemas))
+	for _, schema := range schemas {
+		types = append(types, typeOf(schema, fields))
+	}
+
+	return types
+}
+
+// enumLiterals writes the values of an enum as TypeScript literals (i.e. "available" or 1).
+func enumLiterals(values []any) []string {
+	literals := make([]string, 0, len(values))
+	for _, value := range values {
+		literal, err := json.Marshal(value)
+		if err != nil {
+			continue
+		}
+		literals = append(literals, string(literal))
+	}
+
+	return literals
+}
+
+// union writes a union of types, without duplicates.
+func union(types []string) string {
+	return join(types, " | ")
+}
+
+// intersection writes an intersection of types, with unions in parentheses.
+func intersection(types []string) string {
+	parenthesized := make([]string, 0, len(types))
+	for _, t := range types {
+		parenthesized = append(parenthesized, parenthesize(t))
+	}
+
+	return join(parenthesized, " & ")
+}
+
+// arrayOf writes an array of a type, with unions and intersections in parentheses.
+func arrayOf(t string) string {
+	return parenthesize(t) + "[]"
+}
+
+// parenthesize puts a union or intersection in parentheses, so it can be combined with other types.
+func parenthesize(t string) string {
+	if strings.Contains(t, " | ") || strings.Contains(t, " & ") {
+		return "(" + t + ")"
+	}
+
+	return t
+}
+
+// join joins the types with the separator, without duplicates.
+func join(types []string, separator string) string {
+	unique := make([]string, 0, len(types))
+	for _, t := range types {
+		if !slices.Contains(unique, t) {
+			unique = append(unique, t)
+		}
+	}
+	if len(unique) == 0 {
+		return unknownType
+	}
+
+	return strings.Join(unique, separator)
+}
+
+// property is a property of an object type.
+type property struct {
+	Name        string
+	Type        string
+	Optional    bool
+	Description string
+}
+
+// properties returns the properties of an object schema, sorted by their names.
+// Properties whose struct fields are omitted when they are empty (i.e. omitempty) are optional.
+func properties(schema openapi.Schema, fields openapi.Fields) []property {
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	props := make([]property, 0, len(names))
+	for _, name := range names {
+		propertySchema := schema.Properties[name]
+		props = append(props, property{
+			Name:        name,
+			Type:        typeOf(propertySchema, fields[name].Fields),
+			Optional:    fields[name].OmitEmpty,
+			Description: propertySchema.Description,
+		})
+	}
+
+	return props
+}
+
+// inlineObject writes an object type on a single line (i.e. { id: number; name?: string }).
+func inlineObject(props []property) string {
+	if len(props) == 0 {
+		return "Record<string, never>"
+	}
+
+	fields := make([]string, 0, len(props))
+	for _, prop := range props {
+		fields = append(fields, prop.declaration())
+	}
+
+	return "{ " + strings.Join(fields, "; ") + " }"
+}
+
+// declaration writes the property as it is declared in an object type (i.e. name?: string).
+func (p property) declaration() string {
+	optional := ""
+	if p.Optional {
+		optional = "?"
+	}
+
+	return propertyName(p.Name) + optional + ": " + p.Type
+}
@@ -0,0 +1,74 @@
+package typescript
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra/outputs/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypeOf(t *testing.T) {
+	t.Run("Primitives", func(t *testing.T) {
+		require.Equal(t, "string", typeOf(openapi.Schema{Type: "string"}, nil))
+		require.Equal(t, "Blob", typeOf(openapi.Schema{Type: "string", Format: "binary"}, nil))
+		require.Equal(t, "number", typeOf(openapi.Schema{Type: "integer"}, nil))
+		require.Equal(t, "number", typeOf(openapi.Schema{Type: "number"}, nil))
+		require.Equal(t, "boolean", typeOf(openapi.Schema{Type: "boolean"}, nil))
+		require.Equal(t, "unknown", typeOf(openapi.Schema{}, nil))
+	})
+
+	t.Run("References", func(t *testing.T) {
+		require.Equal(t, "Pet", typeOf(openapi.Schema{Ref: "#/components/schemas/Pet"}, nil))
+		require.Equal(t, "Pet_form", typeOf(openapi.Schema{Ref: "#/components/schemas/Pet_form"}, nil))
+		require.Equal(t, "Pet | null", typeOf(openapi.Schema{Ref: "#/components/schemas/Pet", Nullable: true}, nil))
+	})
+
+	t.Run("Enums", func(t *testing.T) {
+		require.Equal(t, `"available" | "sold"`, typeOf(openapi.Schema{Type: "string", Enum: []any{"available", "sold"}}, nil))
+		require.Equal(t, "1 | 2", typeOf(openapi.Schema{Type: "integer", Enum: []any{1, 2}}, nil))
+	})
+
+	t.Run("Nullable", func(t *testing.T) {
+		require.Equal(t, "string | null", typeOf(openapi.Schema{Type: "string", Nullable: true}, nil))
+		require.Equal(t, `"a" | "b" | null`, typeOf(openapi.Schema{Type: "string", Enum: []any{"a", "b"}, Nullable: true}, nil))
+	})
+
+	t.Run("Arrays", func(t *testing.T) {
+		require.Equal(t, "string[]", typeOf(openapi.Schema{Type: "array", Items: &openapi.Schema{Type: "string"}}, nil))
+		require.Equal(t, "(Pet | null)[]", typeOf(openapi.Schema{Type: "array", Items: &openapi.Schema{Ref: "#/components/schemas/Pet", Nullable: true}}, nil))
+		require.Equal(t, "unknown[]", typeOf(openapi.Schema{Type: "array"}, nil))
+	})
+
+	t.Run("Objects", func(t *testing.T) {
+		require.Equal(t, "Record<string, number>", typeOf(openapi.Schema{Type: "object", AdditionalProperties: &openapi.Schema{Type: "integer"}}, nil))
+		require.Equal(t, "Record<string, unknown>", typeOf(openapi.Schema{Type: "object"}, nil))
+		require.Equal(t, `{ "X-ID"?: string; id: number }`, typeOf(openapi.Schema{
+			Type: "object",
+			Properties: map[string]openapi.Schema{
+				"id":   {Type: "integer"},
+				"X-ID": {Type: "string"},
+			},
+		}, openapi.Fields{"X-ID": {OmitEmpty: true}}))
+	})
+
+	t.Run("Compositions", func(t *testing.T) {
+		require.Equal(t, "Cat | Dog", typeOf(openapi.Schema{OneOf: []openapi.Schema{{Ref: "#/components/schemas/Cat"}, {Ref: "#/components/schemas/Dog"}}}, nil))
+		require.Equal(t, "string | number", typeOf(openapi.Schema{AnyOf: []openapi.Schema{{Type: "string"}, {Type: "integer"}, {Type: "number"}}}, nil))
+		require.Equal(t, "Base & (Cat | null)", typeOf(openapi.Schema{AllOf: []openapi.Schema{{Ref: "#/components/schemas/Base"}, {Ref: "#/components/schemas/Cat", Nullable: true}}}, nil))
+	})
+}
+
+func TestTypeName(t *testing.T) {
+	require.Equal(t, "Pet", typeName("Pet"))
+	require.Equal(t, "Pet_form", typeName("Pet_form"))
+	require.Equal(t, "github_com_pets_Pet", typeName("github.com/pets.Pet"))
+	require.Equal(t, "_1Pet", typeName("1Pet"))
+	require.Equal(t, "Response_", typeName("Response"))
+}
+
+func TestPropertyName(t *testing.T) {
+	require.Equal(t, "id", propertyName("id"))
+	require.Equal(t, "$ref", propertyName("$ref"))
+	require.Equal(t, `"X-Request-ID"`, propertyName("X-Request-ID"))
+	require.Equal(t, `"1st"`, propertyName("1st"))
+}
@@ -0,0 +1,388 @@
+package typescript
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/outputs/openapi"
+)
+
+// zodImport imports zod, which the schemas are written with.
+const zodImport = "import { z } from \"zod\";\n"
+
+// zodFormats are the refinements of the string formats of the schemas (i.e. email).
+var zodFormats = map[string]string{
+	"email":     ".email()",
+	"uuid":      ".uuid()",
+	"uri":       ".url()",
+	"date-time": ".datetime({ offset: true })",
+}
+
+// zodRuleFormats are the refinements of the validation rules that describe a string format.
+var zodRuleFormats = map[string]string{
+	"email": ".email()",
+	"uuid":  ".uuid()",
+	"uuid3": ".uuid()",
+	"uuid4": ".uuid()",
+	"uuid5": ".uuid()",
+	"url":   ".url()",
+	"uri":   ".url()",
+}
+
+// GenerateZod generates the zod output.
+// It writes a zod schema for every component, named the same as in the OpenAPI specification, with the constraints of the validation tags of its fields, and the type that is inferred from it.
+func GenerateZod(filePath string) astra.ServiceFunction {
+	return generate(filePath, "zod", func(s *astra.Service, output openapi.OpenAPISchema, goTypes openapi.GoTypes) string {
+		return zodSchemas(output.Components.Schemas, goTypes)
+	})
+}
+
+// zodSchemas writes the zod schemas of the components, after the components they reference.
+// The components that reference themselves (i.e. a tree) have their types written out, as they can't be inferred, and reference each other lazily.
+func zodSchemas(components map[string]openapi.Schema, goTypes openapi.GoTypes) string {
+	var b strings.Builder
+	b.WriteString(generatedHeader)
+	b.WriteString("\n")
+	b.WriteString(zodImport)
+
+	order, recursive := componentOrder(components)
+	w := zodWriter{declared: make(map[string]bool, len(components))}
+	for _, name := range order {
+		schema := components[name]
+		field := openapi.Field{Fields: goTypes[name].Fields}
+		b.WriteString("\n")
+
+		if recursive[name] {
+			writeComponent(&b, name, schema, field.Fields)
+			fmt.Fprintf(&b, "export const %s: z.ZodType<%s> = %s;\n", typeName(name), typeName(name), w.schema(schema, field, ""))
+			w.declared[typeName(name)] = true
+			continue
+		}
+
+		writeDocComment(&b, "", schema.Description)
+		fmt.Fprintf(&b, "export const %s = %s;\n", typeName(name), w.schema(schema, field, ""))
+		fmt.Fprintf(&b, "export type %s = z.infer<typeof %s>;\n", typeName(name), typeName(name))
+		w.declared[typeName(name)] = true
+	}
+
+	return b.String()
+}
+
+// componentOrder orders the components so that they are after the components they reference, and returns those that reference themselves through other components.
+// The components are otherwise in the order of their names, so the order is the same every time.
+func componentOrder(components map[string]openapi.Schema) ([]string, map[string]bool) {
+	names := make([]string, 0, len(components))
+	for name := range components {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int, len(components))
+	recursive := make(map[string]bool)
+	order := make([]string, 0, len(components))
+	var stack []string
+
+	var visit func(name string)
+	visit = func(name string) {
+		switch state[name] {
+		case visiting:
+			// The components from the referenced one to this one reference each other in a cycle
+			for i := len(stack) - 1; i >= 0; i-- {
+				recursive[stack[i]] = true
+				if stack[i] == name {
+					break
+				}
+			}
+			return
+		case visited:
+			return
+		}
+
+		state[name] = visiting
+		stack = append(stack, name)
+		for _, reference := range schemaReferences(components[name]) {
+			if _, ok := components[reference]; ok {
+				visit(reference)
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[name] = visited
+		order = append(order, name)
+	}
+	for _, name := range names {
+		visit(name)
+	}
+
+	return order, recursive
+}
+
+// schemaReferences returns the names of the components that a schema references, in the order of their names.
+func schemaReferences(schema openapi.Schema) []string {
+	references := make(map[string]bool)
+	var walk func(schema openapi.Schema)
+	walk = func(schema openapi.Schema) {
+		if name, ok := strings.CutPrefix(schema.Ref, schemaRefPrefix); ok {
+			references[name] = true
+		}
+		for _, s := range []*openapi.Schema{schema.Items, schema.Not, schema.AdditionalProperties} {
+			if s != nil {
+				walk(*s)
+			}
+		}
+		for _, schemas := range [][]openapi.Schema{schema.AllOf, schema.OneOf, schema.AnyOf} {
+			for _, s := range schemas {
+				walk(s)
+			}
+		}
+		for _, s := range schema.Properties {
+			walk(s)
+		}
+	}
+	walk(schema)
+
+	names := make([]string, 0, len(references))
+	for name := range references {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// zodWriter writes the zod schemas of the components.
+// The components that aren't declared yet are referenced lazily, as they reference each other in a cycle.
+type zodWriter struct {
+	declared map[string]bool
+}
+
+// schema writes the zod schema of a schema, which is nullable if the schema is, with the constraints of the validation rules of its struct field.
+// The properties of objects are on their own lines, indented from the prefix.
+func (w zodWriter) schema(schema openapi.Schema, field openapi.Field, prefix string) string {
+	zodSchema := w.nonNullableSchema(schema, field, prefix)
+	if schema.Nullable {
+		zodSchema += ".nullable()"
+	}
+
+	return zodSchema
+}
+
+// nonNullableSchema writes the zod schema of a schema, ignoring whether it is nullable.
+func (w zodWriter) nonNullableSchema(schema openapi.Schema, field openapi.Field, prefix string) string {
+	switch {
+	case schema.Ref != "":
+		name := typeName(strings.TrimPrefix(schema.Ref, schemaRefPrefix))
+		if !w.declared[name] {
+			return fmt.Sprintf("z.lazy(() => %s)", name)
+		}
+		return name
+	case len(schema.AllOf) > 0:
+		parts := w.schemas(schema.AllOf, openapi.Field{Fields: field.Fields}, prefix)
+		if len(parts) == 1 {
+			return parts[0]
+		}
+		return parts[0] + ".and(" + strings.Join(parts[1:], ").and(") + ")"
+	case len(schema.OneOf) > 0:
+		return zodUnion(w.schemas(schema.OneOf, openapi.Field{}, prefix))
+	case len(schema.AnyOf) > 0:
+		return zodUnion(w.schemas(schema.AnyOf, openapi.Field{}, prefix))
+	case len(schema.Enum) > 0:
+		return zodEnum(schema.Enum)
+	}
+
+	switch schema.Type {
+	case "string":
+		if schema.Format == "binary" {
+			return "z.instanceof(Blob)"
+		}
+		if options, ok := field.ValidationRules["oneof"]; ok {
+			return zodEnum(oneOfValues(schema.Type, options))
+		}
+		return "z.string()" + zodStringRefinements(schema, field.ValidationRules)
+	case "integer", "number":
+		if options, ok := field.ValidationRules["oneof"]; ok {
+			return zodEnum(oneOfValues(schema.Type, options))
+		}
+		zodSchema := "z.number()"
+		if schema.Type == "integer" {
+			zodSchema += ".int()"
+		}
+		return zodSchema + zodNumberRefinements(field.ValidationRules)
+	case "boolean":
+		return "z.boolean()"
+	case "array":
+		items := "z.unknown()"
+		if schema.Items != nil {
+			items = w.schema(*schema.Items, openapi.Field{}, prefix)
+		}
+		return "z.array(" + items + ")" + zodLengthRefinements(field.ValidationRules)
+	case "object":
+		if len(schema.Properties) > 0 {
+			return w.object(schema, field.Fields, prefix)
+		}
+		if schema.AdditionalProperties != nil {
+			return "z.record(z.string(), " + w.schema(*schema.AdditionalProperties, openapi.Field{}, prefix) + ")"
+		}
+		return "z.record(z.string(), z.unknown())"
+	}
+
+	return "z.unknown()"
+}
+
+// schemas writes the zod schemas of schemas, which share a struct field.
+func (w zodWriter) schemas(schemas []openapi.Schema, field openapi.Field, prefix string) []string {
+	zodSchemas := make([]string, 0, len(schemas))
+	for _, schema := range schemas {
+		zodSchemas = append(zodSchemas, w.schema(schema, field, prefix))
+	}
+
+	return zodSchemas
+}
+
+// object writes the zod schema of an object, with its properties on their own lines.
+// Properties whose struct fields are omitted when they are empty (i.e. omitempty) are optional.
+func (w zodWriter) object(schema openapi.Schema, fields openapi.Fields, prefix string) string {
+	var b strings.Builder
+	b.WriteString("z.object({\n")
+	for _, prop := range properties(schema, fields) {
+		propertySchema := schema.Properties[prop.Name]
+		writeDocComment(&b, prefix+indent, prop.Description)
+
+		zodSchema := w.schema(propertySchema, fields[prop.Name], prefix+indent)
+		if prop.Optional {
+			zodSchema += ".optional()"
+		}
+		fmt.Fprintf(&b, "%s%s%s: %s,\n", prefix, indent, propertyName(prop.Name), zodSchema)
+	}
+	fmt.Fprintf(&b, "%s})", prefix)
+
+	return b.String()
+}
+
+// zodUnion writes a union of zod schemas, which is the schema itself if there is only one.
+func zodUnion(zodSchemas []string) string {
+	if len(zodSchemas) == 1 {
+		return zodSchemas[0]
+	}
+
+	return "z.union([" + strings.Join(zodSchemas, ", ") + "])"
+}
+
+// zodEnum writes the zod schema of the values of an enum.
+// Strings are a zod enum, and anything else is a union of literals.
+func zodEnum(values []any) string {
+	literals := enumLiterals(values)
+	if len(literals) == 0 {
+		return "z.never()"
+	}
+
+	allStrings := true
+	for _, value := range values {
+		_, ok := value.(string)
+		allStrings = allStrings && ok
+	}
+	if allStrings {
+		return "z.enum([" + strings.Join(literals, ", ") + "])"
+	}
+
+	zodLiterals := make([]string, 0, len(literals))
+	for _, literal := range literals {
+		zodLiterals = append(zodLiterals, "z.literal("+literal+")")
+	}
+
+	return zodUnion(zodLiterals)
+}
+
+// oneOfValues returns the values of a oneof validation rule (i.e. oneof=red green), which are numbers for number types.
+func oneOfValues(schemaType string, options string) []any {
+	var values []any
+	for _, option := range strings.Fields(options) {
+		switch schemaType {
+		case "integer", "number":
+			if number, err := strconv.ParseFloat(option, 64); err == nil {
+				values = append(values, number)
+			}
+		default:
+			values = append(values, option)
+		}
+	}
+
+	return values
+}
+
+// zodStringRefinements writes the refinements of a string schema, from its format and the validation rules of its struct field.
+func zodStringRefinements(schema openapi.Schema, rules map[string]string) string {
+	var refinements []string
+	if refinement, ok := zodFormats[schema.Format]; ok {
+		refinements = append(refinements, refinement)
+	}
+	for _, name := range sortedRuleNames(rules) {
+		// The format of the rule can be the format of the schema too
+		if refinement, ok := zodRuleFormats[name]; ok && !slices.Contains(refinements, refinement) {
+			refinements = append(refinements, refinement)
+		}
+	}
+
+	return strings.Join(refinements, "") + zodLengthRefinements(rules)
+}
+
+// zodLengthRefinements writes the refinements of the length of a string or array schema, from its validation rules.
+func zodLengthRefinements(rules map[string]string) string {
+	var refinements []string
+	for _, name := range sortedRuleNames(rules) {
+		length, err := strconv.Atoi(rules[name])
+		if err != nil {
+			continue
+		}
+
+		switch name {
+		case "min", "gte":
+			refinements = append(refinements, fmt.Sprintf(".min(%d)", length))
+		case "max", "lte":
+			refinements = append(refinements, fmt.Sprintf(".max(%d)", length))
+		case "gt":
+			refinements = append(refinements, fmt.Sprintf(".min(%d)", length+1))
+		case "lt":
+			refinements = append(refinements, fmt.Sprintf(".max(%d)", length-1))
+		case "len":
+			refinements = append(refinements, fmt.Sprintf(".length(%d)", length))
+		}
+	}
+
+	return strings.Join(refinements, "")
+}
+
+// zodNumberRefinements writes the refinements of a number schema, from its validation rules.
+func zodNumberRefinements(rules map[string]string) string {
+	var refinements []string
+	for _, name := range sortedRuleNames(rules) {
+		switch name {
+		case "min", "max", "gt", "gte", "lt", "lte":
+			number, err := strconv.ParseFloat(rules[name], 64)
+			if err != nil {
+				continue
+			}
+			refinements = append(refinements, fmt.Sprintf(".%s(%s)", name, strconv.FormatFloat(number, 'f', -1, 64)))
+		}
+	}
+
+	return strings.Join(refinements, "")
+}
+
+// sortedRuleNames returns the names of the validation rules in order, so the refinements are in the same order every time.
+func sortedRuleNames(rules map[string]string) []string {
+	names := make([]string, 0, len(rules))
+	for name := range rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
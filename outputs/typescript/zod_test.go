@@ -0,0 +1,109 @@
+package typescript
+
+import (
+	"testing"
+
+	"github.com/ls6-events/astra/outputs/openapi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestZodSchemas(t *testing.T) {
+	t.Run("Objects", func(t *testing.T) {
+		contents := zodSchemas(map[string]openapi.Schema{
+			"Pet": {
+				Type:        "object",
+				Description: "Pet is a pet in the store.",
+				Properties: map[string]openapi.Schema{
+					"id":     {Type: "integer"},
+					"name":   {Type: "string"},
+					"email":  {Type: "string"},
+					"status": {Ref: "#/components/schemas/Status"},
+					"tag":    {Type: "string"},
+					"owner":  {Ref: "#/components/schemas/Owner", Nullable: true},
+				},
+			},
+			"Owner":  {Type: "object", Properties: map[string]openapi.Schema{"id": {Type: "string", Format: "uuid"}}},
+			"Status": {Type: "string", Enum: []any{"available", "sold"}},
+		}, openapi.GoTypes{
+			"Pet": {Fields: openapi.Fields{
+				"id":    {ValidationRules: map[string]string{"min": "1"}},
+				"name":  {ValidationRules: map[string]string{"min": "1", "max": "50"}},
+				"email": {ValidationRules: map[string]string{"email": ""}},
+				"tag":   {OmitEmpty: true},
+			}},
+		})
+
+		require.Equal(t, `// Code generated by astra. DO NOT EDIT.
+
+import { z } from "zod";
+
+export const Owner = z.object({
+  id: z.string().uuid(),
+});
+export type Owner = z.infer<typeof Owner>;
+
+export const Status = z.enum(["available", "sold"]);
+export type Status = z.infer<typeof Status>;
+
+/** Pet is a pet in the store. */
+export const Pet = z.object({
+  email: z.string().email(),
+  id: z.number().int().min(1),
+  name: z.string().max(50).min(1),
+  owner: Owner.nullable(),
+  status: Status,
+  tag: z.string().optional(),
+});
+export type Pet = z.infer<typeof Pet>;
+`, contents)
+	})
+
+	t.Run("Recursive", func(t *testing.T) {
+		contents := zodSchemas(map[string]openapi.Schema{
+			"Node": {
+				Type:        "object",
+				Description: "Node is a node of a tree.",
+				Properties: map[string]openapi.Schema{
+					"children": {Type: "array", Items: &openapi.Schema{Ref: "#/components/schemas/Node"}},
+				},
+			},
+		}, nil)
+
+		require.Contains(t, contents, `/** Node is a node of a tree. */
+export interface Node {
+  children: Node[];
+}
+export const Node: z.ZodType<Node> = z.object({
+  children: z.array(z.lazy(() => Node)),
+});
+`)
+	})
+}
+
+func TestComponentOrder(t *testing.T) {
+	order, recursive := componentOrder(map[string]openapi.Schema{
+		"A": {Ref: "#/components/schemas/B"},
+		"B": {Type: "array", Items: &openapi.Schema{Ref: "#/components/schemas/C"}},
+		"C": {Type: "string"},
+		"D": {Properties: map[string]openapi.Schema{"e": {Ref: "#/components/schemas/E"}}},
+		"E": {AllOf: []openapi.Schema{{Ref: "#/components/schemas/D"}}},
+	})
+
+	require.Equal(t, []string{"C", "B", "A", "E", "D"}, order)
+	require.Equal(t, map[string]bool{"D": true, "E": true}, recursive)
+}
+
+func TestZodWriter_Schema(t *testing.T) {
+	w := zodWriter{declared: map[string]bool{"Pet": true}}
+
+	require.Equal(t, `z.enum(["red", "green"])`, w.schema(openapi.Schema{Type: "string"}, openapi.Field{ValidationRules: map[string]string{"oneof": "red green"}}, ""))
+	require.Equal(t, "z.union([z.literal(1), z.literal(2)])", w.schema(openapi.Schema{Type: "integer", Enum: []any{1, 2}}, openapi.Field{}, ""))
+	require.Equal(t, "z.number().gt(0).lte(1.5)", w.schema(openapi.Schema{Type: "number"}, openapi.Field{ValidationRules: map[string]string{"gt": "0", "lte": "1.5"}}, ""))
+	require.Equal(t, "z.array(Pet).length(2)", w.schema(openapi.Schema{Type: "array", Items: &openapi.Schema{Ref: "#/components/schemas/Pet"}}, openapi.Field{ValidationRules: map[string]string{"len": "2"}}, ""))
+	require.Equal(t, "z.string().datetime({ offset: true })", w.schema(openapi.Schema{Type: "string", Format: "date-time"}, openapi.Field{}, ""))
+	require.Equal(t, "z.string().uuid()", w.schema(openapi.Schema{Type: "string", Format: "uuid"}, openapi.Field{ValidationRules: map[string]string{"uuid4": ""}}, ""))
+	require.Equal(t, "z.instanceof(Blob)", w.schema(openapi.Schema{Type: "string", Format: "binary"}, openapi.Field{}, ""))
+	require.Equal(t, "z.record(z.string(), z.boolean())", w.schema(openapi.Schema{Type: "object", AdditionalProperties: &openapi.Schema{Type: "boolean"}}, openapi.Field{}, ""))
+	require.Equal(t, "Pet.and(z.lazy(() => Tag))", w.schema(openapi.Schema{AllOf: []openapi.Schema{{Ref: "#/components/schemas/Pet"}, {Ref: "#/components/schemas/Tag"}}}, openapi.Field{}, ""))
+	require.Equal(t, "z.unknown()", w.schema(openapi.Schema{}, openapi.Field{}, ""))
+}
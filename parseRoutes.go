@@ -1,5 +1,7 @@
 package astra
 
+import "slices"
+
 // ParseRoutes iterates over the inputs and parses the routes from them.
 // CreateRoutes should be called before ParseRoutes.
 func (s *Service) ParseRoutes() error {
@@ -15,6 +17,19 @@ func (s *Service) ParseRoutes() error {
 	}
 	s.Log.Info().Msg("Parsing routes from inputs complete")
 
+	// Hidden routes are only parsed to find that they are hidden, so they are removed before the outputs see them
+	s.Routes = slices.DeleteFunc(s.Routes, func(route Route) bool {
+		if route.Hidden {
+			s.Log.Debug().Str("path", route.Path).Str("method", route.Method).Msg("Removing hidden route")
+		}
+		return route.Hidden
+	})
+
+	s.TagRoutes()
+	s.SummarizeRoutes()
+	s.SecureRoutes()
+	s.ExtendRoutes()
+
 	if s.CacheEnabled {
 		err := s.Cache()
 		if err != nil {
@@ -1,19 +1,64 @@
 package astra
 
+import (
+	"slices"
+	"strings"
+)
+
 // AddReturnType adds a return type to a slice of return types if it doesn't already exist.
 // It uses the field type, package and status code to determine if the return type already exists.
 func AddReturnType(prev []ReturnType, n ...ReturnType) []ReturnType {
 	for _, newReturn := range n {
-		if len(prev) == 0 {
+		var found bool
+		for i, existingReturn := range prev {
+			if newReturn.Field.Type == existingReturn.Field.Type && newReturn.Field.Package == existingReturn.Field.Package && newReturn.StatusCode == existingReturn.StatusCode && newReturn.ContentType == existingReturn.ContentType {
+				// The same response can be written in multiple places, each with its own headers and conditions
+				prev[i].Headers = AddHeader(existingReturn.Headers, newReturn.Headers...)
+				prev[i].Description = JoinDescriptions(existingReturn.Description, newReturn.Description)
+				found = true
+				break
+			}
+		}
+		if !found {
 			prev = append(prev, newReturn)
-		} else {
-			for _, existingReturn := range prev {
-				if newReturn.Field.Type != existingReturn.Field.Type || newReturn.Field.Package != existingReturn.Field.Package || newReturn.StatusCode != existingReturn.StatusCode || newReturn.ContentType != existingReturn.ContentType {
-					prev = append(prev, newReturn)
-					break
-				}
+		}
+	}
+
+	return prev
+}
+
+// JoinDescriptions joins the descriptions of a response that is written in multiple places.
+// Empty and repeated descriptions are skipped.
+func JoinDescriptions(descriptions ...string) string {
+	var joined []string
+	for _, description := range descriptions {
+		for _, part := range strings.Split(description, descriptionSeparator) {
+			if part != "" && !slices.Contains(joined, part) {
+				joined = append(joined, part)
+			}
+		}
+	}
+
+	return strings.Join(joined, descriptionSeparator)
+}
+
+// descriptionSeparator separates the descriptions of a response that is written in multiple places.
+const descriptionSeparator = "; "
+
+// AddHeader adds a header to a slice of headers if it doesn't already exist.
+// It uses the header name to determine if the header already exists.
+func AddHeader(prev []Param, n ...Param) []Param {
+	for _, newHeader := range n {
+		var found bool
+		for _, existingHeader := range prev {
+			if newHeader.Name == existingHeader.Name {
+				found = true
+				break
 			}
 		}
+		if !found {
+			prev = append(prev, newHeader)
+		}
 	}
 
 	return prev
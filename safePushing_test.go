@@ -61,6 +61,52 @@ func TestAddReturnType(t *testing.T) {
 		expected := append(existingSlice, differentFieldReturn)
 		assert.Equal(t, expected, result)
 	})
+
+	t.Run("MergingHeadersOfTheSameReturnType", func(t *testing.T) {
+		existingSlice := []ReturnType{
+			{StatusCode: 200, Headers: []Param{{Name: "X-Request-ID"}}},
+			{StatusCode: 400},
+		}
+		newReturn := ReturnType{
+			StatusCode: 400,
+			Headers:    []Param{{Name: "X-Request-ID"}},
+		}
+		result := AddReturnType(existingSlice, newReturn)
+		expected := []ReturnType{
+			{StatusCode: 200, Headers: []Param{{Name: "X-Request-ID"}}},
+			{StatusCode: 400, Headers: []Param{{Name: "X-Request-ID"}}},
+		}
+		assert.Equal(t, expected, result)
+	})
+}
+
+func TestJoinDescriptions(t *testing.T) {
+	t.Run("SkippingEmptyDescriptions", func(t *testing.T) {
+		assert.Equal(t, "pet not found", JoinDescriptions("", "pet not found", ""))
+	})
+
+	t.Run("SkippingRepeatedDescriptions", func(t *testing.T) {
+		assert.Equal(t, "pet not found; owner not found", JoinDescriptions("pet not found; owner not found", "owner not found"))
+	})
+}
+
+func TestAddHeader(t *testing.T) {
+	t.Run("AddingToEmptySlice", func(t *testing.T) {
+		result := AddHeader(nil, Param{Name: "X-Request-ID"})
+		assert.Equal(t, []Param{{Name: "X-Request-ID"}}, result)
+	})
+
+	t.Run("AddingToExistingSliceWithSameName", func(t *testing.T) {
+		existingSlice := []Param{{Name: "X-Request-ID", Field: Field{Type: "string"}}}
+		result := AddHeader(existingSlice, Param{Name: "X-Request-ID", Field: Field{Type: "int"}})
+		assert.Equal(t, existingSlice, result)
+	})
+
+	t.Run("AddingToExistingSliceWithDifferentName", func(t *testing.T) {
+		existingSlice := []Param{{Name: "X-Request-ID"}}
+		result := AddHeader(existingSlice, Param{Name: "X-Total-Count"})
+		assert.Equal(t, []Param{{Name: "X-Request-ID"}, {Name: "X-Total-Count"}}, result)
+	})
 }
 
 func TestAddComponent(t *testing.T) {
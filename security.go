@@ -0,0 +1,205 @@
+package astra
+
+import (
+	"reflect"
+	"runtime"
+	"slices"
+	"strings"
+)
+
+// SecuritySchemeType is the type of security scheme, which matches the types of the OpenAPI specification.
+type SecuritySchemeType string
+
+const (
+	SecuritySchemeTypeHTTP          SecuritySchemeType = "http"          // HTTP authentication (i.e. bearer or basic) in the Authorization header
+	SecuritySchemeTypeAPIKey        SecuritySchemeType = "apiKey"        // An API key in a header, query param or cookie
+	SecuritySchemeTypeOAuth2        SecuritySchemeType = "oauth2"        // OAuth2 with one or more flows
+	SecuritySchemeTypeOpenIDConnect SecuritySchemeType = "openIdConnect" // OpenID Connect discovered from its URL
+)
+
+// APIKeyLocation is where an API key is sent in the request.
+type APIKeyLocation string
+
+const (
+	APIKeyInHeader APIKeyLocation = "header"
+	APIKeyInQuery  APIKeyLocation = "query"
+	APIKeyInCookie APIKeyLocation = "cookie"
+)
+
+// SecurityScheme is a scheme the routes can be secured by.
+// It is named in the config, and the routes refer to it by its name in their security requirements.
+type SecurityScheme struct {
+	Type             SecuritySchemeType `json:"type"`
+	Description      string             `json:"description,omitempty"`
+	Scheme           string             `json:"scheme,omitempty"`           // for http schemes (i.e. bearer or basic)
+	BearerFormat     string             `json:"bearerFormat,omitempty"`     // for http bearer schemes (i.e. JWT)
+	Name             string             `json:"name,omitempty"`             // for apiKey schemes, the name of the header, query param or cookie
+	In               APIKeyLocation     `json:"in,omitempty"`               // for apiKey schemes
+	Flows            *OAuthFlows        `json:"flows,omitempty"`            // for oauth2 schemes
+	OpenIDConnectURL string             `json:"openIdConnectUrl,omitempty"` // for openIdConnect schemes
+}
+
+// OAuthFlows are the OAuth2 flows supported by an oauth2 security scheme.
+type OAuthFlows struct {
+	Implicit          *OAuthFlow `json:"implicit,omitempty"`
+	Password          *OAuthFlow `json:"password,omitempty"`
+	ClientCredentials *OAuthFlow `json:"clientCredentials,omitempty"`
+	AuthorizationCode *OAuthFlow `json:"authorizationCode,omitempty"`
+}
+
+// OAuthFlow is an OAuth2 flow, with the scopes available to it by their descriptions.
+type OAuthFlow struct {
+	AuthorizationURL string            `json:"authorizationUrl,omitempty"`
+	TokenURL         string            `json:"tokenUrl,omitempty"`
+	RefreshURL       string            `json:"refreshUrl,omitempty"`
+	Scopes           map[string]string `json:"scopes"`
+}
+
+// NewBearerSecurityScheme creates a security scheme for a bearer token in the Authorization header, of the format (i.e. JWT) if it is set.
+func NewBearerSecurityScheme(bearerFormat string) SecurityScheme {
+	return SecurityScheme{
+		Type:         SecuritySchemeTypeHTTP,
+		Scheme:       "bearer",
+		BearerFormat: bearerFormat,
+	}
+}
+
+// NewBasicSecurityScheme creates a security scheme for basic authentication in the Authorization header.
+func NewBasicSecurityScheme() SecurityScheme {
+	return SecurityScheme{
+		Type:   SecuritySchemeTypeHTTP,
+		Scheme: "basic",
+	}
+}
+
+// NewAPIKeySecurityScheme creates a security scheme for an API key, sent in the header, query param or cookie with the name.
+func NewAPIKeySecurityScheme(in APIKeyLocation, name string) SecurityScheme {
+	return SecurityScheme{
+		Type: SecuritySchemeTypeAPIKey,
+		In:   in,
+		Name: name,
+	}
+}
+
+// NewOAuth2SecurityScheme creates a security scheme for OAuth2 with the flows.
+func NewOAuth2SecurityScheme(flows OAuthFlows) SecurityScheme {
+	return SecurityScheme{
+		Type:  SecuritySchemeTypeOAuth2,
+		Flows: &flows,
+	}
+}
+
+// AddSecurityScheme adds a security scheme to the config by its name.
+func (c *Config) AddSecurityScheme(name string, scheme SecurityScheme) *Config {
+	if c.SecuritySchemes == nil {
+		c.SecuritySchemes = make(map[string]SecurityScheme)
+	}
+
+	c.SecuritySchemes[name] = scheme
+	return c
+}
+
+// WithSecurityMiddleware is an option to secure the routes that use the middleware by the security requirement (i.e. {"bearerAuth": {}}).
+// The middleware can be the middleware function itself, or the function that creates it (i.e. auth.RequireJWT for auth.RequireJWT()).
+// Routes that are secured by the @security directive keep that security instead.
+func WithSecurityMiddleware(middleware any, requirement SecurityRequirement) Option {
+	return func(s *Service) {
+		name := funcName(middleware)
+		if name == "" {
+			s.Log.Warn().Msg("Security middleware is not a function")
+			return
+		}
+
+		if s.SecurityMiddleware == nil {
+			s.SecurityMiddleware = make(map[string]SecurityRequirement)
+		}
+		s.SecurityMiddleware[name] = requirement
+	}
+}
+
+// SecureRoutes sets the security of the routes that use the security middleware.
+// A route using more than one security middleware needs all of their schemes, so their requirements are combined.
+func (s *Service) SecureRoutes() {
+	if len(s.SecurityMiddleware) == 0 {
+		return
+	}
+
+	for i, route := range s.Routes {
+		if len(route.Security) > 0 {
+			continue
+		}
+
+		var requirement SecurityRequirement
+		for _, middleware := range route.Middleware {
+			middlewareRequirement, ok := s.securityMiddlewareRequirement(middleware)
+			if !ok {
+				continue
+			}
+
+			if requirement == nil {
+				requirement = make(SecurityRequirement)
+			}
+			for scheme, scopes := range middlewareRequirement {
+				for _, scope := range scopes {
+					if !slices.Contains(requirement[scheme], scope) {
+						requirement[scheme] = append(requirement[scheme], scope)
+					}
+				}
+				if requirement[scheme] == nil {
+					requirement[scheme] = []string{}
+				}
+			}
+		}
+
+		if requirement != nil {
+			s.Routes[i].Security = []SecurityRequirement{requirement}
+		}
+	}
+
+	if s.Config == nil {
+		return
+	}
+	var missingSchemes []string
+	for _, requirement := range s.SecurityMiddleware {
+		for scheme := range requirement {
+			if _, ok := s.Config.SecuritySchemes[scheme]; !ok && !slices.Contains(missingSchemes, scheme) {
+				missingSchemes = append(missingSchemes, scheme)
+			}
+		}
+	}
+	slices.Sort(missingSchemes)
+	for _, scheme := range missingSchemes {
+		s.Log.Warn().Str("scheme", scheme).Msg("Security middleware requires a security scheme that isn't in the config")
+	}
+}
+
+// securityMiddlewareRequirement finds the security requirement of a middleware by its function name.
+// Middleware created by a function is named after it (i.e. auth.RequireJWT.func1), so it is found by the function that created it.
+func (s *Service) securityMiddlewareRequirement(middleware string) (SecurityRequirement, bool) {
+	if requirement, ok := s.SecurityMiddleware[middleware]; ok {
+		return requirement, true
+	}
+
+	for name, requirement := range s.SecurityMiddleware {
+		if strings.HasPrefix(middleware, name+".func") {
+			return requirement, true
+		}
+	}
+
+	return nil, false
+}
+
+// funcName returns the full name of a function (i.e. github.com/example/auth.RequireJWT), or an empty string if it isn't a function.
+func funcName(f any) string {
+	value := reflect.ValueOf(f)
+	if value.Kind() != reflect.Func || value.IsNil() {
+		return ""
+	}
+
+	runtimeFunc := runtime.FuncForPC(value.Pointer())
+	if runtimeFunc == nil {
+		return ""
+	}
+
+	return runtimeFunc.Name()
+}
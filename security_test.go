@@ -0,0 +1,65 @@
+package astra
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func requireJWT() func() {
+	return func() {}
+}
+
+func requireAdmin() {}
+
+func TestConfig_AddSecurityScheme(t *testing.T) {
+	config := &Config{}
+
+	config.AddSecurityScheme("bearerAuth", NewBearerSecurityScheme("JWT")).
+		AddSecurityScheme("apiKey", NewAPIKeySecurityScheme(APIKeyInHeader, "X-API-Key"))
+
+	require.Equal(t, SecurityScheme{Type: SecuritySchemeTypeHTTP, Scheme: "bearer", BearerFormat: "JWT"}, config.SecuritySchemes["bearerAuth"])
+	require.Equal(t, SecurityScheme{Type: SecuritySchemeTypeAPIKey, In: APIKeyInHeader, Name: "X-API-Key"}, config.SecuritySchemes["apiKey"])
+}
+
+func TestWithSecurityMiddleware(t *testing.T) {
+	t.Run("Function", func(t *testing.T) {
+		service := &Service{}
+
+		WithSecurityMiddleware(requireJWT, SecurityRequirement{"bearerAuth": {}})(service)
+
+		require.Equal(t, SecurityRequirement{"bearerAuth": {}}, service.SecurityMiddleware["github.com/ls6-events/astra.requireJWT"])
+	})
+
+	t.Run("Not A Function", func(t *testing.T) {
+		service := &Service{}
+
+		WithSecurityMiddleware("requireJWT", SecurityRequirement{"bearerAuth": {}})(service)
+
+		require.Empty(t, service.SecurityMiddleware)
+	})
+}
+
+func TestService_SecureRoutes(t *testing.T) {
+	service := &Service{
+		Config: &Config{},
+		Routes: []Route{
+			{Path: "/pets", Middleware: []string{"github.com/gin-gonic/gin.LoggerWithConfig.func1", "github.com/ls6-events/astra.requireJWT.func1"}},
+			{Path: "/admin", Middleware: []string{"github.com/ls6-events/astra.requireJWT.func1", "github.com/ls6-events/astra.requireAdmin"}},
+			{Path: "/health", Middleware: []string{"github.com/gin-gonic/gin.LoggerWithConfig.func1"}},
+			{Path: "/owners", Middleware: []string{"github.com/ls6-events/astra.requireJWT.func1"}, Security: []SecurityRequirement{{"apiKey": {}}}},
+		},
+	}
+	service.Config.AddSecurityScheme("bearerAuth", NewBearerSecurityScheme("JWT"))
+
+	WithSecurityMiddleware(requireJWT, SecurityRequirement{"bearerAuth": {}})(service)
+	WithSecurityMiddleware(requireAdmin, SecurityRequirement{"oauth2": {"admin"}})(service)
+
+	service.SecureRoutes()
+
+	require.Equal(t, []SecurityRequirement{{"bearerAuth": {}}}, service.Routes[0].Security)
+	// The schemes of every security middleware are needed
+	require.Equal(t, []SecurityRequirement{{"bearerAuth": {}, "oauth2": {"admin"}}}, service.Routes[1].Security)
+	require.Nil(t, service.Routes[2].Security)
+	// The @security directive takes precedence over the middleware
+	require.Equal(t, []SecurityRequirement{{"apiKey": {}}}, service.Routes[3].Security)
+}
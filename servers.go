@@ -0,0 +1,46 @@
+package astra
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Server is a server the API is deployed to (i.e. local, staging or production).
+// The URL can contain variables in braces (i.e. https://{environment}.example.com{basePath}), which are described by the variables.
+type Server struct {
+	URL         string                    `json:"url"`
+	Description string                    `json:"description,omitempty"`
+	Variables   map[string]ServerVariable `json:"variables,omitempty"`
+}
+
+// ServerVariable is a variable in the URL of a server.
+// The default is used when the variable isn't set, and if there is an enum the variable can only be one of its values.
+type ServerVariable struct {
+	Enum        []string `json:"enum,omitempty"`
+	Default     string   `json:"default"`
+	Description string   `json:"description,omitempty"`
+}
+
+// AddServer adds a server to the config.
+// If the config has no servers, the server from the host, port, secure and base path fields is used instead.
+func (c *Config) AddServer(server Server) *Config {
+	c.Servers = append(c.Servers, server)
+	return c
+}
+
+// validateServers validates the servers of the config, and the variables of their URLs.
+func (c *Config) validateServers() error {
+	for _, server := range c.Servers {
+		if server.URL == "" {
+			return ErrConfigServerURLRequired
+		}
+
+		for name, variable := range server.Variables {
+			if len(variable.Enum) > 0 && !slices.Contains(variable.Enum, variable.Default) {
+				return fmt.Errorf("%w: %s in %s", ErrConfigServerVariableDefaultNotInEnum, name, server.URL)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package astra
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestConfig_AddServer(t *testing.T) {
+	config := &Config{}
+
+	config.AddServer(Server{URL: "http://localhost:8000", Description: "Local"}).
+		AddServer(Server{URL: "https://api.example.com", Description: "Production"})
+
+	require.Equal(t, []Server{
+		{URL: "http://localhost:8000", Description: "Local"},
+		{URL: "https://api.example.com", Description: "Production"},
+	}, config.Servers)
+}
+
+func TestConfig_ValidateServers(t *testing.T) {
+	t.Run("valid servers", func(t *testing.T) {
+		config := &Config{
+			Servers: []Server{
+				{
+					URL: "https://{environment}.example.com{basePath}",
+					Variables: map[string]ServerVariable{
+						"environment": {Enum: []string{"staging", "api"}, Default: "api"},
+						"basePath":    {Default: "/v1"},
+					},
+				},
+			},
+		}
+
+		require.NoError(t, config.validateServers())
+	})
+
+	t.Run("server without url", func(t *testing.T) {
+		config := &Config{
+			Servers: []Server{{Description: "Local"}},
+		}
+
+		require.ErrorIs(t, config.validateServers(), ErrConfigServerURLRequired)
+	})
+
+	t.Run("variable default not in enum", func(t *testing.T) {
+		config := &Config{
+			Servers: []Server{
+				{
+					URL: "https://{environment}.example.com",
+					Variables: map[string]ServerVariable{
+						"environment": {Enum: []string{"staging", "api"}, Default: "prod"},
+					},
+				},
+			},
+		}
+
+		require.ErrorIs(t, config.validateServers(), ErrConfigServerVariableDefaultNotInEnum)
+	})
+}
@@ -29,6 +29,11 @@ type Service struct {
 
 	Components []Field `json:"components" yaml:"components"`
 
+	// Tags are the tags of the routes, in the order they are first used
+	Tags []Tag `json:"tags,omitempty" yaml:"tags,omitempty"`
+	// Packages are the packages of the handlers of the routes, by their import path
+	Packages map[string]Package `json:"packages,omitempty" yaml:"packages,omitempty"`
+
 	tempMainPackageName string
 	WorkDir             string `json:"-" yaml:"-"`
 
@@ -40,6 +45,20 @@ type Service struct {
 
 	CustomFuncs []CustomFunc `json:"-" yaml:"-"`
 
+	TagStrategy TagStrategy `json:"tagStrategy,omitempty" yaml:"tagStrategy,omitempty"`
+	TagFunc     TagFunc     `json:"-" yaml:"-"`
+
+	// SecurityMiddleware are the security requirements of the routes using the middleware, by the function name of the middleware
+	SecurityMiddleware map[string]SecurityRequirement `json:"securityMiddleware,omitempty" yaml:"securityMiddleware,omitempty"`
+
+	// StripDocSummary removes the summary of a route from the start of its doc, so it isn't repeated in the description
+	StripDocSummary bool `json:"stripDocSummary,omitempty" yaml:"stripDocSummary,omitempty"`
+
+	// ExtensionFunc returns the vendor extensions of each route, which aren't cached as the function can't be
+	ExtensionFunc ExtensionFunc `json:"-" yaml:"-"`
+	// GoTypeExtensions adds the Go type and package of each component to it as the x-go-type and x-go-package extensions
+	GoTypeExtensions bool `json:"goTypeExtensions,omitempty" yaml:"goTypeExtensions,omitempty"`
+
 	// CustomTypeMapping is a map of custom types to their OpenAPI type and format
 	CustomTypeMapping map[string]TypeFormat `json:"custom_type_mapping" yaml:"custom_type_mapping"`
 	// fullTypeMapping is a full map of types to their OpenAPI type and format (to save merging the custom type mapping with the predefined type mapping every time)
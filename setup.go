@@ -30,7 +30,13 @@ func (s *Service) Setup() error {
 	}
 
 	if s.CacheEnabled {
-		err := s.Cache()
+		// The cache is replaced either way, so the outputs are generated without their cached configurations (i.e. field numbers) if it can't be read
+		err := s.RestoreCachedOutputs()
+		if err != nil {
+			s.Log.Warn().Err(err).Msg("Error restoring cached outputs")
+		}
+
+		err = s.Cache()
 		if err != nil {
 			s.Log.Error().Err(err).Msg("Error caching")
 			return err
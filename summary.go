@@ -0,0 +1,88 @@
+package astra
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/iancoleman/strcase"
+)
+
+// anonymousFuncRegex matches the names Go gives to anonymous functions (i.e. func1), which can't be made into a summary.
+var anonymousFuncRegex = regexp.MustCompile(`^func\d+$`)
+
+// WithDocSummaryStripped is an option to remove the summary of a route from the start of its doc, so it isn't repeated in the description.
+// Only summaries found from the first sentence of the doc are removed, not those set by the @summary directive.
+func WithDocSummaryStripped() Option {
+	return func(s *Service) {
+		s.StripDocSummary = true
+	}
+}
+
+// SummarizeRoutes sets the summary of the routes that don't have one (from the @summary directive).
+// The summary is the first sentence of the doc of the route, or the name of its handler if it has no doc (i.e. GetPost is "Get post").
+func (s *Service) SummarizeRoutes() {
+	for i, route := range s.Routes {
+		if route.Summary != "" {
+			continue
+		}
+
+		sentence, rest := firstSentence(route.Doc)
+		if sentence == "" {
+			s.Routes[i].Summary = humanizeHandlerName(route.Handler)
+			continue
+		}
+
+		s.Routes[i].Summary = strings.TrimSuffix(sentence, ".")
+		if s.StripDocSummary {
+			s.Routes[i].Doc = rest
+		}
+	}
+}
+
+// firstSentence splits the first sentence from the rest of the doc.
+// The sentence ends at the first period followed by a space (or the end of the doc), or at the end of the first paragraph.
+// Its lines are joined into one, as a summary is a single line.
+func firstSentence(doc string) (string, string) {
+	doc = strings.TrimSpace(doc)
+
+	end, restStart := len(doc), len(doc)
+	for i := 0; i < len(doc); i++ {
+		if doc[i] == '\n' && i+1 < len(doc) && doc[i+1] == '\n' {
+			end, restStart = i, i
+			break
+		}
+
+		if doc[i] != '.' {
+			continue
+		}
+
+		next, _ := utf8.DecodeRuneInString(doc[i+1:])
+		if i+1 == len(doc) || unicode.IsSpace(next) {
+			end, restStart = i+1, i+1
+			break
+		}
+	}
+
+	return strings.Join(strings.Fields(doc[:end]), " "), strings.TrimSpace(doc[restStart:])
+}
+
+// humanizeHandlerName turns the name of a handler into a sentence (i.e. github.com/example/pets.GetPost is "Get post").
+// Anonymous handlers don't have a name to use, so they have no summary.
+func humanizeHandlerName(handler string) string {
+	name := handler
+	if i := strings.LastIndex(name, "."); i != -1 {
+		name = name[i+1:]
+	}
+	// Methods used as handlers are suffixed by -fm
+	name = strings.TrimSuffix(name, "-fm")
+
+	if name == "" || anonymousFuncRegex.MatchString(name) {
+		return ""
+	}
+
+	words := strcase.ToDelimited(name, ' ')
+	first, size := utf8.DecodeRuneInString(words)
+	return string(unicode.ToUpper(first)) + words[size:]
+}
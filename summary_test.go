@@ -0,0 +1,84 @@
+package astra
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestWithDocSummaryStripped(t *testing.T) {
+	service := &Service{}
+
+	WithDocSummaryStripped()(service)
+
+	require.True(t, service.StripDocSummary)
+}
+
+func TestService_SummarizeRoutes(t *testing.T) {
+	newService := func() *Service {
+		return &Service{
+			Routes: []Route{
+				{Handler: "example.com/pets.getPet", Doc: "getPet gets a pet. It is found by its ID."},
+				{Handler: "example.com/pets.GetPost"},
+				{Handler: "example.com/pets.listPets", Doc: "listPets lists the pets.", Summary: "List pets"},
+				{Handler: "example.com/pets.setupRouter.func1"},
+				{Handler: "example.com/pets.(*Handler).DeletePet-fm", Doc: "DeletePet deletes a pet\nby its ID\n\nThe pet can't be restored."},
+			},
+		}
+	}
+
+	t.Run("Keeps Doc", func(t *testing.T) {
+		service := newService()
+		service.SummarizeRoutes()
+
+		require.Equal(t, "getPet gets a pet", service.Routes[0].Summary)
+		require.Equal(t, "getPet gets a pet. It is found by its ID.", service.Routes[0].Doc)
+		require.Equal(t, "Get post", service.Routes[1].Summary)
+		require.Equal(t, "List pets", service.Routes[2].Summary)
+		require.Empty(t, service.Routes[3].Summary)
+		require.Equal(t, "DeletePet deletes a pet by its ID", service.Routes[4].Summary)
+	})
+
+	t.Run("Strips Doc Summary", func(t *testing.T) {
+		service := newService()
+		WithDocSummaryStripped()(service)
+		service.SummarizeRoutes()
+
+		require.Equal(t, "getPet gets a pet", service.Routes[0].Summary)
+		require.Equal(t, "It is found by its ID.", service.Routes[0].Doc)
+		// A summary from the @summary directive isn't in the doc
+		require.Equal(t, "listPets lists the pets.", service.Routes[2].Doc)
+		require.Equal(t, "The pet can't be restored.", service.Routes[4].Doc)
+	})
+}
+
+func TestFirstSentence(t *testing.T) {
+	tests := []struct {
+		name     string
+		doc      string
+		sentence string
+		rest     string
+	}{
+		{name: "Empty", doc: "", sentence: "", rest: ""},
+		{name: "One Sentence", doc: "getPet gets a pet.", sentence: "getPet gets a pet.", rest: ""},
+		{name: "No Period", doc: "getPet gets a pet", sentence: "getPet gets a pet", rest: ""},
+		{name: "Many Sentences", doc: "getPet gets a pet. It is found by its ID.", sentence: "getPet gets a pet.", rest: "It is found by its ID."},
+		{name: "Period Within Word", doc: "getPet gets a pet from example.com. It is found by its ID.", sentence: "getPet gets a pet from example.com.", rest: "It is found by its ID."},
+		{name: "Sentence Over Lines", doc: "getPet gets\na pet.\nIt is found by its ID.", sentence: "getPet gets a pet.", rest: "It is found by its ID."},
+		{name: "Paragraph", doc: "getPet gets a pet\n\nIt is found by its ID.", sentence: "getPet gets a pet", rest: "It is found by its ID."},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sentence, rest := firstSentence(test.doc)
+			require.Equal(t, test.sentence, sentence)
+			require.Equal(t, test.rest, rest)
+		})
+	}
+}
+
+func TestHumanizeHandlerName(t *testing.T) {
+	require.Equal(t, "Get post", humanizeHandlerName("example.com/pets.GetPost"))
+	require.Equal(t, "Get pet by id", humanizeHandlerName("example.com/pets.getPetByID"))
+	require.Equal(t, "Delete pet", humanizeHandlerName("example.com/pets.(*Handler).DeletePet-fm"))
+	require.Empty(t, humanizeHandlerName("example.com/pets.setupRouter.func1"))
+}
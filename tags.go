@@ -0,0 +1,144 @@
+package astra
+
+import (
+	"slices"
+	"strings"
+)
+
+// TagStrategy is the strategy used to tag the routes, which groups their operations in the outputs.
+type TagStrategy string
+
+const (
+	TagStrategyNone        TagStrategy = ""            // The routes are only tagged by the @tags directive
+	TagStrategyPathSegment TagStrategy = "pathSegment" // The routes are tagged by the first segment of their path after the base path
+	TagStrategyPackage     TagStrategy = "package"     // The routes are tagged by the name of the Go package of their handler
+	TagStrategyRouterGroup TagStrategy = "routerGroup" // The routes are tagged by the prefix of the router group they are registered in
+	TagStrategyFunc        TagStrategy = "func"        // The routes are tagged by a custom function
+)
+
+// TagFunc is a custom function that returns the tags of a route.
+type TagFunc func(Route) []string
+
+// Tag is a tag that groups routes, with a description of the group.
+type Tag struct {
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// Package is a Go package containing the handlers of routes.
+// It is used to name and describe the routes by the package they are in.
+type Package struct {
+	Name string `json:"name" yaml:"name"`
+	Doc  string `json:"doc,omitempty" yaml:"doc,omitempty"`
+}
+
+// WithTagStrategy is an option to tag the routes by one of the predefined strategies.
+// Routes that are tagged by the @tags directive keep those tags instead.
+func WithTagStrategy(strategy TagStrategy) Option {
+	return func(s *Service) {
+		s.TagStrategy = strategy
+	}
+}
+
+// WithTagFunc is an option to tag the routes by a custom function.
+// Routes that are tagged by the @tags directive keep those tags instead.
+// The function can't be cached, so it isn't used when the routes are parsed by the CLI.
+func WithTagFunc(tagFunc TagFunc) Option {
+	return func(s *Service) {
+		s.TagStrategy = TagStrategyFunc
+		s.TagFunc = tagFunc
+	}
+}
+
+// AddPackage adds the package of a handler to the service, by its import path.
+func (s *Service) AddPackage(path string, pkg Package) {
+	if s.Packages == nil {
+		s.Packages = make(map[string]Package)
+	}
+
+	s.Packages[path] = pkg
+}
+
+// TagRoutes tags the routes by the tag strategy, and collects their tags.
+// A tag is described by the doc comment of the package of its routes, if they are all in the same package.
+func (s *Service) TagRoutes() {
+	s.Tags = nil
+	for i, route := range s.Routes {
+		if len(route.Tags) > 0 {
+			continue
+		}
+
+		s.Routes[i].Tags = s.routeTags(route)
+	}
+
+	tagPackages := make(map[string][]string)
+	for _, route := range s.Routes {
+		for _, tag := range route.Tags {
+			if _, ok := tagPackages[tag]; !ok {
+				s.Tags = append(s.Tags, Tag{Name: tag})
+			}
+			if !slices.Contains(tagPackages[tag], route.Package) {
+				tagPackages[tag] = append(tagPackages[tag], route.Package)
+			}
+		}
+	}
+
+	for i, tag := range s.Tags {
+		if len(tagPackages[tag.Name]) == 1 {
+			s.Tags[i].Description = s.Packages[tagPackages[tag.Name][0]].Doc
+		}
+	}
+}
+
+// routeTags returns the tags of a route by the tag strategy.
+func (s *Service) routeTags(route Route) []string {
+	var tag string
+	switch s.TagStrategy {
+	case TagStrategyPathSegment:
+		tag = firstPathSegment(route.Path, s.basePath())
+	case TagStrategyPackage:
+		tag = s.Packages[route.Package].Name
+	case TagStrategyRouterGroup:
+		tag = strings.Trim(trimBasePath(route.Group, s.basePath()), "/")
+	case TagStrategyFunc:
+		if s.TagFunc != nil {
+			return s.TagFunc(route)
+		}
+	}
+
+	if tag == "" {
+		return nil
+	}
+
+	return []string{tag}
+}
+
+// basePath returns the base path of the routes, if there is one.
+func (s *Service) basePath() string {
+	if s.Config == nil {
+		return ""
+	}
+
+	return s.Config.BasePath
+}
+
+// trimBasePath removes the base path from the start of a path, if it is there.
+func trimBasePath(path string, basePath string) string {
+	basePath = strings.TrimSuffix(basePath, "/")
+	if basePath != "" && (path == basePath || strings.HasPrefix(path, basePath+"/")) {
+		return strings.TrimPrefix(path, basePath)
+	}
+
+	return path
+}
+
+// firstPathSegment returns the first segment of the path after the base path.
+// Path params aren't a segment that can be grouped by, so they return an empty segment.
+func firstPathSegment(path string, basePath string) string {
+	segment, _, _ := strings.Cut(strings.TrimPrefix(trimBasePath(path, basePath), "/"), "/")
+	if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+		return ""
+	}
+
+	return segment
+}
@@ -0,0 +1,115 @@
+package astra
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestWithTagStrategy(t *testing.T) {
+	service := &Service{}
+
+	WithTagStrategy(TagStrategyPackage)(service)
+
+	require.Equal(t, TagStrategyPackage, service.TagStrategy)
+}
+
+func TestWithTagFunc(t *testing.T) {
+	service := &Service{}
+
+	WithTagFunc(func(route Route) []string {
+		return []string{route.Method}
+	})(service)
+
+	require.Equal(t, TagStrategyFunc, service.TagStrategy)
+	require.NotNil(t, service.TagFunc)
+}
+
+func TestService_TagRoutes(t *testing.T) {
+	newService := func(strategy TagStrategy) *Service {
+		return &Service{
+			Config: &Config{
+				BasePath: "/api/v1",
+			},
+			TagStrategy: strategy,
+			Routes: []Route{
+				{Method: "GET", Path: "/api/v1/pets/:id", Package: "example.com/pets", Group: "/api/v1/pets"},
+				{Method: "GET", Path: "/api/v1/owners", Package: "example.com/owners", Group: "/api/v1/owners"},
+				{Method: "GET", Path: "/api/v1/:id", Package: "example.com/pets", Group: "/api/v1"},
+				{Method: "GET", Path: "/health", Package: "example.com/health", Tags: []string{"internal"}},
+			},
+			Packages: map[string]Package{
+				"example.com/pets":   {Name: "pets", Doc: "Package pets manages the pets."},
+				"example.com/owners": {Name: "owners"},
+				"example.com/health": {Name: "health", Doc: "Package health checks the service."},
+			},
+		}
+	}
+
+	t.Run("None", func(t *testing.T) {
+		service := newService(TagStrategyNone)
+		service.TagRoutes()
+
+		require.Nil(t, service.Routes[0].Tags)
+		require.Equal(t, []string{"internal"}, service.Routes[3].Tags)
+		require.Equal(t, []Tag{{Name: "internal", Description: "Package health checks the service."}}, service.Tags)
+	})
+
+	t.Run("Path Segment", func(t *testing.T) {
+		service := newService(TagStrategyPathSegment)
+		service.TagRoutes()
+
+		require.Equal(t, []string{"pets"}, service.Routes[0].Tags)
+		require.Equal(t, []string{"owners"}, service.Routes[1].Tags)
+		require.Nil(t, service.Routes[2].Tags)
+		require.Equal(t, []string{"internal"}, service.Routes[3].Tags)
+		require.Equal(t, []Tag{
+			{Name: "pets", Description: "Package pets manages the pets."},
+			{Name: "owners"},
+			{Name: "internal", Description: "Package health checks the service."},
+		}, service.Tags)
+	})
+
+	t.Run("Package", func(t *testing.T) {
+		service := newService(TagStrategyPackage)
+		service.TagRoutes()
+
+		require.Equal(t, []string{"pets"}, service.Routes[0].Tags)
+		require.Equal(t, []string{"owners"}, service.Routes[1].Tags)
+		require.Equal(t, []string{"pets"}, service.Routes[2].Tags)
+		require.Equal(t, []Tag{
+			{Name: "pets", Description: "Package pets manages the pets."},
+			{Name: "owners"},
+			{Name: "internal", Description: "Package health checks the service."},
+		}, service.Tags)
+	})
+
+	t.Run("Router Group", func(t *testing.T) {
+		service := newService(TagStrategyRouterGroup)
+		service.TagRoutes()
+
+		require.Equal(t, []string{"pets"}, service.Routes[0].Tags)
+		require.Equal(t, []string{"owners"}, service.Routes[1].Tags)
+		require.Nil(t, service.Routes[2].Tags)
+	})
+
+	t.Run("Func", func(t *testing.T) {
+		service := newService(TagStrategyNone)
+		WithTagFunc(func(route Route) []string {
+			return []string{route.Method, "all"}
+		})(service)
+		service.TagRoutes()
+
+		require.Equal(t, []string{"GET", "all"}, service.Routes[0].Tags)
+		require.Equal(t, []string{"internal"}, service.Routes[3].Tags)
+		// The routes of a tag are in more than one package, so it can't be described by one
+		require.Equal(t, []Tag{{Name: "GET"}, {Name: "all"}, {Name: "internal", Description: "Package health checks the service."}}, service.Tags)
+	})
+
+	t.Run("Tagging Again Doesn't Duplicate Tags", func(t *testing.T) {
+		service := newService(TagStrategyPathSegment)
+		service.TagRoutes()
+		service.TagRoutes()
+
+		require.Len(t, service.Tags, 3)
+	})
+}
@@ -1,11 +1,22 @@
 package petstore
 
 import (
+	"mime/multipart"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 )
 
+// PetPhotos is a form containing photos of a pet.
+type PetPhotos struct {
+	// Name is the name of the pet.
+	Name string `form:"name"`
+	// Cover is the main photo of the pet.
+	Cover *multipart.FileHeader `form:"cover"`
+	// Photos are the additional photos of the pet.
+	Photos []*multipart.FileHeader `form:"photos"`
+}
+
 func uploadFile(c *gin.Context) {
 	_, err := c.FormFile("file")
 	if err != nil {
@@ -15,3 +26,38 @@ func uploadFile(c *gin.Context) {
 
 	c.Status(http.StatusOK)
 }
+
+func uploadFiles(c *gin.Context) {
+	form, err := c.MultipartForm()
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	_ = form.File["files"]
+	_ = form.Value["tags"]
+
+	c.Status(http.StatusOK)
+}
+
+func uploadWithFields(c *gin.Context) {
+	_, err := c.FormFile("file")
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	_ = c.PostForm("description")
+
+	c.Status(http.StatusOK)
+}
+
+func uploadBound(c *gin.Context) {
+	var photos PetPhotos
+	if err := c.ShouldBind(&photos); err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
@@ -18,6 +18,49 @@ func TestPaths(t *testing.T) {
 
 	require.NotNil(t, testAstra)
 
-	require.Equal(t, "string", testAstra.Search("paths", "/upload", "post", "requestBody", "content", "multipart/form-data", "schema", "properties", "file", "type").Data().(string))
-	require.Equal(t, "binary", testAstra.Search("paths", "/upload", "post", "requestBody", "content", "multipart/form-data", "schema", "properties", "file", "format").Data().(string))
+	t.Run("Form File", func(t *testing.T) {
+		require.Equal(t, "string", testAstra.Search("paths", "/upload", "post", "requestBody", "content", "multipart/form-data", "schema", "properties", "file", "type").Data().(string))
+		require.Equal(t, "binary", testAstra.Search("paths", "/upload", "post", "requestBody", "content", "multipart/form-data", "schema", "properties", "file", "format").Data().(string))
+		require.Equal(t, "application/octet-stream", testAstra.Search("paths", "/upload", "post", "requestBody", "content", "multipart/form-data", "encoding", "file", "contentType").Data().(string))
+	})
+
+	t.Run("Multipart Form", func(t *testing.T) {
+		content := testAstra.Search("paths", "/upload/many", "post", "requestBody", "content", "multipart/form-data")
+
+		require.Equal(t, "array", content.Search("schema", "properties", "files", "type").Data().(string))
+		require.Equal(t, "string", content.Search("schema", "properties", "files", "items", "type").Data().(string))
+		require.Equal(t, "binary", content.Search("schema", "properties", "files", "items", "format").Data().(string))
+		require.Equal(t, "application/octet-stream", content.Search("encoding", "files", "contentType").Data().(string))
+
+		require.Equal(t, "array", content.Search("schema", "properties", "tags", "type").Data().(string))
+		require.Equal(t, "string", content.Search("schema", "properties", "tags", "items", "type").Data().(string))
+		require.False(t, content.Exists("encoding", "tags"))
+	})
+
+	t.Run("Mixed Parts", func(t *testing.T) {
+		requestBody := testAstra.Search("paths", "/upload/form", "post", "requestBody")
+
+		require.Len(t, requestBody.Search("content").ChildrenMap(), 1)
+		require.Equal(t, "binary", requestBody.Search("content", "multipart/form-data", "schema", "properties", "file", "format").Data().(string))
+		require.Equal(t, "string", requestBody.Search("content", "multipart/form-data", "schema", "properties", "description", "type").Data().(string))
+	})
+
+	t.Run("Bound Form", func(t *testing.T) {
+		requestBody := testAstra.Search("paths", "/upload/bound", "post", "requestBody")
+
+		require.False(t, requestBody.Exists("content", "application/x-www-form-urlencoded"))
+		require.Equal(t, "#/components/schemas/PetPhotos", requestBody.Search("content", "multipart/form-data", "schema", "$ref").Data().(string))
+		require.Equal(t, "application/octet-stream", requestBody.Search("content", "multipart/form-data", "encoding", "cover", "contentType").Data().(string))
+		require.Equal(t, "application/octet-stream", requestBody.Search("content", "multipart/form-data", "encoding", "photos", "contentType").Data().(string))
+
+		// Files are only sent as parts of the form, never as query parameters
+		require.Len(t, testAstra.Search("paths", "/upload/bound", "post", "parameters").Children(), 1)
+		require.Equal(t, "name", testAstra.Search("paths", "/upload/bound", "post", "parameters", "0", "name").Data().(string))
+
+		schema := testAstra.Search("components", "schemas", "PetPhotos", "properties")
+		require.Equal(t, "binary", schema.Search("cover", "format").Data().(string))
+		require.Equal(t, "array", schema.Search("photos", "type").Data().(string))
+		require.Equal(t, "binary", schema.Search("photos", "items", "format").Data().(string))
+		require.False(t, testAstra.Exists("components", "schemas", "FileHeader"))
+	})
 }
@@ -6,6 +6,9 @@ func setupRouter() *gin.Engine {
 	r := gin.Default()
 
 	r.POST("/upload", uploadFile)
+	r.POST("/upload/many", uploadFiles)
+	r.POST("/upload/form", uploadWithFields)
+	r.POST("/upload/bound", uploadBound)
 
 	return r
 }
@@ -0,0 +1,52 @@
+package petstore
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func getPetByID(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	c.JSON(http.StatusOK, id)
+}
+
+func getOwnerPet(c *gin.Context) {
+	ownerIDParam := c.Param("ownerID")
+	ownerID, err := uuid.Parse(ownerIDParam)
+	if err != nil {
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	limit, _ := strconv.ParseInt(c.Query("limit"), 10, 32)
+
+	c.JSON(http.StatusOK, gin.H{
+		"ownerID": ownerID,
+		"name":    c.Param("name"),
+		"limit":   limit,
+	})
+}
+
+type BoundURI struct {
+	// ID is the numeric identifier.
+	ID   int    `uri:"id" binding:"required,min=1,max=1000"`
+	Slug string `uri:"slug" binding:"required,oneof=cat dog"`
+}
+
+func getBound(c *gin.Context) {
+	var uri BoundURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
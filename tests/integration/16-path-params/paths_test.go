@@ -0,0 +1,59 @@
+package petstore
+
+import (
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestPathParams(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	testAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r)
+	require.NoError(t, err)
+
+	require.NotNil(t, testAstra)
+
+	paths := testAstra.Search("paths")
+
+	t.Run("Converted Param", func(t *testing.T) {
+		require.Equal(t, "id", paths.Search("/pets/{id}", "get", "parameters", "0", "name").Data().(string))
+		require.Equal(t, "path", paths.Search("/pets/{id}", "get", "parameters", "0", "in").Data().(string))
+		require.Equal(t, "integer", paths.Search("/pets/{id}", "get", "parameters", "0", "schema", "type").Data().(string))
+		require.Equal(t, "int32", paths.Search("/pets/{id}", "get", "parameters", "0", "schema", "format").Data().(string))
+	})
+
+	t.Run("Converted Variable", func(t *testing.T) {
+		// Parameters are sorted by name: limit, name, ownerID
+		require.Equal(t, "limit", paths.Search("/owners/{ownerID}/pets/{name}", "get", "parameters", "0", "name").Data().(string))
+		require.Equal(t, "integer", paths.Search("/owners/{ownerID}/pets/{name}", "get", "parameters", "0", "schema", "type").Data().(string))
+		require.Equal(t, "int32", paths.Search("/owners/{ownerID}/pets/{name}", "get", "parameters", "0", "schema", "format").Data().(string))
+
+		require.Equal(t, "name", paths.Search("/owners/{ownerID}/pets/{name}", "get", "parameters", "1", "name").Data().(string))
+		require.Equal(t, "string", paths.Search("/owners/{ownerID}/pets/{name}", "get", "parameters", "1", "schema", "type").Data().(string))
+
+		require.Equal(t, "ownerID", paths.Search("/owners/{ownerID}/pets/{name}", "get", "parameters", "2", "name").Data().(string))
+		require.Equal(t, "string", paths.Search("/owners/{ownerID}/pets/{name}", "get", "parameters", "2", "schema", "type").Data().(string))
+		require.Equal(t, "uuid", paths.Search("/owners/{ownerID}/pets/{name}", "get", "parameters", "2", "schema", "format").Data().(string))
+	})
+
+	t.Run("Bound URI", func(t *testing.T) {
+		require.Len(t, paths.Search("/bound/{id}/{slug}", "get", "parameters").Children(), 2)
+
+		require.Equal(t, "id", paths.Search("/bound/{id}/{slug}", "get", "parameters", "0", "name").Data().(string))
+		require.Equal(t, "path", paths.Search("/bound/{id}/{slug}", "get", "parameters", "0", "in").Data().(string))
+		require.Equal(t, true, paths.Search("/bound/{id}/{slug}", "get", "parameters", "0", "required").Data().(bool))
+		require.Equal(t, "ID is the numeric identifier.", paths.Search("/bound/{id}/{slug}", "get", "parameters", "0", "description").Data().(string))
+		require.Equal(t, "integer", paths.Search("/bound/{id}/{slug}", "get", "parameters", "0", "schema", "type").Data().(string))
+		require.Equal(t, float64(1), paths.Search("/bound/{id}/{slug}", "get", "parameters", "0", "schema", "minimum").Data().(float64))
+		require.Equal(t, float64(1000), paths.Search("/bound/{id}/{slug}", "get", "parameters", "0", "schema", "maximum").Data().(float64))
+
+		require.Equal(t, "slug", paths.Search("/bound/{id}/{slug}", "get", "parameters", "1", "name").Data().(string))
+		require.Equal(t, "string", paths.Search("/bound/{id}/{slug}", "get", "parameters", "1", "schema", "type").Data().(string))
+		require.Equal(t, []any{"cat", "dog"}, paths.Search("/bound/{id}/{slug}", "get", "parameters", "1", "schema", "enum").Data().([]any))
+	})
+}
@@ -0,0 +1,13 @@
+package petstore
+
+import "github.com/gin-gonic/gin"
+
+func setupRouter() *gin.Engine {
+	r := gin.Default()
+
+	r.GET("/pets/:id", getPetByID)
+	r.GET("/owners/:ownerID/pets/:name", getOwnerPet)
+	r.GET("/bound/:id/:slug", getBound)
+
+	return r
+}
@@ -0,0 +1,90 @@
+package petstore
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ls6-events/astra/tests/integration/17-response-descriptions/store"
+)
+
+var (
+	// ErrNotFound is returned when a pet doesn't exist.
+	ErrNotFound = errors.New("pet not found")
+	// ErrForbidden is returned when a pet belongs to another owner.
+	ErrForbidden = errors.New("pet belongs to another owner")
+)
+
+// Pet is a pet in the store.
+type Pet struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ErrorResponse is the body of an error response.
+type ErrorResponse struct {
+	Message string `json:"message"`
+}
+
+func findPet(id string) (Pet, error) {
+	if id == "" {
+		return Pet{}, ErrNotFound
+	}
+
+	return Pet{ID: id}, nil
+}
+
+func getPet(c *gin.Context) {
+	pet, err := findPet(c.Param("id"))
+	if errors.Is(err, ErrNotFound) {
+		c.JSON(http.StatusNotFound, ErrorResponse{Message: err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, pet)
+}
+
+func updatePet(c *gin.Context) {
+	var pet Pet
+	if err := c.ShouldBindJSON(&pet); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	_, err := findPet(c.Param("id"))
+	switch err {
+	case ErrNotFound:
+		notFound(c)
+	case ErrForbidden:
+		c.JSON(http.StatusForbidden, ErrorResponse{Message: err.Error()})
+	default:
+		c.JSON(http.StatusOK, pet)
+	}
+}
+
+func deletePet(c *gin.Context) {
+	_, err := findPet(c.Param("id"))
+	if err == nil {
+		c.Status(http.StatusNoContent)
+	} else {
+		notFound(c)
+	}
+}
+
+func sellPet(c *gin.Context) {
+	err := store.Sell(c.Param("id"))
+	if errors.Is(err, store.ErrSold) {
+		c.JSON(http.StatusConflict, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func notFound(c *gin.Context) {
+	c.JSON(http.StatusNotFound, ErrorResponse{Message: "not found"})
+}
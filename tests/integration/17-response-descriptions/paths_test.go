@@ -0,0 +1,44 @@
+package petstore
+
+import (
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestResponseDescriptions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	testAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r)
+	require.NoError(t, err)
+
+	require.NotNil(t, testAstra)
+
+	paths := testAstra.Search("paths")
+
+	t.Run("If Conditions", func(t *testing.T) {
+		require.Equal(t, "pet not found", paths.Search("/pets/{id}", "get", "responses", "404", "description").Data().(string))
+		require.Equal(t, "err != nil", paths.Search("/pets/{id}", "get", "responses", "500", "description").Data().(string))
+		require.Equal(t, "OK", paths.Search("/pets/{id}", "get", "responses", "200", "description").Data().(string))
+	})
+
+	t.Run("Errors From Other Packages", func(t *testing.T) {
+		require.Equal(t, "pet is already sold", paths.Search("/pets/{id}/sell", "post", "responses", "409", "description").Data().(string))
+	})
+
+	t.Run("Switch Cases", func(t *testing.T) {
+		require.Equal(t, "err != nil", paths.Search("/pets/{id}", "put", "responses", "400", "description").Data().(string))
+		require.Equal(t, "pet not found", paths.Search("/pets/{id}", "put", "responses", "404", "description").Data().(string))
+		require.Equal(t, "pet belongs to another owner", paths.Search("/pets/{id}", "put", "responses", "403", "description").Data().(string))
+		require.Equal(t, "OK", paths.Search("/pets/{id}", "put", "responses", "200", "description").Data().(string))
+	})
+
+	t.Run("Else Branches", func(t *testing.T) {
+		require.Equal(t, "err == nil", paths.Search("/pets/{id}", "delete", "responses", "204", "description").Data().(string))
+		require.Equal(t, "err != nil", paths.Search("/pets/{id}", "delete", "responses", "404", "description").Data().(string))
+	})
+}
@@ -0,0 +1,14 @@
+package petstore
+
+import "github.com/gin-gonic/gin"
+
+func setupRouter() *gin.Engine {
+	r := gin.Default()
+
+	r.GET("/pets/:id", getPet)
+	r.PUT("/pets/:id", updatePet)
+	r.DELETE("/pets/:id", deletePet)
+	r.POST("/pets/:id/sell", sellPet)
+
+	return r
+}
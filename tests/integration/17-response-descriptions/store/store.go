@@ -0,0 +1,15 @@
+package store
+
+import stderrors "errors"
+
+// ErrSold is returned when a pet is already sold.
+var ErrSold = stderrors.New("pet is already sold")
+
+// Sell sells a pet.
+func Sell(id string) error {
+	if id == "sold" {
+		return ErrSold
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package petstore
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Pet is a pet in the store.
+type Pet struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// Owner is the owner of a pet.
+type Owner struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func getPets(c *gin.Context) {
+	OK(c, []Pet{})
+}
+
+func getPet(c *gin.Context) {
+	OK(c, Pet{})
+}
+
+func createPet(c *gin.Context) {
+	var pet Pet
+	Respond(c, http.StatusCreated, pet)
+}
+
+func getOwner(c *gin.Context) {
+	OK[Owner](c, Owner{})
+}
@@ -0,0 +1,17 @@
+package petstore
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Respond writes the data as the JSON response with the status code.
+func Respond[T any](c *gin.Context, status int, data T) {
+	c.JSON(status, data)
+}
+
+// OK writes the value as a successful JSON response.
+func OK[T any](c *gin.Context, v T) {
+	Respond(c, http.StatusOK, v)
+}
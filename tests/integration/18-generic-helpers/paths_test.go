@@ -0,0 +1,39 @@
+package petstore
+
+import (
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGenericHelpers(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	testAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r)
+	require.NoError(t, err)
+
+	require.NotNil(t, testAstra)
+
+	paths := testAstra.Search("paths")
+
+	t.Run("Direct Helper", func(t *testing.T) {
+		require.Equal(t, "#/components/schemas/Pet", paths.Search("/pets", "post", "responses", "201", "content", "application/json", "schema", "$ref").Data().(string))
+	})
+
+	t.Run("Nested Helper", func(t *testing.T) {
+		require.Equal(t, "#/components/schemas/Pet", paths.Search("/pets/{id}", "get", "responses", "200", "content", "application/json", "schema", "$ref").Data().(string))
+	})
+
+	t.Run("Slice Type Argument", func(t *testing.T) {
+		require.Equal(t, "array", paths.Search("/pets", "get", "responses", "200", "content", "application/json", "schema", "type").Data().(string))
+		require.Equal(t, "#/components/schemas/Pet", paths.Search("/pets", "get", "responses", "200", "content", "application/json", "schema", "items", "$ref").Data().(string))
+	})
+
+	t.Run("Explicit Instantiation", func(t *testing.T) {
+		require.Equal(t, "#/components/schemas/Owner", paths.Search("/owners/{id}", "get", "responses", "200", "content", "application/json", "schema", "$ref").Data().(string))
+	})
+}
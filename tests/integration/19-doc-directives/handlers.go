@@ -0,0 +1,58 @@
+package petstore
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Pet is a pet in the store.
+type Pet struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ErrorResponse is the body of every error response.
+type ErrorResponse struct {
+	Message string `json:"message"`
+}
+
+// getPets lists the pets in the store.
+// @summary List pets
+// @tags pets, store
+// @param tags query []string false "the tags to filter the pets by"
+// @param X-Request-ID header string false "the ID to trace the request by"
+// @response 500 ErrorResponse "the store is unavailable"
+func getPets(c *gin.Context) {
+	tags := strings.Split(c.Query("tags"), ",")
+	_ = tags
+
+	c.JSON(http.StatusOK, []Pet{})
+}
+
+// getPet gets a pet by its ID.
+// @summary Get a pet
+// @tags pets
+// @param id path int true "the ID of the pet"
+// @response 404 ErrorResponse "the pet was not found"
+// @security bearerAuth
+// @security oauth2 read:pets
+// @operationId findPetByID
+// @sumary A misspelled directive is logged and ignored
+func getPet(c *gin.Context) {
+	c.JSON(http.StatusOK, Pet{})
+}
+
+// deletePet deletes a pet by its ID.
+// @deprecated
+// @response 204 "the pet was deleted"
+func deletePet(c *gin.Context) {
+	c.JSON(http.StatusNoContent, Pet{})
+}
+
+// getInternalPets lists the pets for the internal tools.
+// @hidden
+func getInternalPets(c *gin.Context) {
+	c.JSON(http.StatusOK, []Pet{})
+}
@@ -0,0 +1,85 @@
+package petstore
+
+import (
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDocDirectives(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	testAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r)
+	require.NoError(t, err)
+
+	require.NotNil(t, testAstra)
+
+	paths := testAstra.Search("paths")
+
+	t.Run("Summary And Description", func(t *testing.T) {
+		getPet := paths.Search("/pets/{id}", "get")
+		require.Equal(t, "Get a pet", getPet.Search("summary").Data().(string))
+		require.Equal(t, "getPet gets a pet by its ID.", getPet.Search("description").Data().(string))
+	})
+
+	t.Run("Tags", func(t *testing.T) {
+		require.Equal(t, []any{"pets", "store"}, paths.Search("/pets", "get", "tags").Data())
+		require.Equal(t, []any{"pets"}, paths.Search("/pets/{id}", "get", "tags").Data())
+	})
+
+	t.Run("Params", func(t *testing.T) {
+		getPetParameters := paths.Search("/pets/{id}", "get", "parameters").Children()
+		require.Len(t, getPetParameters, 1)
+		require.Equal(t, "id", getPetParameters[0].Search("name").Data().(string))
+		require.Equal(t, "the ID of the pet", getPetParameters[0].Search("description").Data().(string))
+		require.Equal(t, "integer", getPetParameters[0].Search("schema", "type").Data().(string))
+		require.True(t, getPetParameters[0].Search("required").Data().(bool))
+
+		getPetsParameters := paths.Search("/pets", "get", "parameters").Children()
+		require.Len(t, getPetsParameters, 2)
+
+		require.Equal(t, "X-Request-ID", getPetsParameters[0].Search("name").Data().(string))
+		require.Equal(t, "header", getPetsParameters[0].Search("in").Data().(string))
+		require.Equal(t, "the ID to trace the request by", getPetsParameters[0].Search("description").Data().(string))
+
+		require.Equal(t, "tags", getPetsParameters[1].Search("name").Data().(string))
+		require.Equal(t, "query", getPetsParameters[1].Search("in").Data().(string))
+		require.Equal(t, "array", getPetsParameters[1].Search("schema", "type").Data().(string))
+		require.Equal(t, "string", getPetsParameters[1].Search("schema", "items", "type").Data().(string))
+	})
+
+	t.Run("Responses", func(t *testing.T) {
+		notFound := paths.Search("/pets/{id}", "get", "responses", "404")
+		require.Equal(t, "the pet was not found", notFound.Search("description").Data().(string))
+		require.Equal(t, "#/components/schemas/ErrorResponse", notFound.Search("content", "application/json", "schema", "$ref").Data().(string))
+
+		require.Equal(t, "the store is unavailable", paths.Search("/pets", "get", "responses", "500", "description").Data().(string))
+		require.True(t, paths.Exists("/pets", "get", "responses", "200"))
+	})
+
+	t.Run("Responses Replace Those Found", func(t *testing.T) {
+		deletePet := paths.Search("/pets/{id}", "delete")
+		require.True(t, deletePet.Search("deprecated").Data().(bool))
+		require.Equal(t, "the pet was deleted", deletePet.Search("responses", "204", "description").Data().(string))
+		require.False(t, deletePet.Exists("responses", "204", "content", "application/json"))
+	})
+
+	t.Run("Security", func(t *testing.T) {
+		require.Equal(t, []any{
+			map[string]any{"bearerAuth": []any{}},
+			map[string]any{"oauth2": []any{"read:pets"}},
+		}, paths.Search("/pets/{id}", "get", "security").Data())
+	})
+
+	t.Run("Operation ID", func(t *testing.T) {
+		require.Equal(t, "findPetByID", paths.Search("/pets/{id}", "get", "operationId").Data().(string))
+	})
+
+	t.Run("Hidden", func(t *testing.T) {
+		require.False(t, paths.Exists("/internal/pets"))
+	})
+}
@@ -0,0 +1,13 @@
+package petstore
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// getHealth checks the store is running.
+// @tags internal
+func getHealth(c *gin.Context) {
+	c.String(http.StatusOK, "OK")
+}
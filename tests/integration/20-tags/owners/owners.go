@@ -0,0 +1,24 @@
+// Package owners manages the owners of the pets.
+package owners
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Owner is the owner of pets in the store.
+type Owner struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// GetOwners lists the owners of the pets.
+func GetOwners(c *gin.Context) {
+	c.JSON(http.StatusOK, []Owner{})
+}
+
+// GetOwner gets an owner by their ID.
+func GetOwner(c *gin.Context) {
+	c.JSON(http.StatusOK, Owner{})
+}
@@ -0,0 +1,70 @@
+package petstore
+
+import (
+	"github.com/Jeffail/gabs/v2"
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestTags(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	setupTestAstra := func(t *testing.T, strategy astra.TagStrategy) *gabs.Container {
+		t.Helper()
+
+		config := &astra.Config{
+			Host:     "localhost",
+			Port:     8000,
+			BasePath: "/api",
+		}
+
+		testAstra, err := helpers.SetupTestAstra(t, r, config, astra.WithTagStrategy(strategy))
+		require.NoError(t, err)
+		require.NotNil(t, testAstra)
+
+		return testAstra
+	}
+
+	t.Run("Router Group", func(t *testing.T) {
+		testAstra := setupTestAstra(t, astra.TagStrategyRouterGroup)
+		paths := testAstra.Search("paths")
+
+		require.Equal(t, []any{"pets"}, paths.Search("/api/pets", "get", "tags").Data())
+		require.Equal(t, []any{"pets"}, paths.Search("/api/pets/{id}", "get", "tags").Data())
+		require.Equal(t, []any{"owners"}, paths.Search("/api/owners", "get", "tags").Data())
+		require.Equal(t, []any{"owners"}, paths.Search("/api/owners/{id}", "get", "tags").Data())
+		require.Equal(t, []any{"internal"}, paths.Search("/health", "get", "tags").Data())
+
+		tags := testAstra.Search("tags").Children()
+		require.Len(t, tags, 3)
+		require.Equal(t, "pets", tags[0].Search("name").Data().(string))
+		require.Equal(t, "Package pets manages the pets in the store.", tags[0].Search("description").Data().(string))
+		require.Equal(t, "owners", tags[1].Search("name").Data().(string))
+		require.Equal(t, "Package owners manages the owners of the pets.", tags[1].Search("description").Data().(string))
+		require.Equal(t, "internal", tags[2].Search("name").Data().(string))
+	})
+
+	t.Run("Package", func(t *testing.T) {
+		testAstra := setupTestAstra(t, astra.TagStrategyPackage)
+		paths := testAstra.Search("paths")
+
+		require.Equal(t, []any{"pets"}, paths.Search("/api/pets", "get", "tags").Data())
+		require.Equal(t, []any{"owners"}, paths.Search("/api/owners/{id}", "get", "tags").Data())
+		require.Equal(t, []any{"internal"}, paths.Search("/health", "get", "tags").Data())
+	})
+
+	t.Run("Path Segment", func(t *testing.T) {
+		testAstra := setupTestAstra(t, astra.TagStrategyPathSegment)
+		paths := testAstra.Search("paths")
+
+		require.Equal(t, []any{"pets"}, paths.Search("/api/pets/{id}", "get", "tags").Data())
+		require.Equal(t, []any{"owners"}, paths.Search("/api/owners", "get", "tags").Data())
+		require.Equal(t, []any{"internal"}, paths.Search("/health", "get", "tags").Data())
+	})
+}
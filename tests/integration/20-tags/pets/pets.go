@@ -0,0 +1,30 @@
+// Package pets manages the pets in the store.
+package pets
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Pet is a pet in the store.
+type Pet struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// RegisterRoutes registers the routes of the pets in their router group.
+func RegisterRoutes(r *gin.RouterGroup) {
+	r.GET("", GetPets)
+	r.GET("/:id", GetPet)
+}
+
+// GetPets lists the pets in the store.
+func GetPets(c *gin.Context) {
+	c.JSON(http.StatusOK, []Pet{})
+}
+
+// GetPet gets a pet by its ID.
+func GetPet(c *gin.Context) {
+	c.JSON(http.StatusOK, Pet{})
+}
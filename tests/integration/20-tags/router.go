@@ -0,0 +1,22 @@
+package petstore
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/ls6-events/astra/tests/integration/20-tags/owners"
+	"github.com/ls6-events/astra/tests/integration/20-tags/pets"
+)
+
+func setupRouter() *gin.Engine {
+	r := gin.Default()
+
+	api := r.Group("/api")
+	pets.RegisterRoutes(api.Group("/pets"))
+
+	ownersGroup := api.Group("/owners")
+	ownersGroup.GET("", owners.GetOwners)
+	ownersGroup.GET("/:id", owners.GetOwner)
+
+	r.GET("/health", getHealth)
+
+	return r
+}
@@ -0,0 +1,43 @@
+package petstore
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Pet is a pet in the store.
+type Pet struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// getPets lists the pets in the store.
+// The pets are sorted by their name.
+func getPets(c *gin.Context) {
+	c.JSON(http.StatusOK, []Pet{})
+}
+
+// getPet gets a pet by its ID
+//
+// The pet is only found if it is in the store.
+func getPet(c *gin.Context) {
+	c.JSON(http.StatusOK, Pet{})
+}
+
+func CreatePet(c *gin.Context) {
+	var pet Pet
+	if err := c.ShouldBindJSON(&pet); err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	c.JSON(http.StatusCreated, pet)
+}
+
+// deletePet deletes a pet from the store.
+// It can't be restored.
+// @summary Delete a pet
+func deletePet(c *gin.Context) {
+	c.Status(http.StatusNoContent)
+}
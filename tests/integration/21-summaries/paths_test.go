@@ -0,0 +1,55 @@
+package petstore
+
+import (
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSummaries(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	t.Run("Keeps Doc", func(t *testing.T) {
+		testAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r)
+		require.NoError(t, err)
+		require.NotNil(t, testAstra)
+
+		paths := testAstra.Search("paths")
+
+		getPets := paths.Search("/pets", "get")
+		require.Equal(t, "getPets lists the pets in the store", getPets.Search("summary").Data().(string))
+		require.Equal(t, "getPets lists the pets in the store.\nThe pets are sorted by their name.", getPets.Search("description").Data().(string))
+
+		require.Equal(t, "getPet gets a pet by its ID", paths.Search("/pets/{id}", "get", "summary").Data().(string))
+
+		createPet := paths.Search("/pets", "post")
+		require.Equal(t, "Create pet", createPet.Search("summary").Data().(string))
+		require.False(t, createPet.Exists("description"))
+
+		require.Equal(t, "Delete a pet", paths.Search("/pets/{id}", "delete", "summary").Data().(string))
+	})
+
+	t.Run("Strips Doc Summary", func(t *testing.T) {
+		testAstra, err := helpers.SetupTestAstraWithDefaultConfig(t, r, astra.WithDocSummaryStripped())
+		require.NoError(t, err)
+		require.NotNil(t, testAstra)
+
+		paths := testAstra.Search("paths")
+
+		getPets := paths.Search("/pets", "get")
+		require.Equal(t, "getPets lists the pets in the store", getPets.Search("summary").Data().(string))
+		require.Equal(t, "The pets are sorted by their name.", getPets.Search("description").Data().(string))
+
+		require.Equal(t, "The pet is only found if it is in the store.", paths.Search("/pets/{id}", "get", "description").Data().(string))
+
+		// The summary from the @summary directive isn't in the doc, so the doc is kept
+		deletePet := paths.Search("/pets/{id}", "delete")
+		require.Equal(t, "Delete a pet", deletePet.Search("summary").Data().(string))
+		require.Equal(t, "deletePet deletes a pet from the store.\nIt can't be restored.", deletePet.Search("description").Data().(string))
+	})
+}
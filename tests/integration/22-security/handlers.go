@@ -0,0 +1,63 @@
+package petstore
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Pet is a pet in the store.
+type Pet struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// requireJWT creates the middleware that requires a bearer JWT.
+func requireJWT() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !strings.HasPrefix(c.GetHeader("Authorization"), "Bearer ") {
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// requireAdmin is the middleware that requires the admin scope.
+func requireAdmin(c *gin.Context) {
+	c.Next()
+}
+
+// requireAPIKey is the middleware that requires an API key.
+func requireAPIKey(c *gin.Context) {
+	if c.GetHeader("X-API-Key") == "" {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+
+	c.Next()
+}
+
+func getPets(c *gin.Context) {
+	c.JSON(http.StatusOK, []Pet{})
+}
+
+func createPet(c *gin.Context) {
+	var pet Pet
+	if err := c.ShouldBindJSON(&pet); err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	c.JSON(http.StatusCreated, pet)
+}
+
+func deletePet(c *gin.Context) {
+	c.Status(http.StatusNoContent)
+}
+
+func getStats(c *gin.Context) {
+	c.JSON(http.StatusOK, map[string]int{})
+}
@@ -0,0 +1,66 @@
+package petstore
+
+import (
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSecurity(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	config, err := astra.NewConfigBuilder().
+		SetHost("localhost").
+		SetPort(8000).
+		AddSecurityScheme("bearerAuth", astra.NewBearerSecurityScheme("JWT")).
+		AddSecurityScheme("apiKey", astra.NewAPIKeySecurityScheme(astra.APIKeyInHeader, "X-API-Key")).
+		AddSecurityScheme("oauth2", astra.NewOAuth2SecurityScheme(astra.OAuthFlows{
+			ClientCredentials: &astra.OAuthFlow{
+				TokenURL: "https://example.com/token",
+				Scopes: map[string]string{
+					"admin": "manage the store",
+				},
+			},
+		})).
+		Build()
+	require.NoError(t, err)
+
+	testAstra, err := helpers.SetupTestAstra(t, r, config,
+		astra.WithSecurityMiddleware(requireJWT, astra.SecurityRequirement{"bearerAuth": {}}),
+		astra.WithSecurityMiddleware(requireAdmin, astra.SecurityRequirement{"oauth2": {"admin"}}),
+		astra.WithSecurityMiddleware(requireAPIKey, astra.SecurityRequirement{"apiKey": {}}),
+	)
+	require.NoError(t, err)
+
+	require.NotNil(t, testAstra)
+
+	t.Run("Security Schemes", func(t *testing.T) {
+		securitySchemes := testAstra.Search("components", "securitySchemes")
+
+		require.Equal(t, "http", securitySchemes.Search("bearerAuth", "type").Data().(string))
+		require.Equal(t, "bearer", securitySchemes.Search("bearerAuth", "scheme").Data().(string))
+		require.Equal(t, "JWT", securitySchemes.Search("bearerAuth", "bearerFormat").Data().(string))
+
+		require.Equal(t, "apiKey", securitySchemes.Search("apiKey", "type").Data().(string))
+		require.Equal(t, "header", securitySchemes.Search("apiKey", "in").Data().(string))
+		require.Equal(t, "X-API-Key", securitySchemes.Search("apiKey", "name").Data().(string))
+
+		require.Equal(t, "oauth2", securitySchemes.Search("oauth2", "type").Data().(string))
+		require.Equal(t, "https://example.com/token", securitySchemes.Search("oauth2", "flows", "clientCredentials", "tokenUrl").Data().(string))
+		require.Equal(t, "manage the store", securitySchemes.Search("oauth2", "flows", "clientCredentials", "scopes", "admin").Data().(string))
+	})
+
+	t.Run("Route Security", func(t *testing.T) {
+		paths := testAstra.Search("paths")
+
+		require.False(t, paths.Exists("/pets", "get", "security"))
+		require.Equal(t, []any{map[string]any{"bearerAuth": []any{}}}, paths.Search("/pets", "post", "security").Data())
+		require.Equal(t, []any{map[string]any{"bearerAuth": []any{}, "oauth2": []any{"admin"}}}, paths.Search("/pets/{id}", "delete", "security").Data())
+		require.Equal(t, []any{map[string]any{"apiKey": []any{}}}, paths.Search("/stats", "get", "security").Data())
+	})
+}
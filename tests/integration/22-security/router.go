@@ -0,0 +1,17 @@
+package petstore
+
+import "github.com/gin-gonic/gin"
+
+func setupRouter() *gin.Engine {
+	r := gin.Default()
+
+	r.GET("/pets", getPets)
+
+	authorized := r.Group("/", requireJWT())
+	authorized.POST("/pets", createPet)
+	authorized.DELETE("/pets/:id", requireAdmin, deletePet)
+
+	r.GET("/stats", requireAPIKey, getStats)
+
+	return r
+}
@@ -0,0 +1,63 @@
+package petstore
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Owner is the owner of a pet.
+type Owner struct {
+	Name string `json:"name" example:"Alice"`
+}
+
+// Pet is a pet in the store.
+type Pet struct {
+	ID     int     `json:"id" example:"1"`
+	Name   string  `json:"name" example:"Rex"`
+	Tag    *string `json:"tag"`
+	Weight float64 `json:"weight" example:"12.5"`
+	Owner  *Owner  `json:"owner"`
+}
+
+// PetFilter filters the pets in the store.
+type PetFilter struct {
+	Limit int `form:"limit" example:"10"`
+}
+
+// PetPath is the path of a pet.
+type PetPath struct {
+	ID int `uri:"id" binding:"required,gt=0,lt=1000"`
+}
+
+func getPets(c *gin.Context) {
+	var filter PetFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	c.JSON(http.StatusOK, []Pet{})
+}
+
+func getPet(c *gin.Context) {
+	var path PetPath
+	if err := c.ShouldBindUri(&path); err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	c.JSON(http.StatusOK, Pet{})
+}
+
+// newPetHook is the request sent when a pet is added to the store.
+// @webhook newPet
+func newPetHook(c *gin.Context) {
+	var pet Pet
+	if err := c.ShouldBindJSON(&pet); err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
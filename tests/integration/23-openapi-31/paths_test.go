@@ -0,0 +1,76 @@
+package petstore
+
+import (
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/outputs/openapi"
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestOpenAPI31(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	config := &astra.Config{
+		Host: "localhost",
+		Port: 8000,
+	}
+
+	t.Run("OpenAPI 3.1", func(t *testing.T) {
+		testAstra, err := helpers.SetupTestAstraWithOpenAPIVersion(t, r, config, openapi.Version31)
+		require.NoError(t, err)
+		require.NotNil(t, testAstra)
+
+		require.Equal(t, "3.1.0", testAstra.Search("openapi").Data().(string))
+		require.Equal(t, "https://spec.openapis.org/oas/3.1/dialect/base", testAstra.Search("jsonSchemaDialect").Data().(string))
+
+		pet := testAstra.Search("components", "schemas", "Pet", "properties")
+		require.Equal(t, []any{"string", "null"}, pet.Search("tag", "type").Data())
+		require.Equal(t, "#/components/schemas/Owner", pet.Search("owner", "anyOf", "0", "$ref").Data().(string))
+		require.Equal(t, "null", pet.Search("owner", "anyOf", "1", "type").Data().(string))
+		require.Equal(t, []any{float64(1)}, pet.Search("id", "examples").Data())
+		require.Equal(t, []any{"Rex"}, pet.Search("name", "examples").Data())
+		require.Equal(t, []any{12.5}, pet.Search("weight", "examples").Data())
+		require.False(t, pet.Exists("name", "example"))
+
+		require.Equal(t, []any{float64(10)}, testAstra.Search("paths", "/pets", "get", "parameters", "0", "schema", "examples").Data())
+
+		id := testAstra.Search("paths", "/pets/{id}", "get", "parameters", "0", "schema")
+		require.Equal(t, float64(1000), id.Search("exclusiveMaximum").Data())
+		require.False(t, id.Exists("maximum"))
+
+		require.False(t, testAstra.Exists("paths", "/hooks/new-pet"))
+		newPet := testAstra.Search("webhooks", "newPet", "post")
+		require.Equal(t, "#/components/schemas/Pet", newPet.Search("requestBody", "content", "application/json", "schema", "$ref").Data().(string))
+	})
+
+	t.Run("OpenAPI 3.0", func(t *testing.T) {
+		testAstra, err := helpers.SetupTestAstraWithOpenAPIVersion(t, r, config, openapi.Version30)
+		require.NoError(t, err)
+		require.NotNil(t, testAstra)
+
+		require.Equal(t, "3.0.0", testAstra.Search("openapi").Data().(string))
+		require.False(t, testAstra.Exists("jsonSchemaDialect"))
+
+		pet := testAstra.Search("components", "schemas", "Pet", "properties")
+		require.Equal(t, "string", pet.Search("tag", "type").Data().(string))
+		require.False(t, pet.Exists("tag", "nullable"))
+		require.Equal(t, "#/components/schemas/Owner", pet.Search("owner", "$ref").Data().(string))
+		require.False(t, pet.Exists("owner", "nullable"))
+		require.Equal(t, "Rex", pet.Search("name", "example").Data().(string))
+
+		require.Equal(t, float64(10), testAstra.Search("paths", "/pets", "get", "parameters", "0", "schema", "example").Data())
+
+		id := testAstra.Search("paths", "/pets/{id}", "get", "parameters", "0", "schema")
+		require.True(t, id.Search("exclusiveMaximum").Data().(bool))
+		require.Equal(t, float64(1000), id.Search("maximum").Data())
+
+		// Webhooks aren't supported by OpenAPI 3.0, so they are paths
+		require.False(t, testAstra.Exists("webhooks"))
+		require.True(t, testAstra.Exists("paths", "/hooks/new-pet", "post"))
+	})
+}
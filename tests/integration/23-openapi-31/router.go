@@ -0,0 +1,13 @@
+package petstore
+
+import "github.com/gin-gonic/gin"
+
+func setupRouter() *gin.Engine {
+	r := gin.Default()
+
+	r.GET("/pets", getPets)
+	r.GET("/pets/:id", getPet)
+	r.POST("/hooks/new-pet", newPetHook)
+
+	return r
+}
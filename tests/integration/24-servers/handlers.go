@@ -0,0 +1,17 @@
+package petstore
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Pet is a pet in the store.
+type Pet struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func getPets(c *gin.Context) {
+	c.JSON(http.StatusOK, []Pet{})
+}
@@ -0,0 +1,58 @@
+package petstore
+
+import (
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestServers(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	r := setupRouter()
+
+	config, err := astra.NewConfigBuilder().
+		AddServer(astra.Server{
+			URL:         "http://localhost:8000",
+			Description: "Local",
+		}).
+		AddServer(astra.Server{
+			URL:         "https://{environment}.example.com{basePath}",
+			Description: "Deployed",
+			Variables: map[string]astra.ServerVariable{
+				"environment": {
+					Enum:        []string{"staging", "api"},
+					Default:     "api",
+					Description: "The environment the API is deployed to",
+				},
+				"basePath": {
+					Default: "/v1",
+				},
+			},
+		}).
+		Build()
+	require.NoError(t, err)
+
+	testAstra, err := helpers.SetupTestAstra(t, r, config)
+	require.NoError(t, err)
+
+	require.NotNil(t, testAstra)
+
+	servers := testAstra.Search("servers").Children()
+	require.Len(t, servers, 2)
+
+	require.Equal(t, "http://localhost:8000", servers[0].Search("url").Data().(string))
+	require.Equal(t, "Local", servers[0].Search("description").Data().(string))
+	require.False(t, servers[0].Exists("variables"))
+
+	require.Equal(t, "https://{environment}.example.com{basePath}", servers[1].Search("url").Data().(string))
+	require.Equal(t, "Deployed", servers[1].Search("description").Data().(string))
+	require.Equal(t, []any{"staging", "api"}, servers[1].Search("variables", "environment", "enum").Data())
+	require.Equal(t, "api", servers[1].Search("variables", "environment", "default").Data().(string))
+	require.Equal(t, "The environment the API is deployed to", servers[1].Search("variables", "environment", "description").Data().(string))
+	require.Equal(t, "/v1", servers[1].Search("variables", "basePath", "default").Data().(string))
+	require.False(t, servers[1].Exists("variables", "basePath", "enum"))
+}
@@ -0,0 +1,50 @@
+package petstore
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Pet is a pet in the store.
+type Pet struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// Owner is an owner of pets.
+type Owner struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ErrorResponse is the response of every error.
+type ErrorResponse struct {
+	Message string `json:"message"`
+}
+
+func getPets(c *gin.Context) {
+	c.Header("X-Request-ID", "id")
+
+	if c.Query("limit") == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Message: "limit is required"})
+		return
+	}
+
+	c.JSON(http.StatusOK, []Pet{})
+}
+
+func getOwners(c *gin.Context) {
+	c.Header("X-Request-ID", "id")
+
+	if c.Query("limit") == "" {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Message: "limit is required"})
+		return
+	}
+
+	c.JSON(http.StatusOK, []Owner{})
+}
+
+func getStats(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{})
+}
@@ -0,0 +1,63 @@
+package petstore
+
+import (
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/outputs/openapi"
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestReusableComponents(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	config := &astra.Config{
+		Host: "localhost",
+		Port: 8000,
+	}
+
+	t.Run("Lifted Into Components", func(t *testing.T) {
+		r := setupRouter()
+
+		testAstra, err := helpers.SetupTestAstraWithOpenAPIOptions(t, r, config, []openapi.Option{openapi.WithComponentThreshold(2)})
+		require.NoError(t, err)
+
+		require.NotNil(t, testAstra)
+
+		components := testAstra.Search("components")
+		require.Equal(t, "limit", components.Search("parameters", "LimitQuery", "name").Data().(string))
+		require.Equal(t, "query", components.Search("parameters", "LimitQuery", "in").Data().(string))
+		require.Equal(t, "string", components.Search("headers", "X-Request-ID", "schema", "type").Data().(string))
+		require.Equal(t, "#/components/headers/X-Request-ID", components.Search("responses", "BadRequestErrorResponse", "headers", "X-Request-ID", "$ref").Data().(string))
+		require.Equal(t, "#/components/schemas/ErrorResponse", components.Search("responses", "BadRequestErrorResponse", "content", "application/json", "schema", "$ref").Data().(string))
+
+		for _, path := range []string{"/pets", "/owners"} {
+			operation := testAstra.Search("paths", path, "get")
+			require.Equal(t, map[string]any{"$ref": "#/components/parameters/LimitQuery"}, operation.Search("parameters", "0").Data())
+			require.Equal(t, "#/components/responses/BadRequestErrorResponse", operation.Search("responses", "400", "$ref").Data().(string))
+			require.False(t, operation.Exists("responses", "400", "description"))
+
+			// The successful responses are different, but their headers are the same
+			require.Equal(t, map[string]any{"$ref": "#/components/headers/X-Request-ID"}, operation.Search("responses", "200", "headers", "X-Request-ID").Data())
+			require.True(t, operation.Exists("responses", "200", "content"))
+		}
+
+		require.False(t, testAstra.Exists("paths", "/stats", "get", "responses", "200", "$ref"))
+	})
+
+	t.Run("Inline By Default", func(t *testing.T) {
+		r := setupRouter()
+
+		testAstra, err := helpers.SetupTestAstra(t, r, config)
+		require.NoError(t, err)
+
+		require.NotNil(t, testAstra)
+
+		require.False(t, testAstra.Exists("components", "parameters"))
+		require.False(t, testAstra.Exists("components", "responses"))
+		require.False(t, testAstra.Exists("components", "headers"))
+		require.Equal(t, "limit", testAstra.Search("paths", "/pets", "get", "parameters", "0", "name").Data().(string))
+	})
+}
@@ -0,0 +1,13 @@
+package petstore
+
+import "github.com/gin-gonic/gin"
+
+func setupRouter() *gin.Engine {
+	r := gin.Default()
+
+	r.GET("/pets", getPets)
+	r.GET("/owners", getOwners)
+	r.GET("/stats", getStats)
+
+	return r
+}
@@ -0,0 +1,35 @@
+package petstore
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Pet is a pet in the store.
+type Pet struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Owner Owner  `json:"owner"`
+}
+
+// Owner is the owner of a pet.
+type Owner struct {
+	Name string `json:"name"`
+}
+
+// getPets lists the pets.
+// @tags pets
+func getPets(c *gin.Context) {
+	c.JSON(http.StatusOK, []Pet{})
+}
+
+// getPet gets a pet by its ID.
+// @tags pets
+func getPet(c *gin.Context) {
+	c.JSON(http.StatusOK, Pet{})
+}
+
+func getHealth(c *gin.Context) {
+	c.String(http.StatusOK, "OK")
+}
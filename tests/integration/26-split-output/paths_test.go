@@ -0,0 +1,86 @@
+package petstore
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/Jeffail/gabs/v2"
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/inputs"
+	"github.com/ls6-events/astra/outputs"
+	"github.com/ls6-events/astra/outputs/openapi"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func setupSplitTestAstra(t *testing.T, options ...openapi.Option) {
+	t.Helper()
+
+	gen := astra.New(inputs.WithGinInput(setupRouter()), outputs.WithOpenAPISplitOutput("./output", options...))
+
+	gen.SetConfig(&astra.Config{
+		Host: "localhost",
+		Port: 8000,
+	})
+
+	err := gen.Parse()
+	require.NoError(t, err)
+}
+
+func readSplitFile(t *testing.T, filePath string) *gabs.Container {
+	t.Helper()
+
+	fileContents, err := os.ReadFile(path.Join("./output", filePath))
+	require.NoError(t, err)
+
+	var contents map[string]any
+	err = yaml.Unmarshal(fileContents, &contents)
+	require.NoError(t, err)
+
+	return gabs.Wrap(contents)
+}
+
+func TestSplitOutput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	t.Run("Split By Path", func(t *testing.T) {
+		setupSplitTestAstra(t)
+
+		root := readSplitFile(t, "openapi.yaml")
+		require.Equal(t, "paths/pets.yaml", root.Search("paths", "/pets", "$ref").Data().(string))
+		require.Equal(t, "paths/pets_id.yaml", root.Search("paths", "/pets/{id}", "$ref").Data().(string))
+		require.Equal(t, "paths/health.yaml", root.Search("paths", "/health", "$ref").Data().(string))
+		require.Equal(t, "schemas/Pet.yaml", root.Search("components", "schemas", "Pet", "$ref").Data().(string))
+		require.Equal(t, "schemas/Owner.yaml", root.Search("components", "schemas", "Owner", "$ref").Data().(string))
+
+		pets := readSplitFile(t, "paths/pets.yaml")
+		require.Equal(t, "../schemas/Pet.yaml", pets.Search("get", "responses", "200", "content", "application/json", "schema", "items", "$ref").Data().(string))
+
+		pet := readSplitFile(t, "paths/pets_id.yaml")
+		require.Equal(t, "id", pet.Search("get", "parameters", "0", "name").Data().(string))
+
+		petSchema := readSplitFile(t, "schemas/Pet.yaml")
+		require.Equal(t, "object", petSchema.Search("type").Data().(string))
+		require.Equal(t, "Owner.yaml", petSchema.Search("properties", "owner", "$ref").Data().(string))
+	})
+
+	t.Run("Split By Tag", func(t *testing.T) {
+		setupSplitTestAstra(t, openapi.WithSplitStrategy(openapi.SplitByTag))
+
+		root := readSplitFile(t, "openapi.yaml")
+		require.Equal(t, "paths/pets.yaml#/~1pets", root.Search("paths", "/pets", "$ref").Data().(string))
+		require.Equal(t, "paths/pets.yaml#/~1pets~1%7Bid%7D", root.Search("paths", "/pets/{id}", "$ref").Data().(string))
+		require.Equal(t, "paths/default.yaml#/~1health", root.Search("paths", "/health", "$ref").Data().(string))
+
+		pets := readSplitFile(t, "paths/pets.yaml")
+		require.True(t, pets.Exists("/pets", "get"))
+		require.True(t, pets.Exists("/pets/{id}", "get"))
+
+		// The directory is replaced, so the files split by path are gone
+		_, err := os.Stat("./output/paths/pets_id.yaml")
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
@@ -0,0 +1,82 @@
+package petstore
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Status is the status of a pet in the store.
+type Status string
+
+const (
+	StatusAvailable Status = "available"
+	StatusPending   Status = "pending"
+	StatusSold      Status = "sold"
+)
+
+// Pet is a pet in the store.
+type Pet struct {
+	ID     int      `json:"id" xml:"id" yaml:"id"`
+	Name   string   `json:"name" xml:"name" yaml:"name" binding:"required"`
+	Tags   []string `json:"tags" xml:"tags" yaml:"tags"`
+	Status Status   `json:"status" xml:"status" yaml:"status"`
+}
+
+// PetFilter filters the pets in the store.
+type PetFilter struct {
+	Status Status `form:"status"`
+	Limit  int    `form:"limit"`
+	Offset int    `form:"offset"`
+}
+
+// ErrorResponse is the response of every error.
+type ErrorResponse struct {
+	Message string `json:"message"`
+}
+
+// getPets lists the pets.
+func getPets(c *gin.Context) {
+	var filter PetFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.Header("X-Total-Count", "0")
+	c.Header("X-Request-ID", "id")
+	c.JSON(http.StatusOK, []Pet{})
+}
+
+// getPet gets a pet by its ID, in the format of the Accept header.
+func getPet(c *gin.Context) {
+	_ = c.Param("id")
+	_ = c.Query("id")
+
+	switch c.GetHeader("Accept") {
+	case "application/xml":
+		c.XML(http.StatusOK, Pet{})
+	case "application/yaml":
+		c.YAML(http.StatusOK, Pet{})
+	default:
+		c.JSON(http.StatusOK, Pet{})
+	}
+}
+
+// createPet creates a pet.
+func createPet(c *gin.Context) {
+	var pet Pet
+	if err := c.ShouldBindJSON(&pet); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, pet)
+}
+
+// getOwner gets an owner by their ID.
+func getOwner(c *gin.Context) {
+	_ = c.Param("id")
+
+	c.JSON(http.StatusNotFound, ErrorResponse{Message: "not found"})
+}
@@ -0,0 +1,51 @@
+package petstore
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/inputs"
+	"github.com/ls6-events/astra/outputs"
+	"github.com/stretchr/testify/require"
+)
+
+// generate generates the OpenAPI specification to the file path, and returns its contents.
+func generate(t *testing.T, filePath string) []byte {
+	t.Helper()
+
+	gen := astra.New(inputs.WithGinInput(setupRouter()), outputs.WithOpenAPIOutput(filePath), astra.WithTagStrategy(astra.TagStrategyPathSegment))
+
+	gen.SetConfig(&astra.Config{
+		Host: "localhost",
+		Port: 8000,
+	})
+
+	err := gen.Parse()
+	require.NoError(t, err)
+
+	fileContents, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+
+	return fileContents
+}
+
+func TestDeterministicOutput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	workDir, err := os.Getwd()
+	require.NoError(t, err)
+
+	for _, filePath := range []string{"./output.json", "./output.yaml"} {
+		t.Run(filePath, func(t *testing.T) {
+			first := generate(t, filePath)
+			for i := 0; i < 3; i++ {
+				require.Equal(t, string(first), string(generate(t, filePath)))
+			}
+
+			require.NotContains(t, string(first), workDir)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package petstore
+
+import "github.com/gin-gonic/gin"
+
+func setupRouter() *gin.Engine {
+	r := gin.Default()
+
+	pets := r.Group("/pets")
+	pets.GET("", getPets)
+	pets.GET("/:id", getPet)
+	pets.POST("", createPet)
+
+	r.GET("/owners/:id", getOwner)
+
+	return r
+}
@@ -0,0 +1,48 @@
+package petstore
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Owner is the owner of a pet.
+type Owner struct {
+	Name string `json:"name"`
+}
+
+// Pet is a pet in the store.
+type Pet struct {
+	ID       string `json:"id" x-go-type:"uuid.UUID" x-oapi-codegen-extra-tags:"{\"db\":\"id\"}"`
+	Name     string `json:"name"`
+	Internal bool   `json:"internal" x-internal:"true"`
+	Owner    Owner  `json:"owner" x-go-name:"PetOwner"`
+}
+
+// PetQuery is the query of the pets to get.
+type PetQuery struct {
+	Limit int `form:"limit" x-ratelimit:"100"`
+}
+
+func getPets(c *gin.Context) {
+	var query PetQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	c.JSON(http.StatusOK, []Pet{})
+}
+
+// createPet creates a pet.
+// @extension x-ratelimit 10
+// @extension x-audit {"level": "high"}
+func createPet(c *gin.Context) {
+	var pet Pet
+	if err := c.ShouldBindJSON(&pet); err != nil {
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	c.JSON(http.StatusCreated, pet)
+}
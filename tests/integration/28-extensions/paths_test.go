@@ -0,0 +1,78 @@
+package petstore
+
+import (
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/tests/integration/helpers"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestExtensions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	config := &astra.Config{
+		Host: "localhost",
+		Port: 8000,
+	}
+	config.AddExtension("x-audience", "public")
+
+	t.Run("Inlined Into The Output", func(t *testing.T) {
+		r := setupRouter()
+
+		testAstra, err := helpers.SetupTestAstra(t, r, config, astra.WithExtensionFunc(func(route astra.Route) map[string]any {
+			return map[string]any{
+				"x-ratelimit": 1000,
+				"x-owner":     "pets-team",
+			}
+		}))
+		require.NoError(t, err)
+
+		require.NotNil(t, testAstra)
+
+		require.Equal(t, "public", testAstra.Search("info", "x-audience").Data().(string))
+
+		getPets := testAstra.Search("paths", "/pets", "get")
+		require.Equal(t, 1000.0, getPets.Search("x-ratelimit").Data().(float64))
+		require.Equal(t, "pets-team", getPets.Search("x-owner").Data().(string))
+
+		// The @extension directive takes precedence over the extension function
+		createPet := testAstra.Search("paths", "/pets", "post")
+		require.Equal(t, 10.0, createPet.Search("x-ratelimit").Data().(float64))
+		require.Equal(t, map[string]any{"level": "high"}, createPet.Search("x-audit").Data())
+		require.Equal(t, "pets-team", createPet.Search("x-owner").Data().(string))
+
+		require.Equal(t, "limit", getPets.Search("parameters", "0", "name").Data().(string))
+		require.Equal(t, 100.0, getPets.Search("parameters", "0", "x-ratelimit").Data().(float64))
+		require.False(t, getPets.Exists("parameters", "0", "schema", "x-ratelimit"))
+
+		properties := testAstra.Search("components", "schemas", "Pet", "properties")
+		require.Equal(t, "uuid.UUID", properties.Search("id", "x-go-type").Data().(string))
+		require.Equal(t, map[string]any{"db": "id"}, properties.Search("id", "x-oapi-codegen-extra-tags").Data())
+		require.Equal(t, true, properties.Search("internal", "x-internal").Data().(bool))
+		require.False(t, properties.Exists("name", "x-go-type"))
+
+		// A reference can't have siblings in OpenAPI 3.0, so it is wrapped in allOf
+		require.Equal(t, "PetOwner", properties.Search("owner", "x-go-name").Data().(string))
+		require.Equal(t, "#/components/schemas/Owner", properties.Search("owner", "allOf", "0", "$ref").Data().(string))
+		require.False(t, properties.Exists("owner", "$ref"))
+
+		require.False(t, testAstra.Exists("components", "schemas", "Pet", "x-go-type"))
+	})
+
+	t.Run("Go Type Extensions", func(t *testing.T) {
+		r := setupRouter()
+
+		testAstra, err := helpers.SetupTestAstra(t, r, config, astra.WithGoTypeExtensions())
+		require.NoError(t, err)
+
+		require.NotNil(t, testAstra)
+
+		for _, name := range []string{"Pet", "Owner"} {
+			schema := testAstra.Search("components", "schemas", name)
+			require.Equal(t, name, schema.Search("x-go-type").Data().(string))
+			require.Equal(t, "github.com/ls6-events/astra/tests/integration/28-extensions", schema.Search("x-go-package").Data().(string))
+		}
+	})
+}
@@ -0,0 +1,77 @@
+package petstore
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PetStatus is the status of a pet in the store.
+type PetStatus string
+
+const (
+	PetStatusAvailable PetStatus = "available"
+	PetStatusSold      PetStatus = "sold"
+)
+
+// Animal is an animal.
+type Animal struct {
+	Legs int `json:"legs"`
+}
+
+// Pet is a pet in the store.
+type Pet struct {
+	Animal
+	ID     int       `json:"id"`
+	Name   string    `json:"name"`
+	Status PetStatus `json:"status"`
+	Tag    string    `json:"tag,omitempty"`
+	Owner  *Owner    `json:"owner"`
+}
+
+// Owner is the owner of a pet.
+type Owner struct {
+	Name string `json:"name"`
+}
+
+// Error is an error response.
+type Error struct {
+	Message string `json:"message"`
+}
+
+type getPetsQuery struct {
+	Limit int `form:"limit"`
+}
+
+func getPets(c *gin.Context) {
+	var query getPetsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, []Pet{})
+}
+
+func getPet(c *gin.Context) {
+	if c.Param("id") == "" {
+		c.JSON(http.StatusNotFound, Error{Message: "not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, Pet{})
+}
+
+func createPet(c *gin.Context) {
+	var pet Pet
+	if err := c.ShouldBindJSON(&pet); err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, pet)
+}
+
+func getPetStatus(c *gin.Context) {
+	c.JSON(http.StatusOK, PetStatusAvailable)
+}
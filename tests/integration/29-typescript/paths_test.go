@@ -0,0 +1,51 @@
+package petstore
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/inputs"
+	"github.com/ls6-events/astra/outputs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypeScript(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	gen := astra.New(inputs.WithGinInput(setupRouter()), outputs.WithTypeScriptOutput("./output.ts"))
+
+	gen.SetConfig(&astra.Config{
+		Host: "localhost",
+		Port: 8000,
+	})
+
+	err := gen.Parse()
+	require.NoError(t, err)
+
+	fileContents, err := os.ReadFile("./output.ts")
+	require.NoError(t, err)
+	output := string(fileContents)
+
+	t.Run("Components", func(t *testing.T) {
+		require.Contains(t, output, "/** Pet is a pet in the store. */\nexport interface Pet extends Animal {\n")
+		require.Contains(t, output, "  owner: Owner | null;\n")
+		require.Contains(t, output, "export type PetStatus = \"available\" | \"sold\";\n")
+		require.Contains(t, output, "  status: PetStatus;\n")
+		require.Contains(t, output, "  tag?: string;\n")
+		require.Contains(t, output, "export interface Animal {\n  legs: number;\n}\n")
+	})
+
+	t.Run("Operations", func(t *testing.T) {
+		require.Contains(t, output, "export type GetPetRequest = {\n  path: { id: string };\n};\n")
+		require.Contains(t, output, "export type GetPetResponse =\n  | { status: 200; body: Pet }\n  | { status: 404; body: Error };\n")
+		require.Contains(t, output, "export type GetPetsRequest = {\n  query?: { limit?: number };\n};\n")
+		require.Contains(t, output, "export type GetPetsResponse =\n  | { status: 200; body: Pet[] }\n  | { status: 400; body: Error };\n")
+		require.Contains(t, output, "export type CreatePetRequest = {\n  body?: Pet;\n};\n")
+		require.Contains(t, output, "export type GetPetStatusResponse =\n  | { status: 200; body: PetStatus };\n")
+		require.Contains(t, output, "  | { status: 201; body: Pet }\n")
+		require.Contains(t, output, "  getPet: { request: GetPetRequest; response: GetPetResponse };\n")
+	})
+}
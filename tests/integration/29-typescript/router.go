@@ -0,0 +1,14 @@
+package petstore
+
+import "github.com/gin-gonic/gin"
+
+func setupRouter() *gin.Engine {
+	r := gin.Default()
+
+	r.GET("/pets", getPets)
+	r.GET("/pets/:id", getPet)
+	r.POST("/pets", createPet)
+	r.GET("/pets/:id/status", getPetStatus)
+
+	return r
+}
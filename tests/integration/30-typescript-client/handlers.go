@@ -0,0 +1,56 @@
+package petstore
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Pet is a pet in the store.
+type Pet struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Tag  string `json:"tag,omitempty"`
+}
+
+// Error is an error response.
+type Error struct {
+	Message string `json:"message"`
+}
+
+type getPetsQuery struct {
+	Limit int `form:"limit"`
+}
+
+func getPets(c *gin.Context) {
+	var query getPetsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, []Pet{})
+}
+
+func getPet(c *gin.Context) {
+	if c.Param("id") == "" {
+		c.JSON(http.StatusNotFound, Error{Message: "not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, Pet{})
+}
+
+func createPet(c *gin.Context) {
+	var pet Pet
+	if err := c.ShouldBindJSON(&pet); err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, pet)
+}
+
+func getFile(c *gin.Context) {
+	c.String(http.StatusOK, c.Param("filepath"))
+}
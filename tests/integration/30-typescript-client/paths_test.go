@@ -0,0 +1,56 @@
+package petstore
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/inputs"
+	"github.com/ls6-events/astra/outputs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypeScriptClient(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	gen := astra.New(inputs.WithGinInput(setupRouter()), outputs.WithTypeScriptClientOutput("./output"))
+
+	gen.SetConfig(&astra.Config{
+		Host:     "localhost",
+		Port:     8000,
+		BasePath: "/api/v1",
+	})
+
+	err := gen.Parse()
+	require.NoError(t, err)
+
+	fileContents, err := os.ReadFile("./output.ts")
+	require.NoError(t, err)
+	output := string(fileContents)
+
+	t.Run("Types", func(t *testing.T) {
+		require.Contains(t, output, "export interface Pet {\n")
+		require.Contains(t, output, "export type GetPetRequest = {\n  path: { id: string };\n};\n")
+		require.Contains(t, output, "export type GetPetResponse =\n  | { status: 200; body: Pet }\n  | { status: 404; body: Error };\n")
+	})
+
+	t.Run("Runtime", func(t *testing.T) {
+		require.Contains(t, output, "const basePath = \"/api/v1\";\n")
+		require.Contains(t, output, "export interface ClientOptions {\n")
+		require.Contains(t, output, "  fetch?: Fetch;\n")
+		require.Contains(t, output, "export type Client = ReturnType<typeof createClient>;\n")
+	})
+
+	t.Run("Functions", func(t *testing.T) {
+		require.Contains(t, output, "export function createClient(options: ClientOptions = {}) {\n")
+		require.Contains(t, output, "    getPets: (request: GetPetsRequest = {}): Promise<GetPetsResponse> =>\n      send<GetPetsResponse>(options, \"GET\", \"/pets\", \"\", request),\n")
+		require.Contains(t, output, "    getPet: (request: GetPetRequest): Promise<GetPetResponse> =>\n      send<GetPetResponse>(options, \"GET\", \"/pets/{id}\", \"\", request),\n")
+		require.Contains(t, output, "    createPet: (request: CreatePetRequest = {}): Promise<CreatePetResponse> =>\n      send<CreatePetResponse>(options, \"POST\", \"/pets\", \"application/json\", request),\n")
+		require.Contains(t, output, "    getFile: (request: GetFileRequest = {}): Promise<GetFileResponse> =>\n      send<GetFileResponse>(options, \"GET\", \"/files/{filepath*}\", \"\", request),\n")
+		// The catch-all param of a wildcard route is substituted by its name, keeping its slashes
+		require.Contains(t, output, "path.replace(/\\{([^}*]+)(\\*?)\\}/g, (_, name: string, catchAll: string) => {\n")
+		require.Contains(t, output, "return catchAll === \"\" ? value : value.replace(/%2F/gi, \"/\");\n")
+	})
+}
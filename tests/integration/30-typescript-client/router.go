@@ -0,0 +1,15 @@
+package petstore
+
+import "github.com/gin-gonic/gin"
+
+func setupRouter() *gin.Engine {
+	r := gin.Default()
+
+	api := r.Group("/api/v1")
+	api.GET("/pets", getPets)
+	api.GET("/pets/:id", getPet)
+	api.POST("/pets", createPet)
+	api.GET("/files/*filepath", getFile)
+
+	return r
+}
@@ -0,0 +1,59 @@
+package petstore
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/inputs"
+	"github.com/ls6-events/astra/outputs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestZod(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	gen := astra.New(inputs.WithGinInput(setupRouter()), outputs.WithZodOutput("./output.ts"))
+
+	gen.SetConfig(&astra.Config{
+		Host: "localhost",
+		Port: 8000,
+	})
+
+	err := gen.Parse()
+	require.NoError(t, err)
+
+	fileContents, err := os.ReadFile("./output.ts")
+	require.NoError(t, err)
+	output := string(fileContents)
+
+	require.Contains(t, output, "import { z } from \"zod\";\n")
+
+	t.Run("Objects", func(t *testing.T) {
+		require.Contains(t, output, "/** Pet is a pet in the store. */\nexport const Pet = z.object({\n")
+		require.Contains(t, output, "  tag: z.string().optional(),\n")
+		require.Contains(t, output, "  owner: Owner.nullable(),\n")
+		require.Contains(t, output, "export type Pet = z.infer<typeof Pet>;\n")
+	})
+
+	t.Run("Enums", func(t *testing.T) {
+		require.Contains(t, output, "export const PetStatus = z.enum([\"available\", \"sold\"]);\n")
+		require.Contains(t, output, "  status: PetStatus,\n")
+		require.Contains(t, output, "  size: z.enum([\"small\", \"medium\", \"large\"]),\n")
+	})
+
+	t.Run("Refinements", func(t *testing.T) {
+		require.Contains(t, output, "  id: z.string().uuid(),\n")
+		require.Contains(t, output, "  name: z.string().max(50).min(1),\n")
+		require.Contains(t, output, "  email: z.string().email(),\n")
+		require.Contains(t, output, "  age: z.number().int().gte(0).lte(30),\n")
+	})
+
+	t.Run("Recursive", func(t *testing.T) {
+		require.Contains(t, output, "export interface Category {\n")
+		require.Contains(t, output, "export const Category: z.ZodType<Category> = z.object({\n")
+		require.Contains(t, output, "  subcategories: z.array(z.lazy(() => Category)),\n")
+	})
+}
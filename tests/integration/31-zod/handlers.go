@@ -0,0 +1,56 @@
+package petstore
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PetStatus is the status of a pet in the store.
+type PetStatus string
+
+const (
+	PetStatusAvailable PetStatus = "available"
+	PetStatusSold      PetStatus = "sold"
+)
+
+// Pet is a pet in the store.
+type Pet struct {
+	ID     string    `json:"id" validate:"uuid"`
+	Name   string    `json:"name" binding:"required,min=1,max=50"`
+	Email  string    `json:"email" binding:"email"`
+	Age    int       `json:"age" validate:"gte=0,lte=30"`
+	Size   string    `json:"size" binding:"oneof=small medium large"`
+	Status PetStatus `json:"status"`
+	Tag    string    `json:"tag,omitempty"`
+	Owner  *Owner    `json:"owner"`
+}
+
+// Owner is the owner of a pet.
+type Owner struct {
+	Name string `json:"name"`
+}
+
+// Category is a category of pets, which can have subcategories.
+type Category struct {
+	Name          string     `json:"name"`
+	Subcategories []Category `json:"subcategories"`
+}
+
+func createPet(c *gin.Context) {
+	var pet Pet
+	if err := c.ShouldBindJSON(&pet); err != nil {
+		c.Status(http.StatusBadRequest)
+		return
+	}
+
+	c.JSON(http.StatusCreated, pet)
+}
+
+func getPetStatus(c *gin.Context) {
+	c.JSON(http.StatusOK, PetStatusAvailable)
+}
+
+func getCategories(c *gin.Context) {
+	c.JSON(http.StatusOK, []Category{})
+}
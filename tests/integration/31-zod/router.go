@@ -0,0 +1,13 @@
+package petstore
+
+import "github.com/gin-gonic/gin"
+
+func setupRouter() *gin.Engine {
+	r := gin.Default()
+
+	r.POST("/pets", createPet)
+	r.GET("/pets/:id/status", getPetStatus)
+	r.GET("/categories", getCategories)
+
+	return r
+}
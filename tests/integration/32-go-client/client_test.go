@@ -0,0 +1,88 @@
+package petstore
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/inputs"
+	"github.com/ls6-events/astra/outputs"
+	"github.com/ls6-events/astra/outputs/goclient"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoClient(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	gen := astra.New(
+		inputs.WithGinInput(setupRouter()),
+		outputs.WithGoClientOutput("./client"),
+		outputs.WithGoClientOutput("./original", goclient.WithPackageName("petclient"), goclient.WithOriginalTypes(true)),
+	)
+
+	gen.SetConfig(&astra.Config{
+		Host:     "localhost",
+		Port:     8000,
+		BasePath: "/api/v1",
+	})
+
+	err := gen.Parse()
+	require.NoError(t, err)
+
+	t.Run("Types", func(t *testing.T) {
+		fileContents, err := os.ReadFile("./client/types.go")
+		require.NoError(t, err)
+		types := string(fileContents)
+
+		require.Contains(t, types, "package client\n")
+		require.Contains(t, types, "// Pet is a pet in the store.\ntype Pet struct {\n")
+		require.Contains(t, types, "\tCreatedAt time.Time `json:\"createdAt\"`\n")
+		require.Contains(t, types, "\tOwner     *Owner    `json:\"owner\"`\n")
+		require.Contains(t, types, "\tTag       string    `json:\"tag,omitempty\"`\n")
+		require.Contains(t, types, "type PetStatus string\n")
+		require.Contains(t, types, "\tPetStatusAvailable PetStatus = \"available\"\n")
+	})
+
+	t.Run("Client", func(t *testing.T) {
+		fileContents, err := os.ReadFile("./client/client.go")
+		require.NoError(t, err)
+		client := string(fileContents)
+
+		require.Contains(t, client, "const BasePath = \"/api/v1\"\n")
+		require.Contains(t, client, "func (c *Client) GetPets(ctx context.Context, params GetPetsParams) ([]Pet, error) {\n")
+		require.Contains(t, client, "func (c *Client) GetPet(ctx context.Context, params GetPetParams) (Pet, error) {\n")
+		require.Contains(t, client, "func (c *Client) CreatePet(ctx context.Context, body Pet) (Pet, error) {\n")
+		require.Contains(t, client, "func (c *Client) DeletePet(ctx context.Context, params DeletePetParams) error {\n")
+		require.Contains(t, client, "type GetPetNotFoundError struct {\n")
+		// The catch-all param of a wildcard route keeps its slashes
+		require.Contains(t, client, "c.do(ctx, \"GET\", \"/files/\"+strings.ReplaceAll(url.PathEscape(paramString(params.Filepath)), \"%2F\", \"/\"), nil, nil, nil, \"\")")
+	})
+
+	t.Run("OriginalTypes", func(t *testing.T) {
+		// The types that aren't exported are still generated
+		fileContents, err := os.ReadFile("./original/types.go")
+		require.NoError(t, err)
+		require.Contains(t, string(fileContents), "type GetPetsQuery struct {\n")
+		require.NotContains(t, string(fileContents), "type Pet struct {\n")
+
+		fileContents, err = os.ReadFile("./original/client.go")
+		require.NoError(t, err)
+		client := string(fileContents)
+
+		require.Contains(t, client, "package petclient\n")
+		require.Contains(t, client, "goclient \"github.com/ls6-events/astra/tests/integration/32-go-client\"\n")
+		require.Contains(t, client, "func (c *Client) GetPet(ctx context.Context, params GetPetParams) (goclient.Pet, error) {\n")
+	})
+
+	t.Run("Compiles", func(t *testing.T) {
+		if _, err := exec.LookPath("go"); err != nil {
+			t.Skip("skipping as the go command isn't installed")
+		}
+
+		output, err := exec.Command("go", "vet", "./client", "./original").CombinedOutput()
+		require.NoError(t, err, string(output))
+	})
+}
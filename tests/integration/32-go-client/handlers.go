@@ -0,0 +1,82 @@
+package petstore
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PetStatus is the status of a pet in the store.
+type PetStatus string
+
+const (
+	PetStatusAvailable PetStatus = "available"
+	PetStatusSold      PetStatus = "sold"
+)
+
+// Owner is the owner of a pet.
+type Owner struct {
+	Name string `json:"name"`
+}
+
+// Pet is a pet in the store.
+type Pet struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Tag       string    `json:"tag,omitempty"`
+	Status    PetStatus `json:"status"`
+	Owner     *Owner    `json:"owner"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Error is an error response.
+type Error struct {
+	Message string `json:"message"`
+}
+
+type getPetsQuery struct {
+	Limit int      `form:"limit"`
+	Tags  []string `form:"tags"`
+}
+
+func getPets(c *gin.Context) {
+	var query getPetsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, []Pet{})
+}
+
+func getPet(c *gin.Context) {
+	if c.Param("id") == "" {
+		c.JSON(http.StatusNotFound, Error{Message: "not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, Pet{})
+}
+
+func getPetStatus(c *gin.Context) {
+	c.JSON(http.StatusOK, PetStatusAvailable)
+}
+
+func createPet(c *gin.Context) {
+	var pet Pet
+	if err := c.ShouldBindJSON(&pet); err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, pet)
+}
+
+func deletePet(c *gin.Context) {
+	c.Status(http.StatusNoContent)
+}
+
+func getFile(c *gin.Context) {
+	c.String(http.StatusOK, c.Param("filepath"))
+}
@@ -0,0 +1,44 @@
+package petstore
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Owner is the owner of a pet.
+type Owner struct {
+	Name string `json:"name"`
+}
+
+// Pet is a pet in the store.
+type Pet struct {
+	ID    int      `json:"id"`
+	Name  string   `json:"name"`
+	Tags  []string `json:"tags"`
+	Owner *Owner   `json:"owner"`
+}
+
+// Error is an error response.
+type Error struct {
+	Message string `json:"message"`
+}
+
+func getPet(c *gin.Context) {
+	if c.Param("id") == "" {
+		c.JSON(http.StatusNotFound, Error{Message: "not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, Pet{})
+}
+
+func createPet(c *gin.Context) {
+	var pet Pet
+	if err := c.ShouldBindJSON(&pet); err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, pet)
+}
@@ -0,0 +1,12 @@
+package petstore
+
+import "github.com/gin-gonic/gin"
+
+func setupRouter() *gin.Engine {
+	r := gin.Default()
+
+	r.GET("/pets/:id", getPet)
+	r.POST("/pets", createPet)
+
+	return r
+}
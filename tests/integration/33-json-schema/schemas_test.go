@@ -0,0 +1,66 @@
+package petstore
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/Jeffail/gabs/v2"
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/inputs"
+	"github.com/ls6-events/astra/outputs"
+	"github.com/stretchr/testify/require"
+)
+
+func readSchemaFile(t *testing.T, fileName string) *gabs.Container {
+	t.Helper()
+
+	fileContents, err := os.ReadFile(path.Join("./output", fileName))
+	require.NoError(t, err)
+
+	contents, err := gabs.ParseJSON(fileContents)
+	require.NoError(t, err)
+
+	return contents
+}
+
+func TestJSONSchema(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	gen := astra.New(inputs.WithGinInput(setupRouter()), outputs.WithJSONSchemaOutput("./output"))
+
+	gen.SetConfig(&astra.Config{
+		Host: "localhost",
+		Port: 8000,
+	})
+
+	err := gen.Parse()
+	require.NoError(t, err)
+
+	t.Run("Documents", func(t *testing.T) {
+		pet := readSchemaFile(t, "Pet.json")
+		require.Equal(t, "https://json-schema.org/draft/2020-12/schema", pet.Search("$schema").Data().(string))
+		require.Equal(t, "Pet.json", pet.Search("$id").Data().(string))
+		require.Equal(t, "Pet", pet.Search("title").Data().(string))
+		require.Equal(t, "Pet is a pet in the store.", pet.Search("description").Data().(string))
+
+		owner := readSchemaFile(t, "Owner.json")
+		require.Equal(t, "Owner.json", owner.Search("$id").Data().(string))
+		require.Equal(t, "string", owner.Search("properties", "name", "type").Data().(string))
+	})
+
+	t.Run("Relative References", func(t *testing.T) {
+		pet := readSchemaFile(t, "Pet.json")
+		require.Equal(t, "Owner.json", pet.Search("properties", "owner", "anyOf", "0", "$ref").Data().(string))
+		require.Equal(t, "null", pet.Search("properties", "owner", "anyOf", "1", "type").Data().(string))
+	})
+
+	t.Run("Bundle", func(t *testing.T) {
+		bundle := readSchemaFile(t, "bundle.json")
+		require.Equal(t, "bundle.json", bundle.Search("$id").Data().(string))
+		require.Equal(t, "#/$defs/Owner", bundle.Search("$defs", "Pet", "properties", "owner", "anyOf", "0", "$ref").Data().(string))
+		require.Equal(t, "object", bundle.Search("$defs", "Error", "type").Data().(string))
+	})
+}
@@ -0,0 +1,133 @@
+package petstore
+
+import (
+	"os"
+	"testing"
+
+	"github.com/Jeffail/gabs/v2"
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/inputs"
+	"github.com/ls6-events/astra/outputs"
+	"github.com/ls6-events/astra/outputs/postman"
+	"github.com/stretchr/testify/require"
+)
+
+func setupCollection(t *testing.T, filePath string, options ...postman.Option) *gabs.Container {
+	t.Helper()
+
+	gen := astra.New(
+		inputs.WithGinInput(setupRouter()),
+		outputs.WithPostmanOutput(filePath, options...),
+		astra.WithTagStrategy(astra.TagStrategyPathSegment),
+	)
+
+	gen.SetConfig(&astra.Config{
+		Title:    "Petstore",
+		Host:     "localhost",
+		Port:     8000,
+		BasePath: "/api/v1",
+	})
+
+	err := gen.Parse()
+	require.NoError(t, err)
+
+	fileContents, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+
+	contents, err := gabs.ParseJSON(fileContents)
+	require.NoError(t, err)
+
+	return contents
+}
+
+func findItem(t *testing.T, items *gabs.Container, name string) *gabs.Container {
+	t.Helper()
+
+	for _, item := range items.Children() {
+		if item.Search("name").Data() == name {
+			return item
+		}
+	}
+
+	require.Failf(t, "item not found", "no item named %s", name)
+	return nil
+}
+
+func TestPostman(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	t.Run("Collection", func(t *testing.T) {
+		collection := setupCollection(t, "./collection.json")
+
+		require.Equal(t, "Petstore", collection.Search("info", "name").Data())
+		require.Equal(t, "https://schema.getpostman.com/json/collection/v2.1.0/collection.json", collection.Search("info", "schema").Data())
+		require.Equal(t, "baseUrl", collection.Search("variable", "0", "key").Data())
+		require.Equal(t, "http://localhost:8000/api/v1", collection.Search("variable", "0", "value").Data())
+	})
+
+	t.Run("Folders By Tag", func(t *testing.T) {
+		collection := setupCollection(t, "./collection.json")
+
+		items := collection.Search("item").Children()
+		require.Len(t, items, 2)
+		require.Equal(t, "owners", items[0].Search("name").Data())
+		require.Len(t, items[0].Search("item").Children(), 1)
+		require.Equal(t, "pets", items[1].Search("name").Data())
+		require.Len(t, items[1].Search("item").Children(), 4)
+	})
+
+	t.Run("Folders By Path", func(t *testing.T) {
+		collection := setupCollection(t, "./collection.json", postman.WithFolderStrategy(postman.FolderByPath))
+
+		items := collection.Search("item").Children()
+		require.Len(t, items, 2)
+		require.Equal(t, "owners", items[0].Search("name").Data())
+		require.Equal(t, "pets", items[1].Search("name").Data())
+		require.Len(t, items[1].Search("item").Children(), 4)
+	})
+
+	t.Run("Path Variables And Headers", func(t *testing.T) {
+		collection := setupCollection(t, "./collection.json")
+		pets := findItem(t, collection.Search("item"), "pets")
+
+		getPet := findItem(t, pets.Search("item"), "Get pet")
+		require.Equal(t, "GET", getPet.Search("request", "method").Data())
+		require.Equal(t, "{{baseUrl}}/pets/:id", getPet.Search("request", "url", "raw").Data())
+		require.Equal(t, []any{"pets", ":id"}, getPet.Search("request", "url", "path").Data())
+		require.Equal(t, "id", getPet.Search("request", "url", "variable", "0", "key").Data())
+		require.Equal(t, "X-Request-ID", getPet.Search("request", "header", "0", "key").Data())
+	})
+
+	t.Run("Query Params", func(t *testing.T) {
+		collection := setupCollection(t, "./collection.json")
+		pets := findItem(t, collection.Search("item"), "pets")
+
+		getPets := findItem(t, pets.Search("item"), "Get pets")
+		require.Equal(t, "{{baseUrl}}/pets?limit=0", getPets.Search("request", "url", "raw").Data())
+
+		query := getPets.Search("request", "url", "query").Children()
+		require.Len(t, query, 2)
+		require.Equal(t, "limit", query[0].Search("key").Data())
+		require.Nil(t, query[0].Search("disabled").Data())
+		require.Equal(t, "status", query[1].Search("key").Data())
+		require.Equal(t, true, query[1].Search("disabled").Data())
+	})
+
+	t.Run("Example Body", func(t *testing.T) {
+		collection := setupCollection(t, "./collection.json")
+		pets := findItem(t, collection.Search("item"), "pets")
+
+		createPet := findItem(t, pets.Search("item"), "Create pet")
+		require.Equal(t, "raw", createPet.Search("request", "body", "mode").Data())
+		require.Equal(t, "json", createPet.Search("request", "body", "options", "raw", "language").Data())
+
+		body, err := gabs.ParseJSON([]byte(createPet.Search("request", "body", "raw").Data().(string)))
+		require.NoError(t, err)
+		require.Equal(t, "available", body.Search("status").Data())
+		require.Equal(t, "2006-01-02T15:04:05Z", body.Search("bornAt").Data())
+		require.Equal(t, "string", body.Search("name").Data())
+		require.Equal(t, []any{"string"}, body.Search("tags").Data())
+	})
+}
@@ -0,0 +1,78 @@
+package petstore
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Status is the status of a pet in the store.
+type Status string
+
+const (
+	StatusAvailable Status = "available"
+	StatusSold      Status = "sold"
+)
+
+// Pet is a pet in the store.
+type Pet struct {
+	ID     int       `json:"id"`
+	Name   string    `json:"name"`
+	Status Status    `json:"status"`
+	BornAt time.Time `json:"bornAt"`
+	Tags   []string  `json:"tags"`
+}
+
+// Owner is the owner of a pet.
+type Owner struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// Error is an error response.
+type Error struct {
+	Message string `json:"message"`
+}
+
+type getPetsQuery struct {
+	Status string `form:"status"`
+}
+
+// @param limit query int true "the number of pets to list"
+func getPets(c *gin.Context) {
+	var query getPetsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, []Pet{})
+}
+
+func getPet(c *gin.Context) {
+	if c.GetHeader("X-Request-ID") == "" {
+		c.JSON(http.StatusBadRequest, Error{Message: "missing request ID"})
+		return
+	}
+
+	c.JSON(http.StatusOK, Pet{Name: c.Param("id")})
+}
+
+func createPet(c *gin.Context) {
+	var pet Pet
+	if err := c.ShouldBindJSON(&pet); err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, pet)
+}
+
+func getDefaultStatus(c *gin.Context) {
+	c.JSON(http.StatusOK, StatusAvailable)
+}
+
+func getOwners(c *gin.Context) {
+	c.JSON(http.StatusOK, []Owner{})
+}
@@ -0,0 +1,56 @@
+package petstore
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Status is the status of a pet in the store.
+type Status string
+
+const (
+	StatusAvailable Status = "available"
+	StatusSold      Status = "sold"
+)
+
+// Pet is a pet in the store.
+type Pet struct {
+	ID     int       `json:"id"`
+	Name   string    `json:"name"`
+	Status Status    `json:"status"`
+	BornAt time.Time `json:"bornAt"`
+}
+
+// Error is an error response.
+type Error struct {
+	Message string `json:"message"`
+}
+
+// getPet gets a pet by its ID.
+// The request must be traced by its ID.
+// @param verbose query bool true "whether the pet is described in full"
+func getPet(c *gin.Context) {
+	if c.GetHeader("X-Request-ID") == "" {
+		c.JSON(http.StatusBadRequest, Error{Message: "missing request ID"})
+		return
+	}
+
+	c.JSON(http.StatusOK, Pet{Name: c.Param("id")})
+}
+
+// createPet adds a pet to the store.
+func createPet(c *gin.Context) {
+	var pet Pet
+	if err := c.ShouldBindJSON(&pet); err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, pet)
+}
+
+func getDefaultStatus(c *gin.Context) {
+	c.JSON(http.StatusOK, StatusAvailable)
+}
@@ -0,0 +1,32 @@
+// Package owners manages the owners of the pets.
+package owners
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Owner is the owner of pets in the store.
+type Owner struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// GetOwner gets an owner by their ID.
+func GetOwner(c *gin.Context) {
+	c.JSON(http.StatusOK, Owner{Name: c.Param("id")})
+}
+
+// UploadPhoto uploads a photo of an owner.
+func UploadPhoto(c *gin.Context) {
+	_, err := c.FormFile("photo")
+	if err != nil {
+		c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	_ = c.PostForm("caption")
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,90 @@
+package petstore
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/inputs"
+	"github.com/ls6-events/astra/outputs"
+	"github.com/ls6-events/astra/outputs/httpfile"
+	"github.com/stretchr/testify/require"
+)
+
+func generateRequestFiles(t *testing.T, options ...astra.Option) {
+	t.Helper()
+
+	gen := astra.New(append([]astra.Option{inputs.WithGinInput(setupRouter())}, options...)...)
+
+	gen.SetConfig(&astra.Config{
+		Host:     "localhost",
+		Port:     8000,
+		BasePath: "/api/v1",
+	})
+
+	err := gen.Parse()
+	require.NoError(t, err)
+}
+
+func readRequestFile(t *testing.T, fileName string) string {
+	t.Helper()
+
+	fileContents, err := os.ReadFile(path.Join("./output", fileName))
+	require.NoError(t, err)
+
+	return string(fileContents)
+}
+
+func TestHTTPFiles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	t.Run("Files By Tag", func(t *testing.T) {
+		generateRequestFiles(t, outputs.WithHTTPOutput("./output"), astra.WithTagStrategy(astra.TagStrategyPathSegment))
+
+		entries, err := os.ReadDir("./output")
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		require.Equal(t, "owners.http", entries[0].Name())
+		require.Equal(t, "pets.http", entries[1].Name())
+
+		pets := readRequestFile(t, "pets.http")
+		require.Contains(t, pets, "@baseUrl = http://localhost:8000/api/v1\n")
+		require.Contains(t, pets, "### createPet adds a pet to the store\n")
+		require.Contains(t, pets, "### getPet gets a pet by its ID\n")
+		require.Contains(t, pets, "### Get default status\n")
+	})
+
+	t.Run("Files By Package", func(t *testing.T) {
+		generateRequestFiles(t, outputs.WithHTTPOutput("./output", httpfile.WithGroupStrategy(httpfile.GroupByPackage)))
+
+		entries, err := os.ReadDir("./output")
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		require.Equal(t, "owners.http", entries[0].Name())
+		require.Equal(t, "petstore.http", entries[1].Name())
+	})
+
+	t.Run("Placeholders And Comments", func(t *testing.T) {
+		generateRequestFiles(t, outputs.WithHTTPOutput("./output"), astra.WithTagStrategy(astra.TagStrategyPathSegment))
+
+		pets := readRequestFile(t, "pets.http")
+		require.Contains(t, pets, "@id = string\n")
+		require.Contains(t, pets, "@verbose = false\n")
+		require.Contains(t, pets, "@X_Request_ID = string\n")
+		require.Contains(t, pets, "### getPet gets a pet by its ID\n# getPet gets a pet by its ID.\n# The request must be traced by its ID.\n# @name getPet\nGET {{baseUrl}}/pets/{{id}}?verbose={{verbose}}\nX-Request-ID: {{X_Request_ID}}\n")
+	})
+
+	t.Run("Bodies", func(t *testing.T) {
+		generateRequestFiles(t, outputs.WithHTTPOutput("./output"), astra.WithTagStrategy(astra.TagStrategyPathSegment))
+
+		pets := readRequestFile(t, "pets.http")
+		require.Contains(t, pets, "POST {{baseUrl}}/pets\nContent-Type: application/json\n\n{\n  \"bornAt\": \"2006-01-02T15:04:05Z\",\n  \"id\": 0,\n  \"name\": \"string\",\n  \"status\": \"available\"\n}\n")
+
+		owners := readRequestFile(t, "owners.http")
+		require.Contains(t, owners, "POST {{baseUrl}}/owners/{{id}}/photo\nContent-Type: multipart/form-data; boundary=boundary\n")
+		require.Contains(t, owners, "Content-Disposition: form-data; name=\"photo\"; filename=\"photo\"\n\n< ./photo\n")
+	})
+}
@@ -0,0 +1,19 @@
+package petstore
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/ls6-events/astra/tests/integration/35-http-files/owners"
+)
+
+func setupRouter() *gin.Engine {
+	r := gin.Default()
+
+	api := r.Group("/api/v1")
+	api.GET("/pets/:id", getPet)
+	api.POST("/pets", createPet)
+	api.GET("/pets/statuses/default", getDefaultStatus)
+	api.GET("/owners/:id", owners.GetOwner)
+	api.POST("/owners/:id/photo", owners.UploadPhoto)
+
+	return r
+}
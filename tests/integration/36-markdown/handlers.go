@@ -0,0 +1,75 @@
+package petstore
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Status is the status of a pet in the store.
+type Status string
+
+const (
+	StatusAvailable Status = "available"
+	StatusSold      Status = "sold"
+)
+
+// Pet is a pet in the store.
+type Pet struct {
+	// ID is the unique identifier of the pet.
+	ID int `json:"id"`
+	// Name is the name of the pet.
+	Name   string   `json:"name" binding:"required"`
+	Status Status   `json:"status"`
+	Tags   []string `json:"tags"`
+	Owner  *Owner   `json:"owner"`
+}
+
+// Owner is the owner of a pet.
+type Owner struct {
+	// Name is the full name of the owner.
+	Name string `json:"name" binding:"required"`
+}
+
+// Error is an error response.
+type Error struct {
+	Message string `json:"message"`
+}
+
+// getPets lists the pets in the store.
+// @param limit query int true "the number of pets to list"
+func getPets(c *gin.Context) {
+	c.JSON(http.StatusOK, []Pet{})
+}
+
+// getPet gets a pet by its ID.
+func getPet(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusNotFound, Error{Message: "pet not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, Pet{Name: id})
+}
+
+// createPet adds a pet to the store.
+func createPet(c *gin.Context) {
+	var pet Pet
+	if err := c.ShouldBindJSON(&pet); err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, pet)
+}
+
+// getDefaultStatus gets the status of the pets that are added to the store.
+func getDefaultStatus(c *gin.Context) {
+	c.JSON(http.StatusOK, StatusAvailable)
+}
+
+// getOwners lists the owners of the pets.
+func getOwners(c *gin.Context) {
+	c.JSON(http.StatusOK, []Owner{})
+}
@@ -0,0 +1,77 @@
+package petstore
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/inputs"
+	"github.com/ls6-events/astra/outputs"
+	"github.com/ls6-events/astra/outputs/markdown"
+	"github.com/stretchr/testify/require"
+)
+
+func setupReference(t *testing.T, filePath string, options ...markdown.Option) string {
+	t.Helper()
+
+	gen := astra.New(
+		inputs.WithGinInput(setupRouter()),
+		outputs.WithMarkdownOutput(filePath, options...),
+		astra.WithTagStrategy(astra.TagStrategyPathSegment),
+	)
+
+	gen.SetConfig(&astra.Config{
+		Title:    "Petstore",
+		Host:     "localhost",
+		Port:     8000,
+		BasePath: "/api/v1",
+	})
+
+	err := gen.Parse()
+	require.NoError(t, err)
+
+	fileContents, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+
+	return string(fileContents)
+}
+
+func TestMarkdown(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	t.Run("Default Template", func(t *testing.T) {
+		reference := setupReference(t, "reference.md")
+
+		require.Contains(t, reference, "# Petstore\n")
+		require.Contains(t, reference, "Base URL: `http://localhost:8000/api/v1`\n")
+
+		// Table of contents
+		require.Contains(t, reference, "- **owners**\n  - [getOwners lists the owners of the pets](#getowners-lists-the-owners-of-the-pets)\n")
+		require.Contains(t, reference, "- **pets**\n")
+		require.Contains(t, reference, "  - [createPet adds a pet to the store](#createpet-adds-a-pet-to-the-store)\n")
+
+		// Operations
+		require.Contains(t, reference, "### getPet gets a pet by its ID\n\n`GET /pets/{id}`\n")
+		require.Contains(t, reference, "| `limit` | query | integer (int32) | Yes | the number of pets to list |\n")
+		require.Contains(t, reference, "**Request body** (`application/json`): [Pet](#pet)\n")
+		require.Contains(t, reference, "| 201 | Created | `application/json` | [Pet](#pet) |\n")
+		require.Contains(t, reference, "| 200 | OK | `application/json` | array of [Pet](#pet) |\n")
+
+		// Models
+		require.Contains(t, reference, "### Pet\n\nPet is a pet in the store.\n")
+		require.Contains(t, reference, "| `id` | integer (int32) | No | ID is the unique identifier of the pet. |\n")
+		require.Contains(t, reference, "| `name` | string | Yes | Name is the name of the pet. |\n")
+		require.Contains(t, reference, "| `owner` | [Owner](#owner) (nullable) | No |  |\n")
+		require.Contains(t, reference, "| `status` | [Status](#status) | No |  |\n")
+		require.Contains(t, reference, "| `tags` | array of string | No |  |\n")
+		require.Contains(t, reference, "### Status\n\nStatus is the status of a pet in the store.\n\nType: string\n\nValues: `available`, `sold`\n")
+	})
+
+	t.Run("Custom Template", func(t *testing.T) {
+		summary := setupReference(t, "summary.md", markdown.WithTemplateFile("templates/summary.md.tmpl"))
+
+		require.Equal(t, "# Petstore\n\n- GET /owners: getOwners lists the owners of the pets\n- GET /pets: getPets lists the pets in the store\n- POST /pets: createPet adds a pet to the store\n- GET /pets/statuses/default: getDefaultStatus gets the status of the pets that are added to the store\n- GET /pets/{id}: getPet gets a pet by its ID\n", summary)
+	})
+}
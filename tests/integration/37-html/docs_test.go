@@ -0,0 +1,70 @@
+package petstore
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/inputs"
+	"github.com/ls6-events/astra/outputs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTML(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	gen := astra.New(
+		inputs.WithGinInput(setupRouter()),
+		outputs.WithHTMLOutput("docs"),
+		astra.WithTagStrategy(astra.TagStrategyPathSegment),
+	)
+
+	gen.SetConfig(&astra.Config{
+		Title:    "Petstore",
+		Host:     "localhost",
+		Port:     8000,
+		BasePath: "/api/v1",
+	})
+
+	err := gen.Parse()
+	require.NoError(t, err)
+
+	fileContents, err := os.ReadFile("docs.html")
+	require.NoError(t, err)
+	docs := string(fileContents)
+
+	t.Run("Sidebar", func(t *testing.T) {
+		require.Contains(t, docs, "<title>Petstore</title>")
+		require.Contains(t, docs, `<li><a href="#operation-getPet"><span class="method get">GET</span>getPet gets a pet by its ID</a></li>`)
+		require.Contains(t, docs, `<li><a href="#model-Pet">Pet</a></li>`)
+	})
+
+	t.Run("Operations", func(t *testing.T) {
+		require.Contains(t, docs, `<section id="operation-getPet">`)
+		require.Contains(t, docs, `<h3><a class="anchor" href="#operation-getPet">#</a>getPet gets a pet by its ID</h3>`)
+		require.Contains(t, docs, "<tr><td><code>limit</code></td><td>query</td><td>integer <span class=\"qualifiers\">(int32)</span></td><td>Yes</td><td>the number of pets to list</td></tr>")
+		require.Contains(t, docs, "<summary><code>application/json</code> <a href=\"#model-Pet\">Pet</a></summary>")
+	})
+
+	t.Run("Models", func(t *testing.T) {
+		require.Contains(t, docs, `<details class="model" id="model-Pet" open>`)
+		require.Contains(t, docs, "<tr><td><code>name</code></td><td>string</td><td>Yes</td><td>Name is the name of the pet.</td></tr>")
+		require.Contains(t, docs, "<tr><td><code>status</code></td><td><a href=\"#model-Status\">Status</a></td><td>No</td><td></td></tr>")
+		require.Contains(t, docs, "<p>Values: <code>available</code>, <code>sold</code></p>")
+	})
+
+	t.Run("Curl Commands", func(t *testing.T) {
+		require.Contains(t, docs, "<code>curl -X GET &#39;http://localhost:8000/api/v1/pets?limit=0&#39;</code>")
+		require.Contains(t, docs, "<code>curl -X GET &#39;http://localhost:8000/api/v1/pets/string&#39; \\\n  -H &#39;X-Request-ID: string&#39;</code>")
+		require.Contains(t, docs, "<code>curl -X POST &#39;http://localhost:8000/api/v1/pets&#39; \\\n  -H &#39;Content-Type: application/json&#39; \\\n  --data-raw &#39;{\n")
+		require.Contains(t, docs, "<code>curl -X POST &#39;http://localhost:8000/api/v1/pets/string/photo&#39; \\\n  -F &#39;caption=string&#39; \\\n  -F &#39;photo=@./photo&#39;</code>")
+	})
+
+	t.Run("Offline", func(t *testing.T) {
+		require.NotContains(t, docs, "<script src")
+		require.NotContains(t, docs, "<link")
+		require.NotContains(t, docs, "https://")
+	})
+}
@@ -0,0 +1,16 @@
+package petstore
+
+import "github.com/gin-gonic/gin"
+
+func setupRouter() *gin.Engine {
+	r := gin.Default()
+
+	api := r.Group("/api/v1")
+	api.GET("/pets", getPets)
+	api.GET("/pets/:id", getPet)
+	api.POST("/pets", createPet)
+	api.POST("/pets/:id/photo", uploadPhoto)
+	api.GET("/pets/statuses/default", getDefaultStatus)
+
+	return r
+}
@@ -0,0 +1,88 @@
+package petstore
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Status is the status of a pet in the store.
+type Status string
+
+const (
+	StatusAvailable Status = "available"
+	StatusSold      Status = "sold"
+)
+
+// Priority is the priority of a pet to be adopted.
+type Priority int
+
+const (
+	PriorityLow  Priority = 1
+	PriorityHigh Priority = 2
+)
+
+// Pet is a pet in the store.
+type Pet struct {
+	// ID is the unique identifier of the pet.
+	ID       int               `json:"id"`
+	Name     string            `json:"name"`
+	Nickname *string           `json:"nickname"`
+	Status   Status            `json:"status"`
+	Priority Priority          `json:"priority"`
+	Tags     []string          `json:"tags"`
+	Labels   map[string]string `json:"labels"`
+	Photo    []byte            `json:"photo"`
+	BornAt   time.Time         `json:"bornAt"`
+	Lifespan time.Duration     `json:"lifespan"`
+	Owner    struct {
+		Name string `json:"name"`
+	} `json:"owner"`
+	Secret string `json:"-"`
+}
+
+// Error is an error response.
+type Error struct {
+	Message string `json:"message"`
+}
+
+// getPets lists the pets in the store.
+func getPets(c *gin.Context) {
+	_ = c.Query("tag")
+
+	c.JSON(http.StatusOK, []Pet{})
+}
+
+// getPet gets a pet by its ID.
+func getPet(c *gin.Context) {
+	c.JSON(http.StatusOK, Pet{Name: c.Param("id")})
+}
+
+// createPet adds a pet to the store.
+func createPet(c *gin.Context) {
+	var pet Pet
+	if err := c.ShouldBindJSON(&pet); err != nil {
+		c.JSON(http.StatusBadRequest, Error{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, pet)
+}
+
+// deletePet removes a pet from the store.
+func deletePet(c *gin.Context) {
+	_ = c.Param("id")
+
+	c.Status(http.StatusNoContent)
+}
+
+// getDefaultStatus gets the status of the pets that are added to the store.
+func getDefaultStatus(c *gin.Context) {
+	c.JSON(http.StatusOK, StatusAvailable)
+}
+
+// getDefaultPriority gets the priority of the pets that are added to the store.
+func getDefaultPriority(c *gin.Context) {
+	c.JSON(http.StatusOK, PriorityLow)
+}
@@ -0,0 +1,95 @@
+package petstore
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ls6-events/astra"
+	"github.com/ls6-events/astra/cache"
+	"github.com/ls6-events/astra/inputs"
+	"github.com/ls6-events/astra/outputs"
+	"github.com/ls6-events/astra/outputs/protobuf"
+	"github.com/stretchr/testify/require"
+)
+
+func generateProtobuf(t *testing.T, options ...protobuf.Option) string {
+	t.Helper()
+
+	gen := astra.New(
+		inputs.WithGinInput(setupRouter()),
+		outputs.WithProtobufOutput("petstore", options...),
+		cache.WithCustomCachePath("./cache.json"),
+	)
+
+	gen.SetConfig(&astra.Config{
+		Title:    "Petstore",
+		Host:     "localhost",
+		Port:     8000,
+		BasePath: "/api/v1",
+	})
+
+	err := gen.Parse()
+	require.NoError(t, err)
+
+	fileContents, err := os.ReadFile("petstore.proto")
+	require.NoError(t, err)
+
+	return string(fileContents)
+}
+
+func TestProtobuf(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	err := os.Remove("cache.json")
+	if err != nil && !os.IsNotExist(err) {
+		require.NoError(t, err)
+	}
+
+	// legacy_name was a field of Pet that has been removed, so its number is reserved
+	proto := generateProtobuf(t, protobuf.WithFieldNumbers(protobuf.FieldNumbers{
+		"Pet": {"id": 1, "legacy_name": 2},
+	}))
+
+	t.Run("File", func(t *testing.T) {
+		require.Contains(t, proto, "syntax = \"proto3\";\n\npackage petstore;\n")
+		require.Contains(t, proto, "import \"google/protobuf/duration.proto\";\nimport \"google/protobuf/empty.proto\";\nimport \"google/protobuf/timestamp.proto\";\n")
+	})
+
+	t.Run("Messages", func(t *testing.T) {
+		require.Contains(t, proto, "// Pet is a pet in the store.\nmessage Pet {\n  reserved 2;\n  reserved \"legacy_name\";\n\n")
+		require.Contains(t, proto, "  // ID is the unique identifier of the pet.\n  int64 id = 1;\n")
+		require.Contains(t, proto, "  google.protobuf.Timestamp born_at = 3;\n")
+		require.Contains(t, proto, "  map<string, string> labels = 4;\n")
+		require.Contains(t, proto, "  google.protobuf.Duration lifespan = 5;\n")
+		require.Contains(t, proto, "  optional string nickname = 7;\n")
+		require.Contains(t, proto, "  Owner owner = 8;\n")
+		require.Contains(t, proto, "  bytes photo = 9;\n")
+		require.Contains(t, proto, "  repeated string tags = 12;\n")
+		require.Contains(t, proto, "  message Owner {\n    string name = 1;\n  }\n")
+		require.NotContains(t, proto, "secret")
+	})
+
+	t.Run("Enums", func(t *testing.T) {
+		require.Contains(t, proto, "enum Status {\n  STATUS_UNSPECIFIED = 0;\n  STATUS_AVAILABLE = 1;\n  STATUS_SOLD = 2;\n}\n")
+		require.Contains(t, proto, "enum Priority {\n  PRIORITY_UNSPECIFIED = 0;\n  PRIORITY_LOW = 1;\n  PRIORITY_HIGH = 2;\n}\n")
+	})
+
+	t.Run("Service", func(t *testing.T) {
+		require.Contains(t, proto, "service PetstoreService {\n")
+		require.Contains(t, proto, "  // getPet gets a pet by its ID.\n  // GET /api/v1/pets/:id\n  rpc GetPet(GetPetRequest) returns (Pet);\n")
+		require.Contains(t, proto, "  rpc GetPets(GetPetsRequest) returns (GetPetsResponse);\n")
+		require.Contains(t, proto, "  rpc CreatePet(Pet) returns (Pet);\n")
+		require.Contains(t, proto, "  rpc DeletePet(DeletePetRequest) returns (google.protobuf.Empty);\n")
+		require.Contains(t, proto, "  rpc GetDefaultStatus(google.protobuf.Empty) returns (GetDefaultStatusResponse);\n")
+		require.Contains(t, proto, "message GetPetRequest {\n  string id = 1;\n}\n")
+		require.Contains(t, proto, "message GetPetsRequest {\n  optional string tag = 1;\n}\n")
+		require.Contains(t, proto, "message GetPetsResponse {\n  repeated Pet items = 1;\n}\n")
+	})
+
+	t.Run("Cached Field Numbers", func(t *testing.T) {
+		// The field numbers are restored from the cache, so the file is the same without the options
+		require.Equal(t, proto, generateProtobuf(t))
+	})
+}
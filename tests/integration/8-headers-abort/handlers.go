@@ -2,6 +2,7 @@ package petstore
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 )
@@ -16,6 +17,33 @@ func setHeader(c *gin.Context) {
 	c.Status(http.StatusOK)
 }
 
+// HeaderRequestID is the header containing the ID of the request.
+const HeaderRequestID = "X-Request-ID"
+
+func setConditionalHeaders(c *gin.Context) {
+	c.Header(HeaderRequestID, "id")
+	c.Header("X-Test-Header", "")
+
+	if c.Query("fail") != "" {
+		c.JSON(http.StatusBadRequest, gin.H{})
+		return
+	}
+
+	c.Writer.Header().Set("X-Total-Count", strconv.Itoa(10))
+	setCacheHeaders(c)
+
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+func setCacheHeaders(c *gin.Context) {
+	c.Writer.Header().Add("Cache-Control", "no-store")
+}
+
+func writeHeader(c *gin.Context) {
+	c.Writer.Header().Set("Location", "/headers")
+	c.Writer.WriteHeader(http.StatusCreated)
+}
+
 func abortWithError(c *gin.Context) {
 	// Ignoring error as it is not used in the test
 	_ = c.AbortWithError(http.StatusBadRequest, nil)
@@ -30,6 +30,21 @@ func TestHeadersAndAbort(t *testing.T) {
 		// Set
 		require.True(t, paths.Exists("/headers", "post", "responses", "200", "headers", "X-Test-Header"))
 		require.Equal(t, "string", paths.Path("/headers.post.responses.200.headers.X-Test-Header.schema.type").Data().(string))
+
+		// Set before the responses they precede
+		require.True(t, paths.Exists("/headers", "put", "responses", "400", "headers", "X-Request-ID"))
+		require.False(t, paths.Exists("/headers", "put", "responses", "400", "headers", "X-Total-Count"))
+		require.False(t, paths.Exists("/headers", "put", "responses", "400", "headers", "Cache-Control"))
+
+		require.True(t, paths.Exists("/headers", "put", "responses", "200", "headers", "X-Request-ID"))
+		require.Equal(t, "integer", paths.Search("/headers", "put", "responses", "200", "headers", "X-Total-Count", "schema", "type").Data().(string))
+		require.Equal(t, "string", paths.Search("/headers", "put", "responses", "200", "headers", "Cache-Control", "schema", "type").Data().(string))
+
+		// Deleted by setting an empty value
+		require.False(t, paths.Exists("/headers", "put", "responses", "200", "headers", "X-Test-Header"))
+
+		// Written through the response writer
+		require.True(t, paths.Exists("/headers", "patch", "responses", "201", "headers", "Location"))
 	})
 
 	t.Run("Abort", func(t *testing.T) {
@@ -7,6 +7,8 @@ func setupRouter() *gin.Engine {
 
 	r.GET("/headers", getHeader)
 	r.POST("/headers", setHeader)
+	r.PUT("/headers", setConditionalHeaders)
+	r.PATCH("/headers", writeHeader)
 	r.GET("/abort-with-error", abortWithError)
 	r.GET("/abort-with-status", abortWithStatus)
 	r.GET("/abort-with-status-json", abortWithStatusJSON)
@@ -9,6 +9,7 @@ import (
 	"github.com/ls6-events/astra"
 	"github.com/ls6-events/astra/inputs"
 	"github.com/ls6-events/astra/outputs"
+	"github.com/ls6-events/astra/outputs/openapi"
 	"github.com/stretchr/testify/require"
 )
 
@@ -26,7 +27,19 @@ func SetupTestAstraWithDefaultConfig(t *testing.T, r *gin.Engine, options ...ast
 func SetupTestAstra(t *testing.T, r *gin.Engine, config *astra.Config, options ...astra.Option) (*gabs.Container, error) {
 	t.Helper()
 
-	options = append(options, inputs.WithGinInput(r), outputs.WithOpenAPIOutput("./output.json"))
+	return SetupTestAstraWithOpenAPIOptions(t, r, config, nil, options...)
+}
+
+func SetupTestAstraWithOpenAPIVersion(t *testing.T, r *gin.Engine, config *astra.Config, version openapi.Version, options ...astra.Option) (*gabs.Container, error) {
+	t.Helper()
+
+	return SetupTestAstraWithOpenAPIOptions(t, r, config, []openapi.Option{version}, options...)
+}
+
+func SetupTestAstraWithOpenAPIOptions(t *testing.T, r *gin.Engine, config *astra.Config, openAPIOptions []openapi.Option, options ...astra.Option) (*gabs.Container, error) {
+	t.Helper()
+
+	options = append(options, inputs.WithGinInput(r), outputs.WithOpenAPIOutput("./output.json", openAPIOptions...))
 
 	gen := astra.New(options...)
 
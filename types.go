@@ -18,16 +18,37 @@ type Route struct {
 	Doc         string       `json:"doc,omitempty" yaml:"doc,omitempty"`
 	OperationID string       `json:"operationId,omitempty" yaml:"operationId,omitempty"`
 
+	Summary    string                `json:"summary,omitempty" yaml:"summary,omitempty"`
+	Tags       []string              `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Security   []SecurityRequirement `json:"security,omitempty" yaml:"security,omitempty"` // each security requirement is an alternative to the others.
+	Deprecated bool                  `json:"deprecated,omitempty" yaml:"deprecated,omitempty"`
+	Hidden     bool                  `json:"hidden,omitempty" yaml:"hidden,omitempty"`   // hidden routes are removed once the routes are parsed.
+	Webhook    string                `json:"webhook,omitempty" yaml:"webhook,omitempty"` // the name of the webhook the route documents, if it is one.
+
+	Middleware []string `json:"middleware,omitempty" yaml:"middleware,omitempty"` // the function names of the middleware the route is handled by, in order.
+
+	Package string `json:"package,omitempty" yaml:"package,omitempty"` // the import path of the package of the handler.
+	Group   string `json:"group,omitempty" yaml:"group,omitempty"`     // the prefix of the router group the route is registered in, if it is known.
+
 	RequestHeaders  []Param `json:"requestHeaders,omitempty" yaml:"requestHeaders,omitempty"`
-	ResponseHeaders []Param `json:"responseHeaders,omitempty" yaml:"responseHeaders,omitempty"`
+	ResponseHeaders []Param `json:"responseHeaders,omitempty" yaml:"responseHeaders,omitempty"` // every header set by the route, the headers of each response are in its return type.
+
+	Extensions map[string]any `json:"extensions,omitempty" yaml:"extensions,omitempty"` // the vendor extensions of the route (i.e. x-ratelimit), by their names.
 }
 
+// SecurityRequirement is a requirement for a route to be called with the security schemes, by their names and the scopes they need.
+type SecurityRequirement map[string][]string
+
 // ReturnType is a return type for a route.
 // It contains the status code and the field that is returned.
+// It also contains the headers that are set before the response is written.
+// And the description of when the response is written (i.e. the condition leading to it).
 type ReturnType struct {
-	StatusCode  int    `json:"statusCode,omitempty" yaml:"statusCode,omitempty"`
-	ContentType string `json:"contentType,omitempty" yaml:"contentType,omitempty"`
-	Field       Field  `json:"field,omitempty" yaml:"field,omitempty"`
+	StatusCode  int     `json:"statusCode,omitempty" yaml:"statusCode,omitempty"`
+	ContentType string  `json:"contentType,omitempty" yaml:"contentType,omitempty"`
+	Field       Field   `json:"field,omitempty" yaml:"field,omitempty"`
+	Headers     []Param `json:"headers,omitempty" yaml:"headers,omitempty"`
+	Description string  `json:"description,omitempty" yaml:"description,omitempty"`
 }
 
 // Param is a parameter for a route.
@@ -39,6 +60,7 @@ type Param struct {
 	IsRequired bool   `json:"isRequired,omitempty" yaml:"isRequired,omitempty"`
 	IsArray    bool   `json:"isArray,omitempty" yaml:"isArray,omitempty"`
 	IsMap      bool   `json:"isMap,omitempty" yaml:"isMap,omitempty"`
+	Doc        string `json:"doc,omitempty" yaml:"doc,omitempty"`
 
 	IsBound bool `json:"isBound,omitempty" yaml:"isBound,omitempty"` // I.e. is a struct reference.
 }
@@ -77,6 +99,11 @@ type Field struct {
 
 	IsRequired bool `json:"isRequired,omitempty" yaml:"isRequired,omitempty"`
 	IsEmbedded bool `json:"isEmbedded,omitempty" yaml:"isEmbedded,omitempty"`
+	IsNullable bool `json:"isNullable,omitempty" yaml:"isNullable,omitempty"` // i.e. a pointer struct field.
+
+	Example string `json:"example,omitempty" yaml:"example,omitempty"` // the example tag of a struct field.
+
+	Extensions map[string]any `json:"extensions,omitempty" yaml:"extensions,omitempty"` // the vendor extensions from the x- tags of a struct field (i.e. x-go-type).
 
 	SliceType string `json:"sliceType,omitempty" yaml:"sliceType,omitempty"`
 
@@ -14,6 +14,9 @@ func ParseResultToField(result astTraversal.Result) Field {
 		EnumValues:                result.EnumValues,
 		EnumNames:                 result.EnumNames,
 		IsEmbedded:                result.IsEmbedded,
+		IsNullable:                result.IsNullable,
+		Example:                   result.Example,
+		Extensions:                ParseExtensionValues(result.Extensions),
 		SliceType:                 result.SliceType,
 		ArrayType:                 result.ArrayType,
 		ArrayLength:               result.ArrayLength,
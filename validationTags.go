@@ -0,0 +1,24 @@
+package astra
+
+import "github.com/ls6-events/astra/astTraversal"
+
+// ExtractValidationRules merges the rules from every validation tag of a field into a single map.
+// The validator tag is applied last, so it takes precedence over the gin binding tag when both define the same rule.
+func ExtractValidationRules(validationTags astTraversal.ValidationTagMap) map[string]string {
+	var rules map[string]string
+	for _, validationTagType := range astTraversal.ValidationTags {
+		validationTag, ok := validationTags[validationTagType]
+		if !ok {
+			continue
+		}
+
+		for name, value := range validationTag.Rules {
+			if rules == nil {
+				rules = make(map[string]string)
+			}
+			rules[name] = value
+		}
+	}
+
+	return rules
+}
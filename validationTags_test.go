@@ -0,0 +1,37 @@
+package astra
+
+import (
+	"github.com/ls6-events/astra/astTraversal"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestExtractValidationRules(t *testing.T) {
+	t.Run("No Validation Tags", func(t *testing.T) {
+		require.Nil(t, ExtractValidationRules(astTraversal.ValidationTagMap{}))
+	})
+
+	t.Run("Merges Validation Tags", func(t *testing.T) {
+		validationTags := astTraversal.ValidationTagMap{
+			astTraversal.GinValidationTag: {
+				IsRequired: true,
+				Rules: map[string]string{
+					"min": "1",
+					"max": "10",
+				},
+			},
+			astTraversal.ValidatorValidationTag: {
+				Rules: map[string]string{
+					"max":   "5",
+					"email": "",
+				},
+			},
+		}
+
+		require.Equal(t, map[string]string{
+			"min":   "1",
+			"max":   "5",
+			"email": "",
+		}, ExtractValidationRules(validationTags))
+	})
+}